@@ -617,17 +617,21 @@ const (
 	CirrusCIService_DeleteCache_FullMethodName                = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/DeleteCache"
 	CirrusCIService_Heartbeat_FullMethodName                  = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/Heartbeat"
 	CirrusCIService_ReportStopHook_FullMethodName             = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportStopHook"
+	CirrusCIService_ReportGitMetadata_FullMethodName          = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportGitMetadata"
+	CirrusCIService_ReportLifecycleHook_FullMethodName        = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportLifecycleHook"
 	CirrusCIService_ReportAgentError_FullMethodName           = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportAgentError"
 	CirrusCIService_ReportAgentWarning_FullMethodName         = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportAgentWarning"
 	CirrusCIService_ReportAgentSignal_FullMethodName          = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportAgentSignal"
 	CirrusCIService_ReportAgentLogs_FullMethodName            = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportAgentLogs"
 	CirrusCIService_ReportAgentFinished_FullMethodName        = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportAgentFinished"
+	CirrusCIService_ReportResourceUtilization_FullMethodName  = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportResourceUtilization"
 	CirrusCIService_ParseConfig_FullMethodName                = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ParseConfig"
 	CirrusCIService_ReportTerminalAttached_FullMethodName     = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportTerminalAttached"
 	CirrusCIService_ReportTerminalLifecycle_FullMethodName    = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/ReportTerminalLifecycle"
 	CirrusCIService_GenerateCacheUploadURL_FullMethodName     = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/GenerateCacheUploadURL"
 	CirrusCIService_GenerateCacheDownloadURL_FullMethodName   = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/GenerateCacheDownloadURL"
 	CirrusCIService_GenerateCacheDownloadURLs_FullMethodName  = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/GenerateCacheDownloadURLs"
+	CirrusCIService_RefreshOIDCToken_FullMethodName           = "/org.cirruslabs.ci.services.cirruscigrpc.CirrusCIService/RefreshOIDCToken"
 )
 
 // CirrusCIServiceClient is the client API for CirrusCIService service.
@@ -651,17 +655,21 @@ type CirrusCIServiceClient interface {
 	DeleteCache(ctx context.Context, in *DeleteCacheRequest, opts ...grpc.CallOption) (*DeleteCacheResponse, error)
 	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
 	ReportStopHook(ctx context.Context, in *ReportStopHookRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ReportGitMetadata(ctx context.Context, in *ReportGitMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ReportLifecycleHook(ctx context.Context, in *ReportLifecycleHookRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ReportAgentError(ctx context.Context, in *ReportAgentProblemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ReportAgentWarning(ctx context.Context, in *ReportAgentProblemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ReportAgentSignal(ctx context.Context, in *ReportAgentSignalRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ReportAgentLogs(ctx context.Context, in *ReportAgentLogsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ReportAgentFinished(ctx context.Context, in *ReportAgentFinishedRequest, opts ...grpc.CallOption) (*ReportAgentFinishedResponse, error)
+	ReportResourceUtilization(ctx context.Context, in *ReportResourceUtilizationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ParseConfig(ctx context.Context, in *ParseConfigRequest, opts ...grpc.CallOption) (*ParseConfigResponse, error)
 	ReportTerminalAttached(ctx context.Context, in *ReportTerminalAttachedRequest, opts ...grpc.CallOption) (*ReportTerminalAttachedResponse, error)
 	ReportTerminalLifecycle(ctx context.Context, in *ReportTerminalLifecycleRequest, opts ...grpc.CallOption) (*ReportTerminalLifecycleResponse, error)
 	GenerateCacheUploadURL(ctx context.Context, in *CacheKey, opts ...grpc.CallOption) (*GenerateURLResponse, error)
 	GenerateCacheDownloadURL(ctx context.Context, in *CacheKey, opts ...grpc.CallOption) (*GenerateURLResponse, error)
 	GenerateCacheDownloadURLs(ctx context.Context, in *CacheKey, opts ...grpc.CallOption) (*GenerateURLsResponse, error)
+	RefreshOIDCToken(ctx context.Context, in *RefreshOIDCTokenRequest, opts ...grpc.CallOption) (*RefreshOIDCTokenResponse, error)
 }
 
 type cirrusCIServiceClient struct {
@@ -930,6 +938,24 @@ func (c *cirrusCIServiceClient) ReportStopHook(ctx context.Context, in *ReportSt
 	return out, nil
 }
 
+func (c *cirrusCIServiceClient) ReportGitMetadata(ctx context.Context, in *ReportGitMetadataRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, CirrusCIService_ReportGitMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cirrusCIServiceClient) ReportLifecycleHook(ctx context.Context, in *ReportLifecycleHookRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, CirrusCIService_ReportLifecycleHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cirrusCIServiceClient) ReportAgentError(ctx context.Context, in *ReportAgentProblemRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, CirrusCIService_ReportAgentError_FullMethodName, in, out, opts...)
@@ -975,6 +1001,15 @@ func (c *cirrusCIServiceClient) ReportAgentFinished(ctx context.Context, in *Rep
 	return out, nil
 }
 
+func (c *cirrusCIServiceClient) ReportResourceUtilization(ctx context.Context, in *ReportResourceUtilizationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, CirrusCIService_ReportResourceUtilization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cirrusCIServiceClient) ParseConfig(ctx context.Context, in *ParseConfigRequest, opts ...grpc.CallOption) (*ParseConfigResponse, error) {
 	out := new(ParseConfigResponse)
 	err := c.cc.Invoke(ctx, CirrusCIService_ParseConfig_FullMethodName, in, out, opts...)
@@ -1029,6 +1064,15 @@ func (c *cirrusCIServiceClient) GenerateCacheDownloadURLs(ctx context.Context, i
 	return out, nil
 }
 
+func (c *cirrusCIServiceClient) RefreshOIDCToken(ctx context.Context, in *RefreshOIDCTokenRequest, opts ...grpc.CallOption) (*RefreshOIDCTokenResponse, error) {
+	out := new(RefreshOIDCTokenResponse)
+	err := c.cc.Invoke(ctx, CirrusCIService_RefreshOIDCToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CirrusCIServiceServer is the server API for CirrusCIService service.
 // All implementations must embed UnimplementedCirrusCIServiceServer
 // for forward compatibility
@@ -1050,17 +1094,21 @@ type CirrusCIServiceServer interface {
 	DeleteCache(context.Context, *DeleteCacheRequest) (*DeleteCacheResponse, error)
 	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
 	ReportStopHook(context.Context, *ReportStopHookRequest) (*empty.Empty, error)
+	ReportGitMetadata(context.Context, *ReportGitMetadataRequest) (*empty.Empty, error)
+	ReportLifecycleHook(context.Context, *ReportLifecycleHookRequest) (*empty.Empty, error)
 	ReportAgentError(context.Context, *ReportAgentProblemRequest) (*empty.Empty, error)
 	ReportAgentWarning(context.Context, *ReportAgentProblemRequest) (*empty.Empty, error)
 	ReportAgentSignal(context.Context, *ReportAgentSignalRequest) (*empty.Empty, error)
 	ReportAgentLogs(context.Context, *ReportAgentLogsRequest) (*empty.Empty, error)
 	ReportAgentFinished(context.Context, *ReportAgentFinishedRequest) (*ReportAgentFinishedResponse, error)
+	ReportResourceUtilization(context.Context, *ReportResourceUtilizationRequest) (*empty.Empty, error)
 	ParseConfig(context.Context, *ParseConfigRequest) (*ParseConfigResponse, error)
 	ReportTerminalAttached(context.Context, *ReportTerminalAttachedRequest) (*ReportTerminalAttachedResponse, error)
 	ReportTerminalLifecycle(context.Context, *ReportTerminalLifecycleRequest) (*ReportTerminalLifecycleResponse, error)
 	GenerateCacheUploadURL(context.Context, *CacheKey) (*GenerateURLResponse, error)
 	GenerateCacheDownloadURL(context.Context, *CacheKey) (*GenerateURLResponse, error)
 	GenerateCacheDownloadURLs(context.Context, *CacheKey) (*GenerateURLsResponse, error)
+	RefreshOIDCToken(context.Context, *RefreshOIDCTokenRequest) (*RefreshOIDCTokenResponse, error)
 	mustEmbedUnimplementedCirrusCIServiceServer()
 }
 
@@ -1113,6 +1161,12 @@ func (UnimplementedCirrusCIServiceServer) Heartbeat(context.Context, *HeartbeatR
 func (UnimplementedCirrusCIServiceServer) ReportStopHook(context.Context, *ReportStopHookRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportStopHook not implemented")
 }
+func (UnimplementedCirrusCIServiceServer) ReportGitMetadata(context.Context, *ReportGitMetadataRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportGitMetadata not implemented")
+}
+func (UnimplementedCirrusCIServiceServer) ReportLifecycleHook(context.Context, *ReportLifecycleHookRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportLifecycleHook not implemented")
+}
 func (UnimplementedCirrusCIServiceServer) ReportAgentError(context.Context, *ReportAgentProblemRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportAgentError not implemented")
 }
@@ -1128,6 +1182,9 @@ func (UnimplementedCirrusCIServiceServer) ReportAgentLogs(context.Context, *Repo
 func (UnimplementedCirrusCIServiceServer) ReportAgentFinished(context.Context, *ReportAgentFinishedRequest) (*ReportAgentFinishedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportAgentFinished not implemented")
 }
+func (UnimplementedCirrusCIServiceServer) ReportResourceUtilization(context.Context, *ReportResourceUtilizationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportResourceUtilization not implemented")
+}
 func (UnimplementedCirrusCIServiceServer) ParseConfig(context.Context, *ParseConfigRequest) (*ParseConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParseConfig not implemented")
 }
@@ -1146,6 +1203,9 @@ func (UnimplementedCirrusCIServiceServer) GenerateCacheDownloadURL(context.Conte
 func (UnimplementedCirrusCIServiceServer) GenerateCacheDownloadURLs(context.Context, *CacheKey) (*GenerateURLsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateCacheDownloadURLs not implemented")
 }
+func (UnimplementedCirrusCIServiceServer) RefreshOIDCToken(context.Context, *RefreshOIDCTokenRequest) (*RefreshOIDCTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshOIDCToken not implemented")
+}
 func (UnimplementedCirrusCIServiceServer) mustEmbedUnimplementedCirrusCIServiceServer() {}
 
 // UnsafeCirrusCIServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1464,6 +1524,42 @@ func _CirrusCIService_ReportStopHook_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CirrusCIService_ReportGitMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportGitMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirrusCIServiceServer).ReportGitMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirrusCIService_ReportGitMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirrusCIServiceServer).ReportGitMetadata(ctx, req.(*ReportGitMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CirrusCIService_ReportLifecycleHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportLifecycleHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirrusCIServiceServer).ReportLifecycleHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirrusCIService_ReportLifecycleHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirrusCIServiceServer).ReportLifecycleHook(ctx, req.(*ReportLifecycleHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CirrusCIService_ReportAgentError_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportAgentProblemRequest)
 	if err := dec(in); err != nil {
@@ -1554,6 +1650,24 @@ func _CirrusCIService_ReportAgentFinished_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CirrusCIService_ReportResourceUtilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportResourceUtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirrusCIServiceServer).ReportResourceUtilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirrusCIService_ReportResourceUtilization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirrusCIServiceServer).ReportResourceUtilization(ctx, req.(*ReportResourceUtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CirrusCIService_ParseConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParseConfigRequest)
 	if err := dec(in); err != nil {
@@ -1662,6 +1776,24 @@ func _CirrusCIService_GenerateCacheDownloadURLs_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CirrusCIService_RefreshOIDCToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshOIDCTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CirrusCIServiceServer).RefreshOIDCToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CirrusCIService_RefreshOIDCToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CirrusCIServiceServer).RefreshOIDCToken(ctx, req.(*RefreshOIDCTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CirrusCIService_ServiceDesc is the grpc.ServiceDesc for CirrusCIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1709,6 +1841,14 @@ var CirrusCIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportStopHook",
 			Handler:    _CirrusCIService_ReportStopHook_Handler,
 		},
+		{
+			MethodName: "ReportGitMetadata",
+			Handler:    _CirrusCIService_ReportGitMetadata_Handler,
+		},
+		{
+			MethodName: "ReportLifecycleHook",
+			Handler:    _CirrusCIService_ReportLifecycleHook_Handler,
+		},
 		{
 			MethodName: "ReportAgentError",
 			Handler:    _CirrusCIService_ReportAgentError_Handler,
@@ -1729,6 +1869,10 @@ var CirrusCIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportAgentFinished",
 			Handler:    _CirrusCIService_ReportAgentFinished_Handler,
 		},
+		{
+			MethodName: "ReportResourceUtilization",
+			Handler:    _CirrusCIService_ReportResourceUtilization_Handler,
+		},
 		{
 			MethodName: "ParseConfig",
 			Handler:    _CirrusCIService_ParseConfig_Handler,
@@ -1753,6 +1897,10 @@ var CirrusCIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateCacheDownloadURLs",
 			Handler:    _CirrusCIService_GenerateCacheDownloadURLs_Handler,
 		},
+		{
+			MethodName: "RefreshOIDCToken",
+			Handler:    _CirrusCIService_RefreshOIDCToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
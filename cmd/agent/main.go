@@ -2,15 +2,29 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"github.com/avast/retry-go"
+	"github.com/certifi/gocertifi"
 	"github.com/cirruslabs/cirrus-ci-agent/api"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sandbox"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/approval"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/filetransfer"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/portforward"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/recorder"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/restrictedshell"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/grpcweb"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/lifecyclehook"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/network"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/signalfilter"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/sshtunnel"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/throttle"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/workroot"
 	"github.com/cirruslabs/cirrus-ci-agent/pkg/grpchelper"
+	"github.com/dustin/go-humanize"
 	"github.com/getsentry/sentry-go"
 	"github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	goversion "github.com/hashicorp/go-version"
@@ -23,6 +37,8 @@ import (
 	"io"
 	"log"
 	"math"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -74,8 +90,125 @@ func main() {
 	commandToPtr := flag.String("command-to", "", "Command to stop execution at (exclusive)")
 	preCreatedWorkingDir := flag.String("pre-created-working-dir", "",
 		"working directory to use when spawned via Persistent Worker")
+	portForward := flag.Bool("port-forward", false,
+		"serve the port forwarding protocol on stdin/stdout (used from within the terminal sessions)")
+	fileTransfer := flag.Bool("file-transfer", false,
+		"serve the file transfer protocol on stdin/stdout (used from within the terminal sessions)")
+	recordTerminal := flag.String("record-terminal", "",
+		"run the specified command (or the shell) while recording it to the specified directory "+
+			"(used from within the terminal sessions)")
+	approveTerminal := flag.String("approve-terminal", "",
+		"ask for the approval token whose hash is stored in the specified file and then run "+
+			"the specified command (used to start the terminal sessions)")
+	restrictedShell := flag.Bool("restricted-shell", false,
+		"run a shell that only allows the allow-listed commands (used from within the terminal sessions)")
+	sandboxConfig := flag.String(sandbox.Flag, "",
+		"run the specified command in a sandbox with the specified configuration (used for the -sandbox-scripts)")
+	sandboxScripts := flag.Bool("sandbox-scripts", os.Getenv("CIRRUS_AGENT_SANDBOX_SCRIPTS") == "true",
+		"run the scripts in a sandbox that only allows them to modify the working directory, "+
+			"a private temporary directory and the -sandbox-writable paths")
+	sandboxWritable := flag.String("sandbox-writable", os.Getenv("CIRRUS_AGENT_SANDBOX_WRITABLE"),
+		"comma-separated list of the additional paths the sandboxed scripts are allowed to modify "+
+			"(e.g. the cache folders outside of the working directory)")
+	egressAllow := flag.String("egress-allow", os.Getenv("CIRRUS_AGENT_EGRESS_ALLOW"),
+		"comma-separated list of the destination hosts the scripts are allowed to connect to "+
+			"through the egress proxy, all other destinations are prohibited")
+	egressDeny := flag.String("egress-deny", os.Getenv("CIRRUS_AGENT_EGRESS_DENY"),
+		"comma-separated list of the destination hosts the scripts are not allowed to connect to "+
+			"through the egress proxy, takes precedence over the -egress-allow")
+	dnsServer := flag.String("dns-server", os.Getenv("CIRRUS_AGENT_DNS_SERVER"),
+		"DNS server (IP address with an optional port) to use instead of the system resolver")
+	hostsFile := flag.String("hosts-file", os.Getenv("CIRRUS_AGENT_HOSTS_FILE"),
+		"/etc/hosts-style file with the static hostname overrides")
+	sshTunnel := flag.String("ssh-tunnel", os.Getenv("CIRRUS_AGENT_SSH_TUNNEL"),
+		"dial the API endpoint through the specified SSH jump host (user@host[:port])")
+	sshTunnelKey := flag.String("ssh-tunnel-key", os.Getenv("CIRRUS_AGENT_SSH_TUNNEL_KEY"),
+		"private key to authenticate to the SSH jump host with (defaults to ~/.ssh/id_*)")
+	sshTunnelKnownHosts := flag.String("ssh-tunnel-known-hosts", os.Getenv("CIRRUS_AGENT_SSH_TUNNEL_KNOWN_HOSTS"),
+		"known hosts file to verify the SSH jump host with (defaults to ~/.ssh/known_hosts)")
+	grpcWebEndpoint := flag.String("grpc-web-endpoint", os.Getenv("CIRRUS_AGENT_GRPC_WEB_ENDPOINT"),
+		"gRPC-Web endpoint to fall back to when HTTP/2 gRPC is blocked (defaults to the API endpoint)")
+	workingDirSnapshot := flag.String("working-dir-snapshot", os.Getenv("CIRRUS_AGENT_WORKING_DIR_SNAPSHOT"),
+		"reset the working directory with a btrfs, ZFS or APFS snapshot: \"task\" rolls it back to the state "+
+			"before the first task of the repository, \"clone\" to the state after the clone before the script re-runs")
+	preStartHook := flag.String("pre-start-hook", os.Getenv("CIRRUS_AGENT_PRE_START_HOOK"),
+		"script to run before the task's first instruction, its failure fails the task")
+	postCloneHook := flag.String("post-clone-hook", os.Getenv("CIRRUS_AGENT_POST_CLONE_HOOK"),
+		"script to run after the repository is cloned, its failure fails the clone instruction")
+	postTaskHook := flag.String("post-task-hook", os.Getenv("CIRRUS_AGENT_POST_TASK_HOOK"),
+		"script to run after the task's last instruction")
+	preStopHook := flag.String("pre-stop-hook", os.Getenv("CIRRUS_AGENT_PRE_STOP_HOOK"),
+		"script to run in the -stop-hook mode before reporting it")
+	bandwidthLimit := flag.String("bandwidth-limit", os.Getenv("CIRRUS_AGENT_BANDWIDTH_LIMIT"),
+		"bytes per second (e.g. \"10MB\") shared by all the logs, caches and artifacts traffic to the servers")
+	maxConcurrentStreams := flag.String("max-concurrent-streams", os.Getenv("CIRRUS_AGENT_MAX_CONCURRENT_STREAMS"),
+		"number of the concurrent log, cache and artifact streams to the servers, should leave room "+
+			"for the log streams of the background scripts that are held until they finish")
+	workRoot := flag.String("work-root", os.Getenv("CIRRUS_AGENT_WORK_ROOT"),
+		"directory to use instead of the OS temp directory for the agent's temporary files "+
+			"and the default working directories (e.g. a dedicated fast volume)")
 	flag.Parse()
 
+	if *recordTerminal != "" {
+		exitCode, err := recorder.Run(*recordTerminal, flag.Args())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "terminal recording failed: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(exitCode)
+	}
+
+	if *sandboxConfig != "" {
+		exitCode, err := sandbox.Run(*sandboxConfig, flag.Args())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "sandbox failed: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(exitCode)
+	}
+
+	if *approveTerminal != "" {
+		if err := approval.GateStdio(*approveTerminal); err != nil {
+			fmt.Fprintf(os.Stderr, "terminal approval failed: %v\n", err)
+			os.Exit(1)
+		}
+		if flag.NArg() != 0 {
+			if err := approval.Exec(flag.Args()); err != nil {
+				fmt.Fprintf(os.Stderr, "failed to start the approved terminal session: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		os.Exit(0)
+	}
+
+	if *restrictedShell {
+		if err := restrictedshell.RunStdio(); err != nil {
+			fmt.Fprintf(os.Stderr, "restricted shell failed: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if *fileTransfer {
+		if err := filetransfer.ServeStdio(); err != nil {
+			fmt.Fprintf(os.Stderr, "file transfer failed: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if *portForward {
+		if err := portforward.ServeStdio(context.Background()); err != nil {
+			fmt.Fprintf(os.Stderr, "port forwarding failed: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if flag.Arg(0) == "preflight" {
+		os.Exit(runPreflight(*apiEndpointPtr, *dnsServer, *hostsFile, *workRoot, flag.Args()[1:]))
+	}
+
 	// Initialize Sentry
 	var release string
 
@@ -106,6 +239,9 @@ func main() {
 		})
 	}
 
+	// Assigned once the task's execution begins, so that its partial results can be reported after a crash
+	var buildExecutor *executor.Executor
+
 	defer func() {
 		err := recover()
 		if err == nil {
@@ -128,10 +264,15 @@ func main() {
 				TaskId: *taskIdPtr,
 				Secret: *clientTokenPtr,
 			},
-			Message: fmt.Sprint(err),
-			Stack:   string(debug.Stack()),
+			Message:  fmt.Sprint(err),
+			Stack:    string(debug.Stack()),
+			Category: client.ProblemAgent,
 		}
 		_, _ = client.CirrusClient.ReportAgentError(context.Background(), request)
+
+		if buildExecutor != nil {
+			buildExecutor.ReportCrash(err)
+		}
 	}()
 
 	if *versionFlag {
@@ -146,6 +287,11 @@ func main() {
 
 	var conn *grpc.ClientConn
 
+	// Affects the log file path below
+	if err := workroot.Configure(*workRoot); err != nil {
+		log.Printf("Failed to configure the work root, falling back to the OS temp directory: %v", err)
+	}
+
 	logFilePath := filepath.Join(os.TempDir(), fmt.Sprintf("cirrus-agent-%d.log", *taskIdPtr))
 	if *stopHook {
 		// In case of a failure the log file will be persisted on the machine for debugging purposes.
@@ -170,6 +316,17 @@ func main() {
 
 	log.Printf("Running agent version %s", fullVersion())
 
+	if err := network.ConfigureResolver(*dnsServer, *hostsFile); err != nil {
+		log.Printf("Failed to configure the resolver, falling back to the system one: %v", err)
+	}
+
+	if err := configureThrottling(*bandwidthLimit, *maxConcurrentStreams); err != nil {
+		log.Printf("Not limiting the traffic to the servers: %v", err)
+	}
+
+	executor.ConfigureSandbox(*sandboxScripts, *sandboxWritable)
+	executor.ConfigureEgressPolicy(*egressAllow, *egressDeny)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -195,9 +352,46 @@ func main() {
 		}
 	}()
 
+	var dialOpts []grpc.DialOption
+	httpDialContext := network.DialContext
+
+	if *sshTunnel != "" {
+		tunnel, err := sshtunnel.New(*sshTunnel, *sshTunnelKey, *sshTunnelKnownHosts)
+		if err != nil {
+			log.Printf("Failed to configure the SSH tunnel: %v", err)
+			return
+		}
+		defer tunnel.Close()
+
+		log.Printf("Dialing %s through the SSH tunnel via %s...", *apiEndpointPtr, tunnel)
+
+		dialOpts = append(dialOpts, grpc.WithContextDialer(tunnel.DialContext))
+		httpDialContext = func(ctx context.Context, _ string, addr string) (net.Conn, error) {
+			return tunnel.DialContext(ctx, addr)
+		}
+	}
+
+	if *grpcWebEndpoint == "" {
+		*grpcWebEndpoint = grpcweb.EndpointFor(*apiEndpointPtr)
+	}
+
+	var fallbackActive bool
+	var dialAttempts int
+
 	err = retry.Do(
 		func() error {
-			conn, err = dialWithTimeout(ctx, *apiEndpointPtr)
+			dialAttempts++
+
+			// Don't wait for the HTTP/2 gRPC connection forever if it's blocked,
+			// the RPCs will go through the gRPC-Web instead
+			if *grpcWebEndpoint != "" && dialAttempts > grpcweb.FallbackThreshold {
+				target, opts := dialOptions(*apiEndpointPtr, dialOpts...)
+				conn, err = grpc.DialContext(ctx, target, opts...)
+				fallbackActive = true
+				return err
+			}
+
+			conn, err = dialWithTimeout(ctx, *apiEndpointPtr, dialOpts...)
 			return err
 		}, retry.OnRetry(func(n uint, err error) {
 			log.Printf("Failed to open a connection: %v\n", err)
@@ -213,7 +407,16 @@ func main() {
 
 	log.Printf("Connected!\n")
 
-	client.InitClient(conn)
+	if *grpcWebEndpoint != "" {
+		fallbackConn := grpcweb.NewFallback(conn, newGRPCWebConn(*grpcWebEndpoint, httpDialContext))
+		if fallbackActive {
+			fallbackConn.Activate()
+		}
+
+		client.InitClient(throttle.NewConn(fallbackConn, throttle.Global()))
+	} else {
+		client.InitClient(throttle.NewConn(conn, throttle.Global()))
+	}
 
 	if *stopHook {
 		log.Printf("Stop hook!\n")
@@ -221,10 +424,16 @@ func main() {
 			TaskId: *taskIdPtr,
 			Secret: *clientTokenPtr,
 		}
+		err := lifecyclehook.RunAndReport(ctx, &taskIdentification, lifecyclehook.PreStop, *preStopHook,
+			map[string]string{"CIRRUS_TASK_ID": strconv.FormatInt(*taskIdPtr, 10)}, log.Writer())
+		if err != nil {
+			log.Println(err.Error())
+		}
+
 		request := api.ReportStopHookRequest{
 			TaskIdentification: &taskIdentification,
 		}
-		_, err = client.CirrusClient.ReportStopHook(ctx, &request)
+		_, err = client.CirrusClient.ReportStopHook(client.WithIdempotencyKey(ctx), &request)
 		if err != nil {
 			log.Printf("Failed to report stop hook for task %d: %v\n", *taskIdPtr, err)
 		} else {
@@ -235,26 +444,33 @@ func main() {
 	}
 
 	if portsToWait, ok := os.LookupEnv("CIRRUS_PORTS_WAIT_FOR"); ok {
-		ports := strings.Split(portsToWait, ",")
-
-		for _, port := range ports {
-			portNumber, err := strconv.Atoi(port)
-			if err != nil {
-				continue
-			}
+		probes, errs := network.ParseProbes(portsToWait)
+		for _, err := range errs {
+			log.Printf("Ignoring the CIRRUS_PORTS_WAIT_FOR entry: %v\n", err)
+		}
 
-			log.Printf("Waiting on port %v...\n", port)
+		for _, probe := range probes {
+			log.Printf("Waiting on %v...\n", probe)
 
-			subCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
-			network.WaitForLocalPort(subCtx, portNumber)
-			cancel()
+			if err := probe.Wait(ctx); err != nil {
+				log.Printf("Gave up waiting on %v after %v: %v\n", probe, probe.Timeout, err)
+			}
 		}
 	}
 
-	go runHeartbeat(*taskIdPtr, *clientTokenPtr, conn)
-
-	buildExecutor := executor.NewExecutor(*taskIdPtr, *clientTokenPtr, *serverTokenPtr, *commandFromPtr, *commandToPtr,
+	buildExecutor = executor.NewExecutor(*taskIdPtr, *clientTokenPtr, *serverTokenPtr, *commandFromPtr, *commandToPtr,
 		*preCreatedWorkingDir)
+	if err := buildExecutor.SetWorkingDirSnapshotMode(*workingDirSnapshot); err != nil {
+		log.Printf("Not using the working directory snapshots: %v", err)
+	}
+	buildExecutor.SetLifecycleHooks(lifecyclehook.Hooks{
+		lifecyclehook.PreStart:  *preStartHook,
+		lifecyclehook.PostClone: *postCloneHook,
+		lifecyclehook.PostTask:  *postTaskHook,
+	})
+
+	go runHeartbeat(*taskIdPtr, *clientTokenPtr, conn, buildExecutor.HeartbeatHealth)
+
 	buildExecutor.RunBuild(ctx)
 }
 
@@ -297,19 +513,26 @@ func reportSignal(ctx context.Context, sig os.Signal, taskId int64, clientToken
 	_, _ = client.CirrusClient.ReportAgentSignal(ctx, &request)
 }
 
-func dialWithTimeout(ctx context.Context, apiEndpoint string) (*grpc.ClientConn, error) {
+func dialWithTimeout(
+	ctx context.Context,
+	apiEndpoint string,
+	extraOpts ...grpc.DialOption,
+) (*grpc.ClientConn, error) {
 	ctx, cancel := context.WithTimeout(ctx, 1*time.Minute)
 	defer cancel()
 
+	target, opts := dialOptions(apiEndpoint, extraOpts...)
+
+	return grpc.DialContext(ctx, target, append(opts, grpc.WithBlock())...)
+}
+
+func dialOptions(apiEndpoint string, extraOpts ...grpc.DialOption) (string, []grpc.DialOption) {
 	target, transportSecurity := grpchelper.TransportSettingsAsDialOption(apiEndpoint)
 
 	retryCodes := []codes.Code{
 		codes.Unavailable, codes.Internal, codes.Unknown, codes.ResourceExhausted, codes.DeadlineExceeded,
 	}
-	return grpc.DialContext(
-		ctx,
-		target,
-		grpc.WithBlock(),
+	opts := []grpc.DialOption{
 		transportSecurity,
 		grpc.WithKeepaliveParams(
 			keepalive.ClientParameters{
@@ -325,17 +548,79 @@ func dialWithTimeout(ctx context.Context, apiEndpoint string) (*grpc.ClientConn,
 				grpc_retry.WithPerRetryTimeout(60*time.Second),
 			),
 		),
-	)
+	}
+
+	// gRPC's own dialer already uses the configured DNS server,
+	// but knows nothing about the host overrides
+	if network.HasHostOverrides() && !strings.HasPrefix(target, "unix:") {
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return network.DialContext(ctx, "tcp", addr)
+		}))
+	}
+
+	return target, append(opts, extraOpts...)
+}
+
+func configureThrottling(bandwidthLimit string, maxConcurrentStreams string) error {
+	var bytesPerSecond, maxStreams int64
+
+	if bandwidthLimit != "" {
+		parsed, err := humanize.ParseBytes(bandwidthLimit)
+		if err != nil {
+			return fmt.Errorf("invalid bandwidth limit %q: %w", bandwidthLimit, err)
+		}
+
+		bytesPerSecond = int64(parsed)
+	}
+
+	if maxConcurrentStreams != "" {
+		parsed, err := strconv.ParseInt(maxConcurrentStreams, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number of the concurrent streams %q: %w", maxConcurrentStreams, err)
+		}
+
+		maxStreams = parsed
+	}
+
+	throttle.Configure(bytesPerSecond, maxStreams)
+
+	return nil
+}
+
+func newGRPCWebConn(
+	endpoint string,
+	dialContext func(ctx context.Context, network string, addr string) (net.Conn, error),
+) *grpcweb.Conn {
+	// Use embedded root certificates for the same reasons as in grpchelper
+	certPool, _ := gocertifi.CACerts()
+
+	// Note that HTTP/2 is not enabled here on purpose, since that's
+	// what we're trying to avoid when falling back to gRPC-Web
+	return grpcweb.New(endpoint, &http.Client{
+		Transport: &http.Transport{
+			DialContext: dialContext,
+			TLSClientConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				RootCAs:    certPool,
+			},
+		},
+	})
 }
 
-func runHeartbeat(taskId int64, clientToken string, conn *grpc.ClientConn) {
+func runHeartbeat(
+	taskId int64,
+	clientToken string,
+	conn *grpc.ClientConn,
+	health func(ctx context.Context) *client.HeartbeatHealth,
+) {
 	taskIdentification := api.TaskIdentification{
 		TaskId: taskId,
 		Secret: clientToken,
 	}
 	for {
 		log.Println("Sending heartbeat...")
-		_, err := client.CirrusClient.Heartbeat(context.Background(), &api.HeartbeatRequest{TaskIdentification: &taskIdentification})
+		request := client.NewHeartbeatRequest(&taskIdentification, health(context.Background()))
+		_, err := client.CirrusClient.Heartbeat(context.Background(), request)
 		if err != nil {
 			log.Printf("Failed to send heartbeat: %v", err)
 			connectionState := conn.GetState()
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/network"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/preflight"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/workroot"
+	"github.com/dustin/go-humanize"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// runPreflight implements the "preflight" command, which prints a JSON report on whether
+// the host is able to run the tasks and exits with a non-zero code if any of the checks has failed.
+func runPreflight(apiEndpoint string, dnsServer string, hostsFile string, workRoot string, args []string) int {
+	defaultBinaries := "sh"
+	if runtime.GOOS == "windows" {
+		defaultBinaries = "cmd.exe"
+	}
+
+	flagSet := flag.NewFlagSet("preflight", flag.ExitOnError)
+	ntpServer := flagSet.String("ntp-server", "",
+		"NTP server to measure the clock skew against (defaults to the API endpoint's time)")
+	clockSkewThreshold := flagSet.Duration("clock-skew-threshold", 30*time.Second,
+		"maximum tolerated difference between the local and the reference clocks")
+	minFreeSpace := flagSet.String("min-free-space", "10GB",
+		"free space required in the directory the default working directories are created in")
+	requiredBinaries := flagSet.String("required-binaries", defaultBinaries,
+		"comma-separated list of the binaries that should be present in PATH")
+	timeout := flagSet.Duration("timeout", time.Minute, "time limit for all the checks")
+	_ = flagSet.Parse(args)
+
+	minFreeSpaceBytes, err := humanize.ParseBytes(*minFreeSpace)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid minimum free space %q: %v\n", *minFreeSpace, err)
+		return 2
+	}
+
+	// Check the same network and disk configuration as the one the tasks will be run with
+	if err := network.ConfigureResolver(dnsServer, hostsFile); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to configure the resolver: %v\n", err)
+		return 2
+	}
+	if err := workroot.Configure(workRoot); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to configure the work root: %v\n", err)
+		return 2
+	}
+
+	var binaries []string
+	for _, binary := range strings.Split(*requiredBinaries, ",") {
+		if binary = strings.TrimSpace(binary); binary != "" {
+			binaries = append(binaries, binary)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	report := preflight.Run(ctx, preflight.Options{
+		APIEndpoint:        apiEndpoint,
+		NTPServer:          *ntpServer,
+		ClockSkewThreshold: *clockSkewThreshold,
+		Dir:                os.TempDir(),
+		MinFreeSpace:       minFreeSpaceBytes,
+		RequiredBinaries:   binaries,
+	})
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write the report: %v\n", err)
+		return 2
+	}
+
+	if !report.Passed {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,139 @@
+package annotationserver
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// EnvCirrusArtifactsURL is the endpoint through which the user scripts can upload the artifacts mid-step.
+	EnvCirrusArtifactsURL = "CIRRUS_AGENT_ARTIFACTS_URL"
+
+	// EnvCirrusCacheURL is the endpoint through which the user scripts can upload the caches mid-step.
+	EnvCirrusCacheURL = "CIRRUS_AGENT_CACHE_URL"
+
+	// EnvCirrusAgentAPIToken should be passed in the "Authorization: Bearer" header
+	// to the endpoints above, so that only the task's scripts can trigger the uploads.
+	EnvCirrusAgentAPIToken = "CIRRUS_AGENT_API_TOKEN"
+
+	// maxActionRequestSize is more than enough for a list of paths
+	maxActionRequestSize = 1024 * 1024
+)
+
+// ErrNoStep is returned by the Actions when there's no step running to attribute the action to.
+var ErrNoStep = errors.New("no step is currently running")
+
+// Actions are the operations that the user scripts can request via the server.
+type Actions interface {
+	UploadArtifacts(request *ArtifactsRequest) error
+	UploadCache(request *CacheRequest) error
+}
+
+type ArtifactsRequest struct {
+	Name   string   `json:"name"`
+	Paths  []string `json:"paths"`
+	Type   string   `json:"type"`
+	Format string   `json:"format"`
+}
+
+type CacheRequest struct {
+	// Name of the cache previously populated by the cache instruction
+	Name string `json:"name"`
+}
+
+// SetActions enables the artifacts and cache endpoints.
+func (server *Server) SetActions(actions Actions) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.actions = actions
+}
+
+func (server *Server) ArtifactsURL() string {
+	return server.URL() + "artifacts"
+}
+
+func (server *Server) CacheURL() string {
+	return server.URL() + "cache"
+}
+
+// Token returns the secret that authorizes the requests to the artifacts and cache endpoints.
+func (server *Server) Token() string {
+	return server.token
+}
+
+func (server *Server) artifactsHandler(w http.ResponseWriter, r *http.Request) {
+	var request ArtifactsRequest
+
+	actions, ok := server.decodeActionRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	if request.Name == "" || len(request.Paths) == 0 {
+		http.Error(w, "both the artifact name and the paths should be specified", http.StatusBadRequest)
+		return
+	}
+
+	writeActionResult(w, actions.UploadArtifacts(&request))
+}
+
+func (server *Server) cacheHandler(w http.ResponseWriter, r *http.Request) {
+	var request CacheRequest
+
+	actions, ok := server.decodeActionRequest(w, r, &request)
+	if !ok {
+		return
+	}
+
+	if request.Name == "" {
+		http.Error(w, "cache name should be specified", http.StatusBadRequest)
+		return
+	}
+
+	writeActionResult(w, actions.UploadCache(&request))
+}
+
+func (server *Server) decodeActionRequest(w http.ResponseWriter, r *http.Request, request interface{}) (Actions, bool) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return nil, false
+	}
+
+	expected := "Bearer " + server.token
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(expected)) != 1 {
+		http.Error(w, fmt.Sprintf("pass the $%s in the \"Authorization: Bearer\" header", EnvCirrusAgentAPIToken),
+			http.StatusUnauthorized)
+		return nil, false
+	}
+
+	server.mutex.Lock()
+	actions := server.actions
+	server.mutex.Unlock()
+
+	if actions == nil {
+		http.Error(w, "not supported", http.StatusNotImplemented)
+		return nil, false
+	}
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxActionRequestSize)).Decode(request); err != nil {
+		http.Error(w, fmt.Sprintf("failed to parse request: %v", err), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return actions, true
+}
+
+func writeActionResult(w http.ResponseWriter, err error) {
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+	case errors.Is(err, ErrNoStep):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,63 @@
+package annotationserver_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/annotationserver"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type fakeActions struct {
+	artifacts []*annotationserver.ArtifactsRequest
+	caches    []*annotationserver.CacheRequest
+}
+
+func (actions *fakeActions) UploadArtifacts(request *annotationserver.ArtifactsRequest) error {
+	actions.artifacts = append(actions.artifacts, request)
+
+	return nil
+}
+
+func (actions *fakeActions) UploadCache(request *annotationserver.CacheRequest) error {
+	actions.caches = append(actions.caches, request)
+
+	return annotationserver.ErrNoStep
+}
+
+func TestActions(t *testing.T) {
+	server, err := annotationserver.New()
+	require.NoError(t, err)
+	defer server.Close()
+
+	post := func(url string, token string, body string) int {
+		req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	artifactsBody := `{"name": "diagnostics", "paths": ["logs/*.log"]}`
+
+	// Not enabled yet
+	require.Equal(t, http.StatusNotImplemented, post(server.ArtifactsURL(), server.Token(), artifactsBody))
+
+	actions := &fakeActions{}
+	server.SetActions(actions)
+
+	require.Equal(t, http.StatusUnauthorized, post(server.ArtifactsURL(), "wrong", artifactsBody))
+	require.Equal(t, http.StatusBadRequest, post(server.ArtifactsURL(), server.Token(), `{"name": "diagnostics"}`))
+	require.Equal(t, http.StatusOK, post(server.ArtifactsURL(), server.Token(), artifactsBody))
+	require.Equal(t, http.StatusConflict, post(server.CacheURL(), server.Token(), `{"name": "node_modules"}`))
+
+	require.Len(t, actions.artifacts, 1)
+	require.Equal(t, "diagnostics", actions.artifacts[0].Name)
+	require.Equal(t, []string{"logs/*.log"}, actions.artifacts[0].Paths)
+	require.Len(t, actions.caches, 1)
+	require.Equal(t, "node_modules", actions.caches[0].Name)
+}
@@ -0,0 +1,152 @@
+package annotationserver
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const EnvCirrusAnnotationsURL = "CIRRUS_ANNOTATIONS_URL"
+
+// maxPendingAnnotations limits the memory consumption when the user scripts
+// emit annotations faster than we forward them to the server.
+const maxPendingAnnotations = 1000
+
+// maxAnnotationSize is way more than the UI would reasonably display
+const maxAnnotationSize = 1024 * 1024
+
+type Annotation struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+	File    string `json:"file"`
+	Line    int64  `json:"line"`
+}
+
+type Server struct {
+	listener net.Listener
+	pending  []*api.Annotation
+	dropped  int
+	mutex    sync.Mutex
+
+	token   string
+	actions Actions
+}
+
+func New() (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		_ = listener.Close()
+
+		return nil, err
+	}
+
+	server := &Server{
+		listener: listener,
+		token:    hex.EncodeToString(token),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", server.handler)
+	mux.HandleFunc("/artifacts", server.artifactsHandler)
+	mux.HandleFunc("/cache", server.cacheHandler)
+
+	log.Printf("Starting annotations server %s\n", listener.Addr().String())
+	go http.Serve(listener, mux)
+
+	return server, nil
+}
+
+func (server *Server) URL() string {
+	return fmt.Sprintf("http://%s/", server.listener.Addr().String())
+}
+
+// Drain returns the annotations received since the last call to Drain()
+// and the number of annotations that were dropped due to the overflow.
+func (server *Server) Drain() ([]*api.Annotation, int) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	pending, dropped := server.pending, server.dropped
+	server.pending, server.dropped = nil, 0
+
+	return pending, dropped
+}
+
+func (server *Server) Close() error {
+	return server.listener.Close()
+}
+
+func (server *Server) handler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var annotation Annotation
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAnnotationSize)).Decode(&annotation); err != nil {
+		http.Error(w, fmt.Sprintf("failed to parse annotation: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	protoAnnotation, err := annotation.ToProto()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.mutex.Lock()
+	if len(server.pending) < maxPendingAnnotations {
+		server.pending = append(server.pending, protoAnnotation)
+	} else {
+		server.dropped++
+	}
+	server.mutex.Unlock()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (annotation *Annotation) ToProto() (*api.Annotation, error) {
+	if annotation.Message == "" {
+		return nil, fmt.Errorf("annotation message cannot be empty")
+	}
+
+	level := api.Annotation_NOTICE
+
+	if annotation.Level != "" {
+		levelValue, ok := api.Annotation_Level_value[strings.ToUpper(annotation.Level)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported annotation level %q", annotation.Level)
+		}
+
+		level = api.Annotation_Level(levelValue)
+	}
+
+	result := &api.Annotation{
+		Type:    api.Annotation_GENERIC,
+		Level:   level,
+		Message: annotation.Message,
+	}
+
+	if annotation.File != "" {
+		result.FileLocation = &api.Annotation_FileLocation{
+			Path:      annotation.File,
+			StartLine: annotation.Line,
+			EndLine:   annotation.Line,
+		}
+	}
+
+	return result, nil
+}
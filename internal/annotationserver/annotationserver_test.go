@@ -0,0 +1,43 @@
+package annotationserver_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/annotationserver"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAnnotationServer(t *testing.T) {
+	server, err := annotationserver.New()
+	require.NoError(t, err)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL(), "application/json",
+		strings.NewReader(`{"level": "warning", "message": "deprecated call", "file": "main.go", "line": 42}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+	resp, err = http.Post(server.URL(), "application/json",
+		strings.NewReader(`{"level": "unknown", "message": "bogus level"}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post(server.URL(), "application/json",
+		strings.NewReader(`{"message": "`+strings.Repeat("a", 2*1024*1024)+`"}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	annotations, dropped := server.Drain()
+	require.Zero(t, dropped)
+	require.Len(t, annotations, 1)
+	require.Equal(t, api.Annotation_WARNING, annotations[0].Level)
+	require.Equal(t, "deprecated call", annotations[0].Message)
+	require.Equal(t, "main.go", annotations[0].FileLocation.Path)
+	require.EqualValues(t, 42, annotations[0].FileLocation.StartLine)
+
+	// Subsequent drains only return new annotations
+	annotations, _ = server.Drain()
+	require.Empty(t, annotations)
+}
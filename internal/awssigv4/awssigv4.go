@@ -0,0 +1,142 @@
+// Package awssigv4 implements the subset of the AWS Signature Version 4[1] signing process
+// needed to talk to AWS APIs without pulling the whole AWS SDK.
+//
+// [1]: https://docs.aws.amazon.com/general/latest/gr/sigv4_signing.html
+package awssigv4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	algorithm = "AWS4-HMAC-SHA256"
+
+	timeFormat = "20060102T150405Z"
+	dateFormat = "20060102"
+)
+
+var ErrNoCredentials = errors.New("no AWS credentials found in the environment")
+
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// CredentialsFromEnvironment retrieves the credentials from the standard AWS environment variables.
+func CredentialsFromEnvironment() (*Credentials, error) {
+	credentials := &Credentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+
+	if credentials.AccessKeyID == "" || credentials.SecretAccessKey == "" {
+		return nil, ErrNoCredentials
+	}
+
+	return credentials, nil
+}
+
+// Sign adds the authentication headers to the request, the body should be
+// the same as the one that will be sent with the request.
+func Sign(request *http.Request, body []byte, credentials *Credentials, region, service string, now time.Time) {
+	now = now.UTC()
+
+	bodyHash := sha256.Sum256(body)
+	hexBodyHash := hex.EncodeToString(bodyHash[:])
+
+	request.Header.Set("X-Amz-Date", now.Format(timeFormat))
+	if credentials.SessionToken != "" {
+		request.Header.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+	if request.Header.Get("Host") == "" {
+		request.Header.Set("Host", request.URL.Host)
+	}
+
+	canonicalHeaders, signedHeaders := canonicalizeHeaders(request.Header)
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		canonicalPath(request.URL),
+		canonicalQuery(request.URL),
+		canonicalHeaders,
+		signedHeaders,
+		hexBodyHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(dateFormat), region, service, "aws4_request"}, "/")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	stringToSign := strings.Join([]string{
+		algorithm,
+		now.Format(timeFormat),
+		scope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), now.Format(dateFormat))
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, credentials.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalPath(u *url.URL) string {
+	path := u.EscapedPath()
+	if path == "" {
+		return "/"
+	}
+
+	return path
+}
+
+func canonicalQuery(u *url.URL) string {
+	// url.Values.Encode() sorts by key, but uses "+" for spaces, which SigV4 doesn't accept
+	return strings.ReplaceAll(u.Query().Encode(), "+", "%20")
+}
+
+func canonicalizeHeaders(header http.Header) (string, string) {
+	var names []string
+
+	for name := range header {
+		names = append(names, strings.ToLower(name))
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+
+	for _, name := range names {
+		var values []string
+
+		for _, value := range header.Values(name) {
+			values = append(values, strings.Join(strings.Fields(value), " "))
+		}
+
+		canonicalHeaders.WriteString(name + ":" + strings.Join(values, ",") + "\n")
+	}
+
+	return canonicalHeaders.String(), strings.Join(names, ";")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,105 @@
+package awssigv4
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const instanceMetadataURL = "http://169.254.169.254/latest"
+
+// CredentialsFromInstanceMetadata retrieves the temporary credentials of the EC2 instance role
+// using the Instance Metadata Service Version 2.
+func CredentialsFromInstanceMetadata(ctx context.Context) (*Credentials, error) {
+	// The metadata service is link-local and should respond almost immediately
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+
+	tokenRequest, err := http.NewRequestWithContext(ctx, http.MethodPut, instanceMetadataURL+"/api/token", nil)
+	if err != nil {
+		return nil, err
+	}
+	tokenRequest.Header.Set("X-aws-ec2-metadata-token-ttl-seconds", "60")
+
+	token, err := doMetadataRequest(httpClient, tokenRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	getMetadata := func(path string) ([]byte, error) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, instanceMetadataURL+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		request.Header.Set("X-aws-ec2-metadata-token", string(token))
+
+		return doMetadataRequest(httpClient, request)
+	}
+
+	roles, err := getMetadata("/meta-data/iam/security-credentials/")
+	if err != nil {
+		return nil, err
+	}
+
+	role := strings.TrimSpace(strings.SplitN(string(roles), "\n", 2)[0])
+	if role == "" {
+		return nil, errors.New("no IAM role is associated with the EC2 instance")
+	}
+
+	rawCredentials, err := getMetadata("/meta-data/iam/security-credentials/" + role)
+	if err != nil {
+		return nil, err
+	}
+
+	var instanceCredentials struct {
+		AccessKeyID     string `json:"AccessKeyId"`
+		SecretAccessKey string `json:"SecretAccessKey"`
+		Token           string `json:"Token"`
+	}
+
+	if err := json.Unmarshal(rawCredentials, &instanceCredentials); err != nil {
+		return nil, fmt.Errorf("failed to parse EC2 instance role credentials: %w", err)
+	}
+
+	return &Credentials{
+		AccessKeyID:     instanceCredentials.AccessKeyID,
+		SecretAccessKey: instanceCredentials.SecretAccessKey,
+		SessionToken:    instanceCredentials.Token,
+	}, nil
+}
+
+// DefaultCredentials retrieves the credentials from the environment
+// and falls back to the EC2 instance role credentials.
+func DefaultCredentials(ctx context.Context) (*Credentials, error) {
+	credentials, err := CredentialsFromEnvironment()
+	if err == nil {
+		return credentials, nil
+	}
+
+	credentials, err = CredentialsFromInstanceMetadata(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w and failed to retrieve them from the EC2 instance metadata: %v",
+			ErrNoCredentials, err)
+	}
+
+	return credentials, nil
+}
+
+func doMetadataRequest(httpClient *http.Client, request *http.Request) ([]byte, error) {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad response status from EC2 instance metadata service %d: %s",
+			response.StatusCode, response.Status)
+	}
+
+	return io.ReadAll(response.Body)
+}
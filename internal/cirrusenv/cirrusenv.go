@@ -7,9 +7,14 @@ import (
 	"github.com/google/uuid"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
+// ProgressKey is written by the scripts to report their progress (e.g. CIRRUS_PROGRESS=42),
+// it's not exported to the environment of the subsequent scripts.
+const ProgressKey = "CIRRUS_PROGRESS"
+
 type CirrusEnv struct {
 	filepath string
 }
@@ -37,6 +42,16 @@ func (ce *CirrusEnv) Path() string {
 }
 
 func (ce *CirrusEnv) Consume() (map[string]string, error) {
+	return ce.ConsumeOnto(nil)
+}
+
+// ConsumeOnto is like Consume, but additionally supports the KEY+=VALUE (append)
+// and PATH-like KEY=+VALUE (prepend) directives, which add the VALUE to the current
+// value of the variable, using the OS-specific path list separator.
+//
+// The current value is looked up in the directives processed so far, then in current
+// and finally in the agent's process environment (which is inherited by the scripts).
+func (ce *CirrusEnv) ConsumeOnto(current map[string]string) (map[string]string, error) {
 	result := map[string]string{}
 
 	fileBytes, err := os.ReadFile(ce.filepath)
@@ -47,18 +62,91 @@ func (ce *CirrusEnv) Consume() (map[string]string, error) {
 	buf := bytes.NewBuffer(fileBytes)
 	scanner := bufio.NewScanner(buf)
 
+	lookup := func(key string) (string, bool) {
+		if value, ok := result[key]; ok {
+			return value, true
+		}
+
+		if value, ok := current[key]; ok {
+			return value, true
+		}
+
+		return os.LookupEnv(key)
+	}
+
 	for scanner.Scan() {
 		splits := strings.SplitN(scanner.Text(), "=", 2)
 		if len(splits) != 2 {
 			return nil, fmt.Errorf("CIRRUS_ENV file should contain lines in KEY=VALUE format")
 		}
 
-		result[splits[0]] = splits[1]
+		key, value := splits[0], splits[1]
+
+		if key == ProgressKey {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(key, "+"):
+			key = strings.TrimSuffix(key, "+")
+			currentValue, _ := lookup(key)
+			value = joinPathList(currentValue, value, false)
+		case isPathList(key) && strings.HasPrefix(value, "+"):
+			currentValue, _ := lookup(key)
+			value = joinPathList(currentValue, strings.TrimPrefix(value, "+"), true)
+		}
+
+		result[key] = value
 	}
 
 	return result, nil
 }
 
+// Progress returns the last progress percentage reported by the script so far.
+func (ce *CirrusEnv) Progress() (int, bool, error) {
+	fileBytes, err := os.ReadFile(ce.filepath)
+	if err != nil {
+		return 0, false, err
+	}
+
+	var progress int
+	var found bool
+
+	scanner := bufio.NewScanner(bytes.NewBuffer(fileBytes))
+
+	for scanner.Scan() {
+		value := strings.TrimPrefix(scanner.Text(), ProgressKey+"=")
+		if value == scanner.Text() {
+			continue
+		}
+
+		percent, err := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "%")))
+		if err != nil || percent < 0 || percent > 100 {
+			continue
+		}
+
+		progress, found = percent, true
+	}
+
+	return progress, found, nil
+}
+
+func isPathList(key string) bool {
+	return strings.HasSuffix(strings.ToUpper(key), "PATH")
+}
+
+func joinPathList(currentValue string, value string, prepend bool) string {
+	if currentValue == "" {
+		return value
+	}
+
+	if prepend {
+		return value + string(os.PathListSeparator) + currentValue
+	}
+
+	return currentValue + string(os.PathListSeparator) + value
+}
+
 func (ce *CirrusEnv) Close() error {
 	return os.Remove(ce.Path())
 }
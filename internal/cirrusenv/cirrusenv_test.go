@@ -29,3 +29,57 @@ func TestCirrusEnvNormal(t *testing.T) {
 
 	assert.Equal(t, expected, env)
 }
+
+func TestCirrusEnvPathDirectives(t *testing.T) {
+	ce, err := cirrusenv.New(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ce.Close()
+
+	contents := "PATH+=/opt/tool/bin\nPATH=+/early/bin\nNEW_PATH+=/first\nNOT_A_LIST=+1\n"
+	if err := os.WriteFile(ce.Path(), []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	env, err := ce.ConsumeOnto(map[string]string{"PATH": "/usr/bin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sep := string(os.PathListSeparator)
+
+	expected := map[string]string{
+		"PATH":       "/early/bin" + sep + "/usr/bin" + sep + "/opt/tool/bin",
+		"NEW_PATH":   "/first",
+		"NOT_A_LIST": "+1",
+	}
+
+	assert.Equal(t, expected, env)
+}
+
+func TestCirrusEnvProgress(t *testing.T) {
+	ce, err := cirrusenv.New(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ce.Close()
+
+	_, found, err := ce.Progress()
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	contents := "CIRRUS_PROGRESS=10\nA=B\nCIRRUS_PROGRESS=42%\nCIRRUS_PROGRESS=142\nCIRRUS_PROGRESS=oops\n"
+	if err := os.WriteFile(ce.Path(), []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	progress, found, err := ce.Progress()
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 42, progress)
+
+	env, err := ce.Consume()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"A": "B"}, env)
+}
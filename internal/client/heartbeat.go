@@ -0,0 +1,35 @@
+package client
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"time"
+)
+
+// HeartbeatHealth is a lightweight health data that allows the server
+// to detect the stuck or unhealthy tasks without waiting for the final report.
+type HeartbeatHealth struct {
+	FreeDiskBytes         uint64
+	LoadAverage1m         float64
+	CurrentCommand        string
+	CurrentCommandElapsed time.Duration
+}
+
+func NewHeartbeatRequest(taskIdentification *api.TaskIdentification, health *HeartbeatHealth) *api.HeartbeatRequest {
+	request := &api.HeartbeatRequest{
+		TaskIdentification: taskIdentification,
+	}
+
+	if health == nil {
+		return request
+	}
+
+	request.FreeDiskBytes = health.FreeDiskBytes
+	request.LoadAverage_1M = health.LoadAverage1m
+
+	if health.CurrentCommand != "" {
+		request.CurrentCommand = health.CurrentCommand
+		request.CurrentCommandElapsedNanos = health.CurrentCommandElapsed.Nanoseconds()
+	}
+
+	return request
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewHeartbeatRequest(t *testing.T) {
+	taskIdentification := &api.TaskIdentification{TaskId: 42}
+
+	// No health data
+	request := NewHeartbeatRequest(taskIdentification, nil)
+	require.Zero(t, request.FreeDiskBytes)
+	require.Empty(t, request.CurrentCommand)
+
+	// Health data
+	request = NewHeartbeatRequest(taskIdentification, &HeartbeatHealth{
+		FreeDiskBytes:         1024,
+		LoadAverage1m:         1.5,
+		CurrentCommand:        "build",
+		CurrentCommandElapsed: time.Minute,
+	})
+	require.EqualValues(t, 42, request.TaskIdentification.TaskId)
+	require.EqualValues(t, 1024, request.FreeDiskBytes)
+	require.Equal(t, 1.5, request.LoadAverage_1M)
+	require.Equal(t, "build", request.CurrentCommand)
+	require.Equal(t, time.Minute.Nanoseconds(), request.CurrentCommandElapsedNanos)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+	"strconv"
+	"sync/atomic"
+)
+
+const (
+	// IdempotencyKeyHeader uniquely identifies a report, so that the server can deduplicate
+	// the requests re-sent by the retries after the ambiguous failures (e.g. a timeout)
+	IdempotencyKeyHeader = "cirrus-idempotency-key"
+
+	// SequenceHeader is monotonically increasing within an agent process, so that
+	// the server can detect the reports that arrived out of order
+	SequenceHeader = "cirrus-sequence"
+)
+
+var (
+	agentInstanceID = uuid.New().String()
+	sequence        uint64
+)
+
+// IdempotencyKey identifies a report across all the attempts of sending it.
+type IdempotencyKey struct {
+	key      string
+	sequence uint64
+}
+
+func NewIdempotencyKey() IdempotencyKey {
+	seq := atomic.AddUint64(&sequence, 1)
+
+	return IdempotencyKey{
+		key:      fmt.Sprintf("%s-%d", agentInstanceID, seq),
+		sequence: seq,
+	}
+}
+
+// Attach attaches the idempotency key and the sequence number to the outgoing context.
+func (key IdempotencyKey) Attach(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx,
+		IdempotencyKeyHeader, key.key,
+		SequenceHeader, strconv.FormatUint(key.sequence, 10),
+	)
+}
+
+// WithIdempotencyKey attaches a new idempotency key to the outgoing context,
+// which should be re-used for all the attempts of sending the same report.
+func WithIdempotencyKey(ctx context.Context) context.Context {
+	return NewIdempotencyKey().Attach(ctx)
+}
@@ -0,0 +1,25 @@
+package client_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"strconv"
+	"testing"
+)
+
+func TestWithIdempotencyKey(t *testing.T) {
+	first, ok := metadata.FromOutgoingContext(client.WithIdempotencyKey(context.Background()))
+	require.True(t, ok)
+	second, ok := metadata.FromOutgoingContext(client.WithIdempotencyKey(context.Background()))
+	require.True(t, ok)
+
+	require.NotEqual(t, first.Get(client.IdempotencyKeyHeader), second.Get(client.IdempotencyKeyHeader))
+
+	firstSequence, err := strconv.ParseUint(first.Get(client.SequenceHeader)[0], 10, 64)
+	require.NoError(t, err)
+	secondSequence, err := strconv.ParseUint(second.Get(client.SequenceHeader)[0], 10, 64)
+	require.NoError(t, err)
+	require.Greater(t, secondSequence, firstSequence)
+}
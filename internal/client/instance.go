@@ -7,6 +7,6 @@ import (
 
 var CirrusClient api.CirrusCIServiceClient
 
-func InitClient(conn *grpc.ClientConn) {
+func InitClient(conn grpc.ClientConnInterface) {
 	CirrusClient = api.NewCirrusCIServiceClient(conn)
 }
@@ -0,0 +1,31 @@
+package client
+
+import "github.com/cirruslabs/cirrus-ci-agent/api"
+
+// ProblemCategory classifies the agent's errors and warnings so that the infrastructure
+// problems (e.g. a flaky network) can be told apart from the genuine build failures.
+type ProblemCategory = api.ReportAgentProblemRequest_Category
+
+const (
+	ProblemNetwork    = api.ReportAgentProblemRequest_NETWORK
+	ProblemStorage    = api.ReportAgentProblemRequest_STORAGE
+	ProblemUserScript = api.ReportAgentProblemRequest_USER_SCRIPT
+	ProblemClone      = api.ReportAgentProblemRequest_CLONE
+	ProblemCache      = api.ReportAgentProblemRequest_CACHE
+	ProblemSecrets    = api.ReportAgentProblemRequest_SECRETS
+	// ProblemAgent covers the agent's own features and the worker's configuration.
+	ProblemAgent = api.ReportAgentProblemRequest_AGENT
+)
+
+// NewProblemRequest creates a ReportAgentProblemRequest for the message with the category attached.
+func NewProblemRequest(
+	taskIdentification *api.TaskIdentification,
+	category ProblemCategory,
+	message string,
+) *api.ReportAgentProblemRequest {
+	return &api.ReportAgentProblemRequest{
+		TaskIdentification: taskIdentification,
+		Message:            message,
+		Category:           category,
+	}
+}
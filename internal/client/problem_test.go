@@ -0,0 +1,15 @@
+package client
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestNewProblemRequest(t *testing.T) {
+	request := NewProblemRequest(&api.TaskIdentification{TaskId: 42}, ProblemCache, "failed to upload the cache")
+
+	require.EqualValues(t, 42, request.TaskIdentification.TaskId)
+	require.Equal(t, "failed to upload the cache", request.Message)
+	require.Equal(t, api.ReportAgentProblemRequest_CACHE, request.Category)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"google.golang.org/grpc/metadata"
+	"time"
+)
+
+// ServerTimestamp returns the server's time at the moment of responding and its resolution.
+//
+// The precise timestamp sent along with the response is preferred, with a fallback to the
+// response's Date header (if any) for the servers that don't send it.
+func ServerTimestamp(response *api.CommandsResponse, header metadata.MD) (time.Time, time.Duration, bool) {
+	if response.ServerTimestampNanos != 0 {
+		return time.Unix(0, response.ServerTimestampNanos), 0, true
+	}
+
+	date, err := clockskew.ParseDateHeader(header.Get("date"))
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	return date, time.Second, true
+}
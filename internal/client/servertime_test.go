@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"testing"
+	"time"
+)
+
+func TestServerTimestamp(t *testing.T) {
+	serverTime := time.Date(2023, 3, 1, 12, 0, 0, 123456789, time.UTC)
+	header := metadata.Pairs("date", "Wed, 01 Mar 2023 12:00:05 GMT")
+
+	// Precise timestamp is preferred
+	timestamp, resolution, ok := ServerTimestamp(&api.CommandsResponse{
+		ServerTimestampNanos: serverTime.UnixNano(),
+	}, header)
+	require.True(t, ok)
+	require.True(t, serverTime.Equal(timestamp))
+	require.Zero(t, resolution)
+
+	// Falls back to the Date header
+	timestamp, resolution, ok = ServerTimestamp(&api.CommandsResponse{}, header)
+	require.True(t, ok)
+	require.True(t, time.Date(2023, 3, 1, 12, 0, 5, 0, time.UTC).Equal(timestamp))
+	require.Equal(t, time.Second, resolution)
+
+	_, _, ok = ServerTimestamp(&api.CommandsResponse{}, nil)
+	require.False(t, ok)
+}
@@ -0,0 +1,56 @@
+// Package clockskew estimates how far the local clock is off from the server's (or an NTP server's)
+// clock and lets the agent produce the corrected wall-clock timestamps without touching the system
+// clock, which would require privileges and might confuse the user's scripts.
+package clockskew
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+var ErrNoServerTime = errors.New("server didn't report its time")
+
+// offset is the correction (in nanoseconds) that is added to the local
+// time to obtain the server's time, see SetOffset
+var offset int64
+
+// Now works like time.Now(), but takes the offset set by SetOffset into account.
+//
+// The monotonic clock reading is preserved, so the durations measured
+// between the two Now() calls are not affected by the correction.
+func Now() time.Time {
+	return time.Now().Add(Offset())
+}
+
+// Offset returns the currently applied correction.
+func Offset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&offset))
+}
+
+// SetOffset makes Now() return the local time corrected by the specified offset.
+func SetOffset(newOffset time.Duration) {
+	atomic.StoreInt64(&offset, int64(newOffset))
+}
+
+// Estimate returns the offset between the local and the server's clocks given the server's time
+// observed during the request that was sent at the sent and whose response was received at the
+// received local time. Like in NTP, the server's time is assumed to correspond to the middle
+// of the round-trip, so the estimation error is bounded by the half of the round-trip time
+// plus the resolution of the server's time.
+func Estimate(sent time.Time, received time.Time, serverTime time.Time) time.Duration {
+	midpoint := sent.Add(received.Sub(sent) / 2)
+
+	return serverTime.Sub(midpoint)
+}
+
+// ParseDateHeader parses the HTTP Date header value (e.g. as found in the gRPC response headers),
+// which only has a resolution of one second.
+func ParseDateHeader(values []string) (time.Time, error) {
+	if len(values) == 0 || values[0] == "" {
+		return time.Time{}, ErrNoServerTime
+	}
+
+	return http.ParseTime(values[0])
+}
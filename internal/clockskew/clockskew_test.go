@@ -0,0 +1,88 @@
+package clockskew
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestEstimate(t *testing.T) {
+	sent := time.Unix(1000, 0)
+	received := sent.Add(2 * time.Second)
+
+	assert.Equal(t, time.Minute, Estimate(sent, received, time.Unix(1061, 0)))
+	assert.Equal(t, -time.Hour, Estimate(sent, received, time.Unix(1001-3600, 0)))
+}
+
+func TestParseDateHeader(t *testing.T) {
+	serverTime, err := ParseDateHeader([]string{time.Unix(1700000000, 0).UTC().Format(http.TimeFormat)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1700000000), serverTime.Unix())
+
+	_, err = ParseDateHeader(nil)
+	assert.ErrorIs(t, err, ErrNoServerTime)
+}
+
+func TestNow(t *testing.T) {
+	defer SetOffset(0)
+
+	SetOffset(time.Hour)
+
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), Now().Unix(), 1)
+}
+
+func TestNTPTimeRoundTrip(t *testing.T) {
+	original := time.Unix(1700000000, 123456789)
+
+	b := make([]byte, 8)
+	putNTPTime(b, original)
+
+	assert.InDelta(t, original.UnixNano(), ntpTime(b).UnixNano(), 1)
+}
+
+func TestQuerySNTP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// Pretend to be an NTP server whose clock is 10 minutes ahead
+	go func() {
+		request := make([]byte, sntpPacketSize)
+
+		_, addr, err := conn.ReadFrom(request)
+		if err != nil {
+			return
+		}
+
+		serverTime := time.Now().Add(10 * time.Minute)
+
+		response := make([]byte, sntpPacketSize)
+		response[0] = 4<<3 | 4
+		response[1] = 1
+		copy(response[24:32], request[40:48])
+		putNTPTime(response[32:40], serverTime)
+		putNTPTime(response[40:48], serverTime)
+
+		_, _ = conn.WriteTo(response, addr)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	offset, err := QuerySNTP(ctx, conn.LocalAddr().String())
+	require.NoError(t, err)
+	assert.InDelta(t, (10 * time.Minute).Seconds(), offset.Seconds(), 1)
+}
+
+func TestParseSNTPResponseMismatch(t *testing.T) {
+	response := make([]byte, sntpPacketSize)
+	response[0] = 4<<3 | 4
+	response[1] = 1
+
+	_, err := parseSNTPResponse(response, []byte{1, 2, 3, 4, 5, 6, 7, 8}, time.Now(), time.Now())
+	assert.ErrorIs(t, err, ErrInvalidSNTPResponse)
+}
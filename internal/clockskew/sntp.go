@@ -0,0 +1,107 @@
+package clockskew
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	sntpPacketSize = 48
+
+	// ntpEpochOffset is the number of seconds between the NTP epoch (1900) and the Unix epoch (1970)
+	ntpEpochOffset = 2208988800
+
+	defaultSNTPTimeout = 5 * time.Second
+)
+
+var ErrInvalidSNTPResponse = errors.New("invalid SNTP response")
+
+// QuerySNTP estimates the offset between the local clock and the clock
+// of the specified NTP server (host with an optional port) using SNTPv4.
+func QuerySNTP(ctx context.Context, server string) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "udp", server)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultSNTPTimeout)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return 0, err
+	}
+
+	request := make([]byte, sntpPacketSize)
+	// LI = 0 (no warning), VN = 4, Mode = 3 (client)
+	request[0] = 0<<6 | 4<<3 | 3
+
+	sent := time.Now()
+	putNTPTime(request[40:48], sent)
+
+	if _, err := conn.Write(request); err != nil {
+		return 0, err
+	}
+
+	response := make([]byte, sntpPacketSize)
+
+	n, err := conn.Read(response)
+	if err != nil {
+		return 0, err
+	}
+	received := time.Now()
+
+	return parseSNTPResponse(response[:n], request[40:48], sent, received)
+}
+
+func parseSNTPResponse(response []byte, originate []byte, sent time.Time, received time.Time) (time.Duration, error) {
+	if len(response) < sntpPacketSize {
+		return 0, fmt.Errorf("%w: got %d bytes instead of %d", ErrInvalidSNTPResponse, len(response),
+			sntpPacketSize)
+	}
+
+	if mode := response[0] & 0x7; mode != 4 {
+		return 0, fmt.Errorf("%w: unexpected mode %d", ErrInvalidSNTPResponse, mode)
+	}
+
+	// Kiss-o'-Death packets (e.g. rate limiting) have a zero stratum
+	if stratum := response[1]; stratum == 0 {
+		return 0, fmt.Errorf("%w: server refused to respond (kiss code %q)", ErrInvalidSNTPResponse,
+			string(response[12:16]))
+	}
+
+	// Protect against the spoofed or stale responses
+	if string(response[24:32]) != string(originate) {
+		return 0, fmt.Errorf("%w: originate timestamp mismatch", ErrInvalidSNTPResponse)
+	}
+
+	serverReceived := ntpTime(response[32:40])
+	serverTransmitted := ntpTime(response[40:48])
+
+	// θ = ((T2 - T1) + (T3 - T4)) / 2
+	return (serverReceived.Sub(sent) + serverTransmitted.Sub(received)) / 2, nil
+}
+
+func ntpTime(b []byte) time.Time {
+	seconds := int64(binary.BigEndian.Uint32(b[0:4])) - ntpEpochOffset
+	fraction := int64(binary.BigEndian.Uint32(b[4:8]))
+
+	return time.Unix(seconds, (fraction*int64(time.Second))>>32)
+}
+
+func putNTPTime(b []byte, t time.Time) {
+	seconds := uint32(t.Unix() + ntpEpochOffset)
+	fraction := uint32((int64(t.Nanosecond()) << 32) / int64(time.Second))
+
+	binary.BigEndian.PutUint32(b[0:4], seconds)
+	binary.BigEndian.PutUint32(b[4:8], fraction)
+}
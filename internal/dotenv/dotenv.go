@@ -0,0 +1,152 @@
+package dotenv
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var keyRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// Parse parses the .env file contents in the commonly used format:
+//
+//	# comment
+//	export KEY=value
+//	KEY="value with \"escapes\"\nand newlines"
+//	KEY='literal value'
+//	KEY=value # trailing comment
+//
+// Double-quoted values may span multiple lines.
+func Parse(r io.Reader) (map[string]string, error) {
+	result := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		line = strings.TrimPrefix(line, "export ")
+
+		splits := strings.SplitN(line, "=", 2)
+		if len(splits) != 2 {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", lineNumber)
+		}
+
+		key := strings.TrimSpace(splits[0])
+		if !keyRegexp.MatchString(key) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", lineNumber, key)
+		}
+
+		rawValue := strings.TrimSpace(splits[1])
+
+		// Double-quoted values may continue on the following lines
+		startLine := lineNumber
+		for strings.HasPrefix(rawValue, `"`) && !hasClosingQuote(rawValue[1:], '"') {
+			if !scanner.Scan() {
+				return nil, fmt.Errorf("line %d: unterminated double-quoted value", startLine)
+			}
+			lineNumber++
+			rawValue += "\n" + scanner.Text()
+		}
+
+		value, err := parseValue(rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", startLine, err)
+		}
+
+		result[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func parseValue(rawValue string) (string, error) {
+	switch {
+	case strings.HasPrefix(rawValue, `'`):
+		end := strings.IndexByte(rawValue[1:], '\'')
+		if end == -1 {
+			return "", fmt.Errorf("unterminated single-quoted value")
+		}
+
+		if err := checkTrailer(rawValue[end+2:]); err != nil {
+			return "", err
+		}
+
+		return rawValue[1 : end+1], nil
+	case strings.HasPrefix(rawValue, `"`):
+		var sb strings.Builder
+
+		for i := 1; i < len(rawValue); i++ {
+			switch rawValue[i] {
+			case '\\':
+				if i+1 == len(rawValue) {
+					return "", fmt.Errorf("unterminated double-quoted value")
+				}
+				i++
+
+				switch rawValue[i] {
+				case 'n':
+					sb.WriteByte('\n')
+				case 'r':
+					sb.WriteByte('\r')
+				case 't':
+					sb.WriteByte('\t')
+				default:
+					sb.WriteByte(rawValue[i])
+				}
+			case '"':
+				if err := checkTrailer(rawValue[i+1:]); err != nil {
+					return "", err
+				}
+
+				return sb.String(), nil
+			default:
+				sb.WriteByte(rawValue[i])
+			}
+		}
+
+		return "", fmt.Errorf("unterminated double-quoted value")
+	default:
+		// An unquoted value ends at the first " #" comment
+		if index := strings.Index(rawValue, " #"); index != -1 {
+			rawValue = rawValue[:index]
+		}
+
+		return strings.TrimSpace(rawValue), nil
+	}
+}
+
+func hasClosingQuote(s string, quote byte) bool {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkTrailer(trailer string) error {
+	trailer = strings.TrimSpace(trailer)
+
+	if trailer != "" && !strings.HasPrefix(trailer, "#") {
+		return fmt.Errorf("unexpected characters after the quoted value: %q", trailer)
+	}
+
+	return nil
+}
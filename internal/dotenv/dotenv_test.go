@@ -0,0 +1,44 @@
+package dotenv_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/dotenv"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	const contents = `# comment
+export PLAIN=value
+SPACED = some value # trailing comment
+SINGLE='literal \n $VALUE'
+DOUBLE="escaped \"quotes\"\nnewline"
+MULTILINE="first
+second"
+EMPTY=
+`
+
+	result, err := dotenv.Parse(strings.NewReader(contents))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"PLAIN":     "value",
+		"SPACED":    "some value",
+		"SINGLE":    `literal \n $VALUE`,
+		"DOUBLE":    "escaped \"quotes\"\nnewline",
+		"MULTILINE": "first\nsecond",
+		"EMPTY":     "",
+	}, result)
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, contents := range []string{
+		"NO_EQUALS_SIGN",
+		"1INVALID=value",
+		`UNTERMINATED="value`,
+		"UNTERMINATED='value",
+		`TRAILER="value" garbage`,
+	} {
+		_, err := dotenv.Parse(strings.NewReader(contents))
+		require.Error(t, err, contents)
+	}
+}
@@ -0,0 +1,81 @@
+package environment
+
+import (
+	"sort"
+	"strings"
+)
+
+type ChangeKind int
+
+const (
+	ChangeAdded ChangeKind = iota
+	ChangeModified
+	ChangeRemoved
+)
+
+func (kind ChangeKind) String() string {
+	switch kind {
+	case ChangeAdded:
+		return "added"
+	case ChangeModified:
+		return "changed"
+	case ChangeRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+type Change struct {
+	Kind  ChangeKind
+	Key   string
+	Value string
+}
+
+// Diff returns the variables added, changed or removed in after compared to before, sorted by name.
+func Diff(before map[string]string, after map[string]string) []Change {
+	var result []Change
+
+	for key, value := range after {
+		beforeValue, ok := before[key]
+
+		switch {
+		case !ok:
+			result = append(result, Change{Kind: ChangeAdded, Key: key, Value: value})
+		case beforeValue != value:
+			result = append(result, Change{Kind: ChangeModified, Key: key, Value: value})
+		}
+	}
+
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			result = append(result, Change{Kind: ChangeRemoved, Key: key})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
+
+// Masked returns the text with the sensitive values replaced.
+func (env *Environment) Masked(text string) string {
+	for _, sensitiveValue := range env.sensitiveValues {
+		text = strings.ReplaceAll(text, sensitiveValue, "HIDDEN-BY-CIRRUS-CI")
+	}
+
+	return text
+}
+
+// Snapshot returns a copy of the variables that won't be affected by further modifications.
+func (env *Environment) Snapshot() map[string]string {
+	result := make(map[string]string, len(env.env))
+
+	for key, value := range env.env {
+		result[key] = value
+	}
+
+	return result
+}
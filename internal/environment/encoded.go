@@ -0,0 +1,56 @@
+package environment
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// MinEncodedValueLength is the length of the shortest value whose encoded variants are masked,
+// since the encodings of the shorter ones (e.g. "MQ" for "1") occur in the unrelated output too
+// often and masking them mangles the logs. The values themselves are masked regardless.
+const MinEncodedValueLength = 4
+
+// EncodedVariants returns representations of the value that tools commonly print
+// instead of the value itself: Base64, URL-encoded and JSON-escaped forms.
+//
+// Variants identical to the original value or shorter than the MinEncodedValueLength
+// are omitted, as are all variants of the values shorter than the MinEncodedValueLength.
+func EncodedVariants(value string) []string {
+	if len(value) < MinEncodedValueLength {
+		return nil
+	}
+
+	var result []string
+
+	seen := map[string]struct{}{
+		value: {},
+	}
+
+	addVariant := func(variant string) {
+		if len(variant) < MinEncodedValueLength {
+			return
+		}
+
+		if _, ok := seen[variant]; ok {
+			return
+		}
+
+		seen[variant] = struct{}{}
+		result = append(result, variant)
+	}
+
+	addVariant(base64.StdEncoding.EncodeToString([]byte(value)))
+	addVariant(base64.RawStdEncoding.EncodeToString([]byte(value)))
+	addVariant(base64.URLEncoding.EncodeToString([]byte(value)))
+	addVariant(base64.RawURLEncoding.EncodeToString([]byte(value)))
+	addVariant(url.QueryEscape(value))
+	addVariant(url.PathEscape(value))
+
+	if jsonValue, err := json.Marshal(value); err == nil {
+		addVariant(strings.TrimSuffix(strings.TrimPrefix(string(jsonValue), "\""), "\""))
+	}
+
+	return result
+}
@@ -40,6 +40,10 @@ func (env *Environment) Set(key string, value string) {
 	}
 }
 
+func (env *Environment) Unset(key string) {
+	delete(env.env, key)
+}
+
 func (env *Environment) Merge(otherEnv map[string]string, isSensitive bool) {
 	if len(otherEnv) == 0 {
 		return
@@ -35,3 +35,99 @@ func TestWellKnownSensitiveVariables(t *testing.T) {
 
 	assert.Equal(t, []string{"SHOULD be masked"}, env.SensitiveValues())
 }
+
+func TestEncodedVariants(t *testing.T) {
+	variants := environment.EncodedVariants("p@ss/word\"")
+
+	assert.Contains(t, variants, "cEBzcy93b3JkIg==")
+	assert.Contains(t, variants, "p%40ss%2Fword%22")
+	assert.Contains(t, variants, "p@ss%2Fword%22")
+	assert.Contains(t, variants, "p@ss/word\\\"")
+	assert.NotContains(t, variants, "p@ss/word\"")
+}
+
+func TestShortValuesAreMaskedWithoutEncodedVariants(t *testing.T) {
+	env := environment.New(map[string]string{})
+	env.AddSensitiveValues("", "abc", "1234")
+
+	assert.Equal(t, []string{"abc", "1234"}, env.SensitiveValues())
+
+	assert.Empty(t, environment.EncodedVariants("abc"))
+	assert.NotEmpty(t, environment.EncodedVariants("1234"))
+}
+
+func TestExpandEnvironmentTransitively(t *testing.T) {
+	result, err := environment.ExpandEnvironment(map[string]string{
+		"A": "${B}/a",
+		"B": "${C}/b",
+		"C": "/c",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"A": "/c/b/a",
+		"B": "/c/b",
+		"C": "/c",
+	}, result)
+}
+
+func TestExpandEnvironmentCycle(t *testing.T) {
+	result, err := environment.ExpandEnvironment(map[string]string{
+		"A":     "${B}/a",
+		"B":     "${A}/b",
+		"OTHER": "${C}",
+		"C":     "/c",
+	})
+
+	var cycleErr *environment.CycleError
+	assert.ErrorAs(t, err, &cycleErr)
+	assert.Equal(t, []string{"A", "B", "A"}, cycleErr.Cycle)
+	assert.Equal(t, "environment variables reference each other in a cycle: A -> B -> A", err.Error())
+
+	// Variables in a cycle are left as is, the rest is expanded
+	assert.Equal(t, "${B}/a", result["A"])
+	assert.Equal(t, "${A}/b", result["B"])
+	assert.Equal(t, "/c", result["OTHER"])
+}
+
+func TestExpandEnvironmentSelfReference(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin:/bin")
+
+	result, err := environment.ExpandEnvironment(map[string]string{
+		"PATH":        "/opt/tool/bin:${PATH}",
+		"EXTRA_PATH":  "${EXTRA_PATH}:/opt/extra/bin",
+		"TOOL_BINARY": "${PATH}/tool",
+	})
+	assert.NoError(t, err)
+
+	// Self-references are resolved through the process environment if possible,
+	// and are left as is otherwise
+	assert.Equal(t, map[string]string{
+		"PATH":        "/opt/tool/bin:/usr/bin:/bin",
+		"EXTRA_PATH":  "${EXTRA_PATH}:/opt/extra/bin",
+		"TOOL_BINARY": "/usr/bin:/bin/tool",
+	}, result)
+}
+
+func TestDiff(t *testing.T) {
+	changes := environment.Diff(map[string]string{
+		"KEPT":    "value",
+		"CHANGED": "old",
+		"REMOVED": "value",
+	}, map[string]string{
+		"KEPT":    "value",
+		"CHANGED": "new",
+		"ADDED":   "value",
+	})
+
+	assert.Equal(t, []environment.Change{
+		{Kind: environment.ChangeAdded, Key: "ADDED", Value: "value"},
+		{Kind: environment.ChangeModified, Key: "CHANGED", Value: "new"},
+		{Kind: environment.ChangeRemoved, Key: "REMOVED"},
+	}, changes)
+}
+
+func TestMasked(t *testing.T) {
+	env := environment.New(map[string]string{"API_TOKEN": "secret"})
+
+	assert.Equal(t, "token is HIDDEN-BY-CIRRUS-CI", env.Masked("token is secret"))
+}
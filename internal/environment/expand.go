@@ -1,42 +1,138 @@
 package environment
 
 import (
+	"fmt"
 	"os"
 	"regexp"
+	"sort"
 	"strings"
 )
 
+// CycleError is returned when environment variables reference each other in a cycle
+// (e.g. FOO=${BAR} and BAR=${FOO}) and thus can't be expanded.
+type CycleError struct {
+	Cycle []string
+}
+
+func (err *CycleError) Error() string {
+	return fmt.Sprintf("environment variables reference each other in a cycle: %s",
+		strings.Join(err.Cycle, " -> "))
+}
+
+// ExpandEnvironmentRecursively expands the variables referencing other variables transitively,
+// leaving the variables that are part of a cycle unexpanded.
 func ExpandEnvironmentRecursively(environment map[string]string) map[string]string {
-	result := make(map[string]string)
-	for key, value := range environment {
-		result[key] = value
+	result, _ := ExpandEnvironment(environment)
+
+	return result
+}
+
+// ExpandEnvironment expands the variables referencing other variables transitively
+// (e.g. FOO=${BAR}/x and BAR=${BAZ}/y), preferring the agent's process environment
+// when a variable is defined there too.
+//
+// Variables that are part of a cycle are left unexpanded, and the first cycle
+// (in the lexicographical order of the variable names) is returned as a *CycleError.
+// Direct self-references not resolvable through the process environment
+// (e.g. FOO=${FOO}:extra) are left unexpanded too, but aren't considered a cycle.
+func ExpandEnvironment(environment map[string]string) (map[string]string, error) {
+	expander := &recursiveExpander{
+		environment: environment,
+		result:      make(map[string]string, len(environment)),
+		visiting:    map[string]bool{},
+		cyclic:      map[string]bool{},
+	}
+
+	keys := make([]string, 0, len(environment))
+	for key := range environment {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		expander.resolve(key)
+	}
+
+	if expander.err != nil {
+		return expander.result, expander.err
 	}
-	for step := 0; step < 10; step++ {
-		var changed = false
-		for key, value := range result {
-			originalValue := result[key]
-			expandedValue := expandTextOSFirst(value, result)
-
-			selfRecursion := strings.Contains(expandedValue, "$"+key) ||
-				strings.Contains(expandedValue, "${"+key) ||
-				strings.Contains(expandedValue, "%"+key)
-			if selfRecursion {
-				// detected self-recursion
-				continue
-			}
-
-			result[key] = expandedValue
-
-			if originalValue != expandedValue {
-				changed = true
-			}
+
+	return expander.result, nil
+}
+
+type recursiveExpander struct {
+	environment map[string]string
+	result      map[string]string
+	visiting    map[string]bool
+	cyclic      map[string]bool
+	stack       []string
+	err         *CycleError
+}
+
+func (expander *recursiveExpander) resolve(key string) string {
+	if value, ok := expander.result[key]; ok {
+		return value
+	}
+
+	if expander.visiting[key] {
+		expander.markCycle(key)
+
+		return expander.environment[key]
+	}
+
+	expander.visiting[key] = true
+	expander.stack = append(expander.stack, key)
+
+	selfReference := false
+
+	expandedValue := expandTextExtended(expander.environment[key], func(name string) (string, bool) {
+		if osValue, ok := os.LookupEnv(name); ok {
+			return osValue, true
 		}
 
-		if !changed {
+		if name == key {
+			selfReference = true
+
+			return "", false
+		}
+
+		if _, ok := expander.environment[name]; !ok {
+			return "", false
+		}
+
+		return expander.resolve(name), true
+	})
+
+	expander.stack = expander.stack[:len(expander.stack)-1]
+	delete(expander.visiting, key)
+
+	if expander.cyclic[key] || selfReference {
+		expandedValue = expander.environment[key]
+	}
+
+	expander.result[key] = expandedValue
+
+	return expandedValue
+}
+
+func (expander *recursiveExpander) markCycle(key string) {
+	var cycle []string
+
+	for i := len(expander.stack) - 1; i >= 0; i-- {
+		cycle = append([]string{expander.stack[i]}, cycle...)
+
+		if expander.stack[i] == key {
 			break
 		}
 	}
-	return result
+
+	for _, name := range cycle {
+		expander.cyclic[name] = true
+	}
+
+	if expander.err == nil {
+		expander.err = &CycleError{Cycle: append(cycle, key)}
+	}
 }
 
 func (env *Environment) ExpandText(text string) string {
@@ -49,16 +145,6 @@ func (env *Environment) ExpandText(text string) string {
 	})
 }
 
-func expandTextOSFirst(text string, customEnv map[string]string) string {
-	return expandTextExtended(text, func(name string) (string, bool) {
-		if osValue, ok := os.LookupEnv(name); ok {
-			return osValue, true
-		}
-		userValue, ok := customEnv[name]
-		return userValue, ok
-	})
-}
-
 func expandTextExtended(text string, lookup func(string) (string, bool)) string {
 	var re = regexp.MustCompile(`%(\w+)%`)
 	return os.Expand(re.ReplaceAllString(text, `${$1}`), func(text string) string {
@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/avast/retry-go"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+)
+
+// reportScriptAnnotations forwards the annotations emitted by the user scripts
+// via the CIRRUS_ANNOTATIONS_URL endpoint since the last invocation.
+func (executor *Executor) reportScriptAnnotations(ctx context.Context, logUploader *LogUploader) {
+	if executor.annotationServer == nil {
+		return
+	}
+
+	annotations, dropped := executor.annotationServer.Drain()
+	if dropped != 0 {
+		fmt.Fprintf(logUploader, "\nDropped %d annotations because too many of them were emitted\n", dropped)
+	}
+
+	if len(annotations) == 0 {
+		return
+	}
+
+	// The annotations end up in the same UI as the logs
+	for _, annotation := range annotations {
+		annotation.Message = maskAnnotationMessage(logUploader, annotation.Message)
+	}
+
+	err := retry.Do(
+		func() error {
+			_, err := client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+				TaskIdentification: executor.taskIdentification,
+				Annotations:        annotations,
+			})
+			return err
+		},
+		retry.Attempts(2),
+		retry.Context(ctx),
+	)
+	if err != nil {
+		fmt.Fprintf(logUploader, "\nFailed to report %d annotations: %v. Ignoring...\n", len(annotations), err)
+
+		return
+	}
+
+	fmt.Fprintf(logUploader, "\nReported %d annotations!\n", len(annotations))
+}
+
+func maskAnnotationMessage(logUploader *LogUploader, message string) string {
+	masked := logUploader.WithMaskedSensitiveValues([]byte(message))
+
+	// Not using WithMaskedSecrets() since it tracks the findings
+	// for the log stream that's being written concurrently
+	if logUploader.SecretScanner != nil {
+		masked, _ = logUploader.SecretScanner.Mask(masked, []byte(maskReplacement))
+	}
+
+	return string(masked)
+}
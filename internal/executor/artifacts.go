@@ -1,18 +1,22 @@
 package executor
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"github.com/avast/retry-go"
 	"github.com/cirruslabs/cirrus-ci-agent/api"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/cosign"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sbom"
 	"github.com/cirruslabs/cirrus-ci-annotations"
 	"github.com/cirruslabs/cirrus-ci-annotations/model"
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
 	"os"
 )
 
@@ -25,6 +29,33 @@ func (executor *Executor) UploadArtifacts(
 	artifactsInstruction *api.ArtifactsInstruction,
 	customEnv *environment.Environment,
 ) bool {
+	executor.uploadsMtx.Lock()
+	defer executor.uploadsMtx.Unlock()
+
+	// Sign the images and the files, and upload the latter along with their signatures (if requested)
+	if artifactsInstruction.Format == cosign.Format {
+		signedInstruction, err := executor.signArtifacts(ctx, logUploader, artifactsInstruction, customEnv)
+		if err != nil {
+			fmt.Fprintf(logUploader, "Failed to sign artifacts: %v\n", err)
+
+			return false
+		}
+
+		artifactsInstruction = signedInstruction
+	}
+
+	// Upload the generated SBOM instead of the paths themselves (if requested)
+	if sbom.IsFormat(artifactsInstruction.Format) {
+		sbomInstruction, err := executor.generateSBOM(ctx, logUploader, name, artifactsInstruction, customEnv)
+		if err != nil {
+			fmt.Fprintf(logUploader, "Failed to generate SBOM: %v\n", err)
+
+			return false
+		}
+
+		artifactsInstruction = sbomInstruction
+	}
+
 	// Check if we need to upload anything at all
 	if len(artifactsInstruction.Paths) == 0 {
 		fmt.Fprintln(logUploader, "Skipping artifacts upload because there are no paths specified...")
@@ -39,30 +70,57 @@ func (executor *Executor) UploadArtifacts(
 		return false
 	}
 
-	// Upload artifacts: try first via HTTPS, then fallback via gRPC if not implemented
-	err = executor.uploadArtifactsWithRetries(ctx, NewHTTPSUploader, logUploader, artifacts)
-	if errStatus, ok := status.FromError(err); ok {
-		if errStatus.Code() == codes.Unimplemented {
-			fmt.Fprintf(logUploader, "Artifact upload via pre-signed URLs is not supported! Falling back to gRPC...\n")
-			err = executor.uploadArtifactsWithRetries(ctx, NewGRPCUploader, logUploader, artifacts)
+	// Look for the accidentally included credentials (if requested)
+	if !executor.scanArtifactsForSecrets(ctx, logUploader, artifacts, customEnv) {
+		return false
+	}
+
+	// Avoid re-uploading files with the same content (if requested)
+	if customEnv.Get(EnvArtifactsDeduplicate) == "true" {
+		if err := executor.artifactDigests.Deduplicate(artifacts); err != nil {
+			fmt.Fprintf(logUploader, "Failed to deduplicate artifacts: %v\n", err)
+
+			return false
 		}
 	}
 
-	if err != nil {
+	// Enforce artifact size budgets (if configured)
+	if !executor.checkArtifactsBudget(logUploader, artifacts, customEnv) {
+		return false
+	}
+
+	if err := executor.uploadArtifactFiles(ctx, logUploader, artifacts); err != nil {
 		fmt.Fprintf(logUploader, "Failed to upload artifacts: %s\n", err)
 		return false
 	}
 
+	executor.artifactsBytesUploaded += artifacts.TotalSize()
+	executor.artifactDigests.Commit(artifacts)
+	executor.recordProvenanceSubjects(artifacts)
+
 	// Process and upload annotations
 	if artifactsInstruction.Format != "" {
 		return executor.processAndUploadAnnotations(ctx, customEnv.Get("CIRRUS_WORKING_DIR"),
-			artifacts.UploadableFiles(), logUploader, artifactsInstruction.Format)
+			artifacts.CollectedFiles(), logUploader, artifactsInstruction.Format)
 	}
 
 	return true
 }
 
-func (executor *Executor) uploadArtifactsWithRetries(ctx context.Context, instantiateArtifactUploader InstantiateArtifactUploaderFunc, logUploader *LogUploader, artifacts *Artifacts) (err error) {
+// uploadArtifactFiles uploads the collected artifact files: first via HTTPS, then via gRPC if the former is not implemented.
+func (executor *Executor) uploadArtifactFiles(ctx context.Context, logUploader io.Writer, artifacts *Artifacts) error {
+	err := executor.uploadArtifactsWithRetries(ctx, NewHTTPSUploader, logUploader, artifacts)
+	if errStatus, ok := status.FromError(err); ok {
+		if errStatus.Code() == codes.Unimplemented {
+			fmt.Fprintf(logUploader, "Artifact upload via pre-signed URLs is not supported! Falling back to gRPC...\n")
+			err = executor.uploadArtifactsWithRetries(ctx, NewGRPCUploader, logUploader, artifacts)
+		}
+	}
+
+	return err
+}
+
+func (executor *Executor) uploadArtifactsWithRetries(ctx context.Context, instantiateArtifactUploader InstantiateArtifactUploaderFunc, logUploader io.Writer, artifacts *Artifacts) (err error) {
 	err = retry.Do(
 		func() error {
 			artifactUploader, err := instantiateArtifactUploader(ctx, executor.taskIdentification, artifacts)
@@ -114,7 +172,7 @@ func (executor *Executor) uploadArtifactsWithRetries(ctx context.Context, instan
 func uploadArtifacts(
 	ctx context.Context,
 	artifacts *Artifacts,
-	logUploader *LogUploader,
+	logUploader io.Writer,
 	artifactUploader ArtifactUploader,
 ) error {
 	for _, pattern := range artifacts.patterns {
@@ -127,6 +185,12 @@ func uploadArtifacts(
 				continue
 			}
 
+			if alias := artifactPath.aliasOf; alias != nil {
+				fmt.Fprintf(logUploader, "Skipping uploading of '%s' because it's identical to '%s' of %s artifacts\n",
+					artifactPath.absolutePath, alias.Path, alias.Artifact)
+				continue
+			}
+
 			if artifactPath.info.Size() > 100*humanize.MByte {
 				fmt.Fprintf(logUploader, "Uploading a quite hefty artifact '%s' of size %s\n",
 					artifactPath.absolutePath, humanize.Bytes(uint64(artifactPath.info.Size())))
@@ -149,6 +213,16 @@ func uploadArtifacts(
 		}
 	}
 
+	if len(artifacts.aliasesManifest) != 0 {
+		err := artifactUploader.Upload(ctx, bytes.NewReader(artifacts.aliasesManifest), ArtifactsAliasesManifestPath,
+			int64(len(artifacts.aliasesManifest)))
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(logUploader, "Uploaded %s\n", ArtifactsAliasesManifestPath)
+	}
+
 	return nil
 }
 
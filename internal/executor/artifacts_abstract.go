@@ -28,6 +28,10 @@ type Artifacts struct {
 	Type     string
 	Format   string
 	patterns []*ProcessedPattern
+
+	// JSON manifest describing the files that weren't uploaded
+	// because they're byte-identical to the already uploaded ones
+	aliasesManifest []byte
 }
 
 type ProcessedPattern struct {
@@ -39,6 +43,8 @@ type ProcessedPath struct {
 	absolutePath string
 	relativePath string
 	info         os.FileInfo
+	digest       string
+	aliasOf      *ArtifactAlias
 }
 
 func NewArtifacts(
@@ -108,6 +114,34 @@ func NewArtifacts(
 func (artifacts *Artifacts) UploadableFiles() []*api.ArtifactFileInfo {
 	var result []*api.ArtifactFileInfo
 
+	for _, pattern := range artifacts.patterns {
+		for _, path := range pattern.Paths {
+			if path.info.IsDir() || path.aliasOf != nil {
+				continue
+			}
+
+			result = append(result, &api.ArtifactFileInfo{
+				Path:        path.relativePath,
+				SizeInBytes: path.info.Size(),
+			})
+		}
+	}
+
+	if len(artifacts.aliasesManifest) != 0 {
+		result = append(result, &api.ArtifactFileInfo{
+			Path:        ArtifactsAliasesManifestPath,
+			SizeInBytes: int64(len(artifacts.aliasesManifest)),
+		})
+	}
+
+	return result
+}
+
+// CollectedFiles returns all files matched by the artifact patterns,
+// including the ones that are not going to be uploaded due to deduplication.
+func (artifacts *Artifacts) CollectedFiles() []*api.ArtifactFileInfo {
+	var result []*api.ArtifactFileInfo
+
 	for _, pattern := range artifacts.patterns {
 		for _, path := range pattern.Paths {
 			if path.info.IsDir() {
@@ -0,0 +1,126 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/dustin/go-humanize"
+	"sort"
+)
+
+const (
+	EnvArtifactsSizeBudget     = "CIRRUS_ARTIFACTS_SIZE_BUDGET"
+	EnvArtifactsTaskSizeBudget = "CIRRUS_ARTIFACTS_TASK_SIZE_BUDGET"
+	EnvArtifactsSizeBudgetFail = "CIRRUS_ARTIFACTS_SIZE_BUDGET_FAIL"
+
+	numLargestArtifactsToReport = 5
+)
+
+type ArtifactsBudget struct {
+	InstructionLimit uint64
+	TaskLimit        uint64
+	FailOnExceed     bool
+}
+
+func NewArtifactsBudgetFromEnvironment(env *environment.Environment) (*ArtifactsBudget, error) {
+	budget := &ArtifactsBudget{
+		FailOnExceed: env.Get(EnvArtifactsSizeBudgetFail) == "true",
+	}
+
+	if rawLimit, ok := env.Lookup(EnvArtifactsSizeBudget); ok {
+		limit, err := humanize.ParseBytes(rawLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", EnvArtifactsSizeBudget, err)
+		}
+		budget.InstructionLimit = limit
+	}
+
+	if rawLimit, ok := env.Lookup(EnvArtifactsTaskSizeBudget); ok {
+		limit, err := humanize.ParseBytes(rawLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", EnvArtifactsTaskSizeBudget, err)
+		}
+		budget.TaskLimit = limit
+	}
+
+	return budget, nil
+}
+
+// Violations returns a human-readable description for each budget that will be exceeded
+// once the artifacts are uploaded, taking into account the bytes already uploaded by the task.
+func (budget *ArtifactsBudget) Violations(artifacts *Artifacts, taskBytesUploaded uint64) []string {
+	var result []string
+
+	instructionSize := artifacts.TotalSize()
+
+	if budget.InstructionLimit != 0 && instructionSize > budget.InstructionLimit {
+		result = append(result, fmt.Sprintf("artifacts size %s exceeds the per-instruction budget of %s",
+			humanize.Bytes(instructionSize), humanize.Bytes(budget.InstructionLimit)))
+	}
+
+	if budget.TaskLimit != 0 && taskBytesUploaded+instructionSize > budget.TaskLimit {
+		result = append(result, fmt.Sprintf("total task artifacts size %s exceeds the per-task budget of %s",
+			humanize.Bytes(taskBytesUploaded+instructionSize), humanize.Bytes(budget.TaskLimit)))
+	}
+
+	return result
+}
+
+func (artifacts *Artifacts) TotalSize() uint64 {
+	var result uint64
+
+	for _, file := range artifacts.UploadableFiles() {
+		result += uint64(file.SizeInBytes)
+	}
+
+	return result
+}
+
+// LargestFiles returns at most n uploadable files, sorted by size in descending order.
+func (artifacts *Artifacts) LargestFiles(n int) []*api.ArtifactFileInfo {
+	result := artifacts.UploadableFiles()
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].SizeInBytes > result[j].SizeInBytes
+	})
+
+	if len(result) > n {
+		result = result[:n]
+	}
+
+	return result
+}
+
+func (executor *Executor) checkArtifactsBudget(
+	logUploader *LogUploader,
+	artifacts *Artifacts,
+	customEnv *environment.Environment,
+) bool {
+	budget, err := NewArtifactsBudgetFromEnvironment(customEnv)
+	if err != nil {
+		fmt.Fprintf(logUploader, "Failed to configure artifacts size budget: %v\n", err)
+
+		return false
+	}
+
+	violations := budget.Violations(artifacts, executor.artifactsBytesUploaded)
+	if len(violations) == 0 {
+		return true
+	}
+
+	for _, violation := range violations {
+		if budget.FailOnExceed {
+			fmt.Fprintf(logUploader, "Error: %s!\n", violation)
+		} else {
+			fmt.Fprintf(logUploader, "Warning: %s!\n", violation)
+		}
+	}
+
+	fmt.Fprintln(logUploader, "Largest artifacts:")
+
+	for _, file := range artifacts.LargestFiles(numLargestArtifactsToReport) {
+		fmt.Fprintf(logUploader, "  %s (%s)\n", file.Path, humanize.Bytes(uint64(file.SizeInBytes)))
+	}
+
+	return !budget.FailOnExceed
+}
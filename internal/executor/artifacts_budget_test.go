@@ -0,0 +1,52 @@
+package executor_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/testutil"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArtifactsBudget(t *testing.T) {
+	workingDir := testutil.TempDir(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "small.txt"), make([]byte, 10), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "large.txt"), make([]byte, 1000), 0600))
+
+	env := environment.New(map[string]string{
+		"CIRRUS_WORKING_DIR":                workingDir,
+		executor.EnvArtifactsSizeBudget:     "500B",
+		executor.EnvArtifactsTaskSizeBudget: "2000B",
+	})
+
+	artifacts, err := executor.NewArtifacts("test", &api.ArtifactsInstruction{Paths: []string{"*.txt"}}, env)
+	require.NoError(t, err)
+	require.EqualValues(t, 1010, artifacts.TotalSize())
+
+	largestFiles := artifacts.LargestFiles(1)
+	require.Len(t, largestFiles, 1)
+	require.Equal(t, "large.txt", largestFiles[0].Path)
+
+	budget, err := executor.NewArtifactsBudgetFromEnvironment(env)
+	require.NoError(t, err)
+	require.False(t, budget.FailOnExceed)
+
+	// Only the per-instruction budget is exceeded
+	require.Len(t, budget.Violations(artifacts, 0), 1)
+
+	// Both budgets are exceeded
+	require.Len(t, budget.Violations(artifacts, 1000), 2)
+}
+
+func TestArtifactsBudgetInvalid(t *testing.T) {
+	env := environment.New(map[string]string{
+		executor.EnvArtifactsSizeBudget: "not a size",
+	})
+
+	_, err := executor.NewArtifactsBudgetFromEnvironment(env)
+	require.Error(t, err)
+}
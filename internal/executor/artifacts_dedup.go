@@ -0,0 +1,127 @@
+package executor
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+)
+
+const (
+	EnvArtifactsDeduplicate = "CIRRUS_ARTIFACTS_DEDUPLICATE"
+
+	ArtifactsAliasesManifestPath = "cirrus-artifacts-aliases.json"
+)
+
+// ArtifactAlias describes an artifact file that wasn't uploaded because
+// a byte-identical file was already uploaded earlier in the same task.
+type ArtifactAlias struct {
+	Path     string `json:"path"`
+	Artifact string `json:"artifact"`
+	Target   string `json:"target"`
+}
+
+// ArtifactDigests keeps track of the content of artifact files uploaded during the task.
+type ArtifactDigests struct {
+	uploaded map[string]*ArtifactAlias
+}
+
+func NewArtifactDigests() *ArtifactDigests {
+	return &ArtifactDigests{
+		uploaded: make(map[string]*ArtifactAlias),
+	}
+}
+
+// Deduplicate hashes the collected artifact files and marks the ones whose content
+// was already uploaded (either by a previous instruction or by an overlapping pattern
+// of the same instruction) as aliases, which are then recorded in the manifest.
+func (digests *ArtifactDigests) Deduplicate(artifacts *Artifacts) error {
+	seen := make(map[string]*ArtifactAlias)
+
+	for digest, alias := range digests.uploaded {
+		seen[digest] = alias
+	}
+
+	var aliases []*ArtifactAlias
+
+	for _, pattern := range artifacts.patterns {
+		for _, path := range pattern.Paths {
+			if path.info.IsDir() {
+				continue
+			}
+
+			digest, err := fileDigest(path.absolutePath)
+			if err != nil {
+				return err
+			}
+			path.digest = digest
+
+			if original, ok := seen[digest]; ok {
+				path.aliasOf = original
+
+				aliases = append(aliases, &ArtifactAlias{
+					Path:     path.relativePath,
+					Artifact: original.Artifact,
+					Target:   original.Path,
+				})
+
+				continue
+			}
+
+			seen[digest] = &ArtifactAlias{
+				Artifact: artifacts.Name,
+				Path:     path.relativePath,
+			}
+		}
+	}
+
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	manifest, err := json.MarshalIndent(aliases, "", "  ")
+	if err != nil {
+		return err
+	}
+	artifacts.aliasesManifest = manifest
+
+	return nil
+}
+
+// Commit remembers the digests of the successfully uploaded artifact files.
+func (digests *ArtifactDigests) Commit(artifacts *Artifacts) {
+	for _, pattern := range artifacts.patterns {
+		for _, path := range pattern.Paths {
+			if path.digest == "" || path.aliasOf != nil {
+				continue
+			}
+
+			if _, ok := digests.uploaded[path.digest]; ok {
+				continue
+			}
+
+			digests.uploaded[path.digest] = &ArtifactAlias{
+				Artifact: artifacts.Name,
+				Path:     path.relativePath,
+			}
+		}
+	}
+}
+
+func fileDigest(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read artifact file %s", path)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", errors.Wrapf(err, "failed to hash artifact file %s", path)
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
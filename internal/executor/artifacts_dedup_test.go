@@ -0,0 +1,52 @@
+package executor_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/testutil"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArtifactsDeduplication(t *testing.T) {
+	workingDir := testutil.TempDir(t)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(workingDir, "build"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "build", "a.txt"), []byte("same"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "build", "b.txt"), []byte("same"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "build", "c.txt"), []byte("different"), 0600))
+
+	env := environment.New(map[string]string{
+		"CIRRUS_WORKING_DIR": workingDir,
+	})
+
+	digests := executor.NewArtifactDigests()
+
+	// Identical files within a single instruction
+	first, err := executor.NewArtifacts("first", &api.ArtifactsInstruction{Paths: []string{"build/*.txt"}}, env)
+	require.NoError(t, err)
+	require.NoError(t, digests.Deduplicate(first))
+	require.Equal(t, []string{"build/a.txt", "build/c.txt", executor.ArtifactsAliasesManifestPath},
+		uploadablePaths(first))
+	require.Len(t, first.CollectedFiles(), 3)
+	digests.Commit(first)
+
+	// Overlapping instruction whose files were all uploaded before
+	second, err := executor.NewArtifacts("second", &api.ArtifactsInstruction{Paths: []string{"build/c.txt"}}, env)
+	require.NoError(t, err)
+	require.NoError(t, digests.Deduplicate(second))
+	require.Equal(t, []string{executor.ArtifactsAliasesManifestPath}, uploadablePaths(second))
+}
+
+func uploadablePaths(artifacts *executor.Artifacts) []string {
+	var result []string
+
+	for _, file := range artifacts.UploadableFiles() {
+		result = append(result, file.Path)
+	}
+
+	return result
+}
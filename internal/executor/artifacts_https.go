@@ -7,6 +7,8 @@ import (
 	"github.com/certifi/gocertifi"
 	"github.com/cirruslabs/cirrus-ci-agent/api"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/network"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/throttle"
 	"io"
 	"net/http"
 )
@@ -37,11 +39,12 @@ func NewHTTPSUploader(
 	certPool, _ := gocertifi.CACerts()
 
 	httpClient := &http.Client{
-		Transport: &http.Transport{
+		Transport: throttle.NewTransport(&http.Transport{
+			DialContext: network.DialContext,
 			TLSClientConfig: &tls.Config{
 				RootCAs: certPool,
 			},
-		},
+		}, throttle.Global()),
 	}
 
 	// Generate URLs to which we'll upload the artifacts
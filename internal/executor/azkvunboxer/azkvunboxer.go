@@ -0,0 +1,92 @@
+package azkvunboxer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+)
+
+const (
+	DefaultVaultURLFormat = "https://%s.vault.azure.net"
+
+	apiVersion = "7.4"
+)
+
+type AZKVUnboxer struct {
+	httpClient     *http.Client
+	vaultURLFormat string
+	tokenSource    *TokenSource
+
+	cache map[string]string
+}
+
+// New creates an unboxer that reaches the vaults by substituting
+// their names into the vaultURLFormat (e.g. DefaultVaultURLFormat).
+func New(vaultURLFormat string, tokenSource *TokenSource) *AZKVUnboxer {
+	return &AZKVUnboxer{
+		httpClient:     &http.Client{Timeout: time.Minute},
+		vaultURLFormat: vaultURLFormat,
+		tokenSource:    tokenSource,
+		cache:          map[string]string{},
+	}
+}
+
+func NewFromEnvironment(env *environment.Environment) (*AZKVUnboxer, error) {
+	return New(DefaultVaultURLFormat, NewTokenSourceFromEnvironment(env)), nil
+}
+
+func (unboxer *AZKVUnboxer) Unbox(ctx context.Context, selector *BoxedValue) (string, error) {
+	secretURL := fmt.Sprintf(unboxer.vaultURLFormat, selector.vaultName) + "/secrets/" +
+		url.PathEscape(selector.secretName)
+	if selector.version != "" {
+		secretURL += "/" + url.PathEscape(selector.version)
+	}
+	secretURL += "?api-version=" + apiVersion
+
+	if value, ok := unboxer.cache[secretURL]; ok {
+		return value, nil
+	}
+
+	token, err := unboxer.tokenSource.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain Azure access token: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	response, err := unboxer.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+
+		return "", fmt.Errorf("failed to get secret %s from vault %s: %s: %s",
+			selector.secretName, selector.vaultName, response.Status, body)
+	}
+
+	var secretBundle struct {
+		Value string `json:"value"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&secretBundle); err != nil {
+		return "", fmt.Errorf("failed to parse secret %s from vault %s: %w",
+			selector.secretName, selector.vaultName, err)
+	}
+
+	unboxer.cache[secretURL] = secretBundle.Value
+
+	return secretBundle.Value, nil
+}
@@ -0,0 +1,59 @@
+package azkvunboxer_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/azkvunboxer"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBoxedValues(t *testing.T) {
+	_, err := azkvunboxer.NewBoxedValue("GCPSM[projects/my-project/secrets/my-secret/versions/latest]")
+	require.ErrorIs(t, err, azkvunboxer.ErrNotABoxedValue)
+
+	_, err = azkvunboxer.NewBoxedValue("AZKV[my-vault]")
+	require.ErrorIs(t, err, azkvunboxer.ErrInvalidBoxedValue)
+
+	_, err = azkvunboxer.NewBoxedValue("AZKV[my-vault/]")
+	require.ErrorIs(t, err, azkvunboxer.ErrInvalidBoxedValue)
+
+	_, err = azkvunboxer.NewBoxedValue("AZKV[my-vault/my-secret/version/extraneous]")
+	require.ErrorIs(t, err, azkvunboxer.ErrInvalidBoxedValue)
+}
+
+func TestUnbox(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		require.NotEmpty(t, request.URL.Query().Get("api-version"))
+
+		paths = append(paths, request.URL.Path)
+
+		fmt.Fprintf(writer, `{"value":"secret value of %s"}`, request.URL.Path)
+	}))
+	defer server.Close()
+
+	unboxer := azkvunboxer.New(server.URL+"/%s", azkvunboxer.NewStaticTokenSource("token"))
+
+	for _, trial := range []struct {
+		RawBoxedValue string
+		Expected      string
+	}{
+		{"AZKV[my-vault/my-secret]", "secret value of /my-vault/secrets/my-secret"},
+		{"AZKV[my-vault/my-secret/v1]", "secret value of /my-vault/secrets/my-secret/v1"},
+		{"AZKV[my-vault/my-secret]", "secret value of /my-vault/secrets/my-secret"},
+	} {
+		boxedValue, err := azkvunboxer.NewBoxedValue(trial.RawBoxedValue)
+		require.NoError(t, err)
+
+		value, err := unboxer.Unbox(context.Background(), boxedValue)
+		require.NoError(t, err)
+		require.Equal(t, trial.Expected, value)
+	}
+
+	require.Equal(t, []string{"/my-vault/secrets/my-secret", "/my-vault/secrets/my-secret/v1"}, paths)
+}
@@ -0,0 +1,56 @@
+package azkvunboxer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type BoxedValue struct {
+	vaultName  string
+	secretName string
+	version    string
+}
+
+const (
+	prefix = "AZKV["
+	suffix = "]"
+)
+
+var (
+	ErrNotABoxedValue    = errors.New("doesn't look like an Azure Key Vault-boxed value")
+	ErrInvalidBoxedValue = errors.New("Azure Key Vault-boxed value has an invalid format")
+)
+
+func NewBoxedValue(rawBoxedValue string) (*BoxedValue, error) {
+	if !strings.HasPrefix(rawBoxedValue, prefix) || !strings.HasSuffix(rawBoxedValue, suffix) {
+		return nil, ErrNotABoxedValue
+	}
+
+	rawBoxedValue = strings.TrimPrefix(rawBoxedValue, prefix)
+	rawBoxedValue = strings.TrimSuffix(rawBoxedValue, suffix)
+
+	// vault-name/secret-name[/version]
+	parts := strings.Split(rawBoxedValue, "/")
+	if len(parts) != 2 && len(parts) != 3 {
+		return nil, fmt.Errorf("%w: there should be a vault name, a secret name and an optional version "+
+			"separated by slashes, found %d elements", ErrInvalidBoxedValue, len(parts))
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("%w: found an empty element", ErrInvalidBoxedValue)
+		}
+	}
+
+	boxedValue := &BoxedValue{
+		vaultName:  parts[0],
+		secretName: parts[1],
+	}
+
+	if len(parts) == 3 {
+		boxedValue.version = parts[2]
+	}
+
+	return boxedValue, nil
+}
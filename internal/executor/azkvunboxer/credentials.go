@@ -0,0 +1,173 @@
+package azkvunboxer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+)
+
+const (
+	EnvAzureTenantID     = "AZURE_TENANT_ID"
+	EnvAzureClientID     = "AZURE_CLIENT_ID"
+	EnvAzureClientSecret = "AZURE_CLIENT_SECRET"
+
+	keyVaultResource = "https://vault.azure.net"
+
+	managedIdentityURL = "http://169.254.169.254/metadata/identity/oauth2/token"
+)
+
+// Refresh the access token when it has less than that left to live
+const tokenRefreshMargin = time.Minute
+
+type fetchTokenFunc func(ctx context.Context) (string, time.Duration, error)
+
+// TokenSource provides Azure AD access tokens for the Key Vault
+// and refreshes them when they're about to expire.
+type TokenSource struct {
+	fetch fetchTokenFunc
+
+	token     string
+	expiresAt time.Time
+}
+
+func NewStaticTokenSource(token string) *TokenSource {
+	return &TokenSource{
+		fetch: func(ctx context.Context) (string, time.Duration, error) {
+			return token, 0, nil
+		},
+	}
+}
+
+// NewTokenSourceFromEnvironment uses the client credentials from the AZURE_TENANT_ID,
+// AZURE_CLIENT_ID and AZURE_CLIENT_SECRET variables when available and falls back
+// to the managed identity (optionally, the user-assigned one specified by AZURE_CLIENT_ID).
+func NewTokenSourceFromEnvironment(env *environment.Environment) *TokenSource {
+	lookup := func(key string) string {
+		if value, ok := env.Lookup(key); ok {
+			return value
+		}
+
+		return os.Getenv(key)
+	}
+
+	tenantID := lookup(EnvAzureTenantID)
+	clientID := lookup(EnvAzureClientID)
+	clientSecret := lookup(EnvAzureClientSecret)
+
+	if tenantID != "" && clientID != "" && clientSecret != "" {
+		return &TokenSource{fetch: clientCredentialsToken(tenantID, clientID, clientSecret)}
+	}
+
+	return &TokenSource{fetch: managedIdentityToken(clientID)}
+}
+
+func (tokenSource *TokenSource) Token(ctx context.Context) (string, error) {
+	if tokenSource.token != "" &&
+		(tokenSource.expiresAt.IsZero() || time.Until(tokenSource.expiresAt) > tokenRefreshMargin) {
+		return tokenSource.token, nil
+	}
+
+	token, expiresIn, err := tokenSource.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tokenSource.token = token
+	tokenSource.expiresAt = time.Time{}
+
+	if expiresIn != 0 {
+		tokenSource.expiresAt = time.Now().Add(expiresIn)
+	}
+
+	return token, nil
+}
+
+func clientCredentialsToken(tenantID string, clientID string, clientSecret string) fetchTokenFunc {
+	return func(ctx context.Context) (string, time.Duration, error) {
+		form := url.Values{
+			"grant_type":    {"client_credentials"},
+			"client_id":     {clientID},
+			"client_secret": {clientSecret},
+			"scope":         {keyVaultResource + "/.default"},
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost,
+			fmt.Sprintf("https://login.microsoftonline.com/%s/oauth2/v2.0/token", url.PathEscape(tenantID)),
+			strings.NewReader(form.Encode()))
+		if err != nil {
+			return "", 0, err
+		}
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		return doTokenRequest(request)
+	}
+}
+
+func managedIdentityToken(clientID string) fetchTokenFunc {
+	return func(ctx context.Context) (string, time.Duration, error) {
+		query := url.Values{
+			"api-version": {"2018-02-01"},
+			"resource":    {keyVaultResource},
+		}
+
+		if clientID != "" {
+			query.Set("client_id", clientID)
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet,
+			managedIdentityURL+"?"+query.Encode(), nil)
+		if err != nil {
+			return "", 0, err
+		}
+		request.Header.Set("Metadata", "true")
+
+		return doTokenRequest(request)
+	}
+}
+
+func doTokenRequest(request *http.Request) (string, time.Duration, error) {
+	httpClient := &http.Client{Timeout: time.Minute}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return "", 0, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("bad response status from %s %d: %s", request.URL.Host, response.StatusCode, body)
+	}
+
+	// Managed identity endpoint returns expires_in as a string
+	var tokenResponse struct {
+		AccessToken string      `json:"access_token"`
+		ExpiresIn   json.Number `json:"expires_in"`
+	}
+
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", 0, err
+	}
+
+	if tokenResponse.AccessToken == "" {
+		return "", 0, errors.New("token endpoint returned no access token")
+	}
+
+	expiresIn, _ := strconv.Atoi(tokenResponse.ExpiresIn.String())
+
+	return tokenResponse.AccessToken, time.Duration(expiresIn) * time.Second, nil
+}
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"github.com/bmatcuk/doublestar"
 	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/hasher"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/http_cache"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/targz"
+	"io"
 	"log"
 	"net"
 	"net/http"
@@ -224,6 +226,7 @@ func (executor *Executor) tryToDownloadAndPopulateCache(
 	cacheFile, fetchDuration, err := FetchCache(ctx, logUploader, commandName, cacheHost, cacheKey)
 	if err != nil {
 		logUploader.Write([]byte(fmt.Sprintf("\nFailed to fetch archive for %s cache: %s!", commandName, err)))
+		executor.reportWarning(ctx, client.ProblemCache, fmt.Sprintf("Failed to fetch archive for %s cache: %v", commandName, err))
 		if err, ok := err.(net.Error); ok && err.Timeout() {
 			return false, true
 		} else {
@@ -349,6 +352,9 @@ func (executor *Executor) UploadCache(
 	cacheHost string,
 	instruction *api.UploadCacheInstruction,
 ) bool {
+	executor.uploadsMtx.Lock()
+	defer executor.uploadsMtx.Unlock()
+
 	var err error
 
 	cache := FindCache(instruction.CacheName)
@@ -456,6 +462,7 @@ func (executor *Executor) UploadCache(
 	err = UploadCacheFile(ctx, cacheURL, cacheFile)
 	if err != nil {
 		logUploader.Write([]byte(fmt.Sprintf("\nFailed to upload cache '%s': %s!", commandName, err)))
+		executor.reportWarning(ctx, client.ProblemCache, fmt.Sprintf("Failed to upload cache %s: %v", commandName, err))
 		logUploader.Write([]byte("\nIgnoring the error..."))
 		return true
 	}
@@ -486,6 +493,56 @@ func UploadCacheFile(ctx context.Context, cacheURL string, cacheFile *os.File) e
 	return nil
 }
 
+// downloadCacheFile downloads the cache entry into a file, returning false if there's no such entry.
+func (executor *Executor) downloadCacheFile(ctx context.Context, key string, path string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, executor.cacheFileURL(key), nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("bad response status from HTTP cache %d: %s", resp.StatusCode, resp.Status)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := io.Copy(file, resp.Body); err != nil {
+		_ = file.Close()
+
+		return false, err
+	}
+
+	return true, file.Close()
+}
+
+// uploadCacheFile uploads the file as the cache entry.
+func (executor *Executor) uploadCacheFile(ctx context.Context, key string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return UploadCacheFile(ctx, executor.cacheFileURL(key), file)
+}
+
+func (executor *Executor) cacheFileURL(key string) string {
+	return fmt.Sprintf("http://%s/%s", executor.httpCacheHost, url.PathEscape(key))
+}
+
 func FindCache(cacheName string) *Cache {
 	for i := 0; i < len(caches); i++ {
 		if caches[i].Name == cacheName {
@@ -0,0 +1,152 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"log"
+	"time"
+)
+
+const (
+	// EnvCirrusClockSkewThreshold is the maximum tolerated difference between the local and the
+	// server's clocks (e.g. "30s"), exceeding it results in a warning. "0" disables the detection.
+	EnvCirrusClockSkewThreshold = "CIRRUS_CLOCK_SKEW_THRESHOLD"
+
+	// EnvCirrusClockSkewCorrection set to "true" makes the agent correct the timestamps it produces
+	// (traces, provenance, log sink entries, request signatures) by the detected skew.
+	// The system clock is never changed.
+	EnvCirrusClockSkewCorrection = "CIRRUS_CLOCK_SKEW_CORRECTION"
+
+	// EnvCirrusNTPServer is an NTP server (e.g. "time.google.com") to measure the skew against
+	// instead of the server's time, e.g. when the server doesn't report it.
+	EnvCirrusNTPServer = "CIRRUS_NTP_SERVER"
+
+	// EnvCirrusClockSkew is exported to the scripts with the detected skew (e.g. "-2m3s"),
+	// positive when the local clock is behind.
+	EnvCirrusClockSkew = "CIRRUS_CLOCK_SKEW"
+
+	defaultClockSkewThreshold = 30 * time.Second
+	ntpQueryTimeout           = 5 * time.Second
+)
+
+// serverTimeObservation is the server's time reported in the response to a request.
+type serverTimeObservation struct {
+	sent       time.Time
+	received   time.Time
+	serverTime time.Time
+	resolution time.Duration
+}
+
+// checkClockSkew warns about the skewed local clock, which otherwise silently corrupts
+// the timing data and breaks the TLS certificate validation, and optionally corrects
+// the agent's own timestamps.
+func (executor *Executor) checkClockSkew(ctx context.Context, observation *serverTimeObservation) {
+	threshold, err := executor.clockSkewThreshold()
+	if err != nil {
+		log.Printf("Ignoring invalid clock skew configuration: %v", err)
+		threshold = defaultClockSkewThreshold
+	}
+	if threshold == 0 {
+		return
+	}
+
+	skew, uncertainty, source, err := executor.measureClockSkew(ctx, observation)
+	if err != nil {
+		log.Printf("Failed to measure the clock skew: %v", err)
+
+		return
+	}
+
+	log.Printf("Clock skew relative to the %s: %v (±%v)", source, skew, uncertainty)
+
+	executor.env.Set(EnvCirrusClockSkew, skew.String())
+
+	// Neither warn about nor correct the skew that might be within the threshold
+	if absDuration(skew)-uncertainty <= threshold {
+		return
+	}
+
+	var direction string
+	if skew > 0 {
+		direction = "behind"
+	} else {
+		direction = "ahead of"
+	}
+
+	message := fmt.Sprintf("Local clock is %v %s the %s's clock, which might break the TLS certificate "+
+		"validation and make the wall-clock timestamps unreliable (durations are measured using the monotonic "+
+		"clock and are not affected).", absDuration(skew).Round(time.Second), direction, source)
+
+	if executor.env.Get(EnvCirrusClockSkewCorrection) == "true" {
+		clockskew.SetOffset(skew)
+		message += " Timestamps produced by the agent are corrected accordingly."
+	} else {
+		message += fmt.Sprintf(" Set %s to \"true\" to correct the timestamps produced by the agent.",
+			EnvCirrusClockSkewCorrection)
+	}
+
+	log.Println(message)
+
+	executor.reportWarning(ctx, client.ProblemAgent, message)
+}
+
+// measureClockSkew returns the offset to add to the local time to get the reference time,
+// the measurement's uncertainty and the reference time source.
+func (executor *Executor) measureClockSkew(
+	ctx context.Context,
+	observation *serverTimeObservation,
+) (time.Duration, time.Duration, string, error) {
+	if ntpServer := executor.env.Get(EnvCirrusNTPServer); ntpServer != "" {
+		ntpCtx, cancel := context.WithTimeout(ctx, ntpQueryTimeout)
+		defer cancel()
+
+		skew, err := clockskew.QuerySNTP(ntpCtx, ntpServer)
+		if err == nil {
+			return skew, 0, "NTP server " + ntpServer, nil
+		}
+
+		log.Printf("Failed to query the NTP server %s, falling back to the server's time: %v", ntpServer, err)
+	}
+
+	if observation == nil || observation.serverTime.IsZero() {
+		return 0, 0, "", clockskew.ErrNoServerTime
+	}
+
+	skew := clockskew.Estimate(observation.sent, observation.received, observation.serverTime)
+
+	// The server's time is attributed to the middle of the round-trip
+	uncertainty := observation.received.Sub(observation.sent)/2 + observation.resolution
+
+	return skew, uncertainty, "server", nil
+}
+
+func (executor *Executor) clockSkewThreshold() (time.Duration, error) {
+	rawThreshold, ok := executor.env.Lookup(EnvCirrusClockSkewThreshold)
+	if !ok {
+		return defaultClockSkewThreshold, nil
+	}
+
+	if rawThreshold == "0" {
+		return 0, nil
+	}
+
+	threshold, err := time.ParseDuration(rawThreshold)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", EnvCirrusClockSkewThreshold, err)
+	}
+	if threshold < 0 {
+		return 0, fmt.Errorf("%s should not be negative", EnvCirrusClockSkewThreshold)
+	}
+
+	return threshold, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -66,6 +66,12 @@ func createWindowsPowershellCmd(cmdShell string, scripts []string) (*exec.Cmd, *
 	if err != nil {
 		return nil, nil, err
 	}
+	// Windows PowerShell reads the scripts without a BOM using the legacy code page
+	// and writes to pipes using the OEM one, both of which mangle the non-ASCII
+	// characters in the scripts and the environment variable values they output
+	scriptFile.WriteString("\uFEFF")
+	scriptFile.WriteString("[Console]::OutputEncoding = [System.Text.Encoding]::UTF8\n")
+	scriptFile.WriteString("$OutputEncoding = [System.Text.Encoding]::UTF8\n")
 	scriptFile.WriteString("$ErrorActionPreference = \"Stop\"\n")
 	scriptFile.WriteString("$ProgressPreference = \"SilentlyContinue\"\n")
 	for i := 0; i < len(scripts); i++ {
@@ -0,0 +1,108 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvCirrusComputedEnv enables the evaluation of the variables with the $(command) values.
+	EnvCirrusComputedEnv = "CIRRUS_COMPUTED_ENV"
+
+	// EnvCirrusComputedEnvSensitive is a comma-separated list of the computed variables
+	// whose values should be masked in the logs.
+	EnvCirrusComputedEnvSensitive = "CIRRUS_COMPUTED_ENV_SENSITIVE"
+
+	computedEnvTimeout = time.Minute
+)
+
+var computedValueRegexp = regexp.MustCompile(`(?s)^\$\((.+)\)$`)
+
+// computeEnvironment replaces the values in the $(command) form with the trimmed output
+// of the command, which is evaluated once before running the first step.
+//
+// Only the values consisting entirely of $(...) are evaluated, and only when
+// CIRRUS_COMPUTED_ENV is "true", otherwise they're passed to the scripts as is.
+func computeEnvironment(ctx context.Context, env *environment.Environment) error {
+	if env.Get(EnvCirrusComputedEnv) != "true" {
+		return nil
+	}
+
+	sensitive := map[string]bool{}
+	for _, key := range strings.Split(env.Get(EnvCirrusComputedEnvSensitive), ",") {
+		sensitive[strings.TrimSpace(key)] = true
+	}
+
+	var keys []string
+	for key, value := range env.Items() {
+		if computedValueRegexp.MatchString(value) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	computed := map[string]string{}
+
+	for _, key := range keys {
+		command := computedValueRegexp.FindStringSubmatch(env.Get(key))[1]
+
+		value, err := runComputedCommand(ctx, env, command)
+		if err != nil {
+			return fmt.Errorf("failed to compute the value of %s: %w", key, err)
+		}
+
+		computed[key] = value
+	}
+
+	env.Merge(computed, false)
+
+	for key, value := range computed {
+		if sensitive[key] {
+			env.AddSensitiveValues(value)
+		}
+	}
+
+	return nil
+}
+
+func runComputedCommand(ctx context.Context, env *environment.Environment, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, computedEnvTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/c", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+
+	cmd.Env = withoutDeniedVariables(append(os.Environ(), EnvMapAsSlice(env.Items())...))
+	if workingDir, ok := env.Lookup("CIRRUS_WORKING_DIR"); ok {
+		if _, err := os.Stat(workingDir); err == nil {
+			cmd.Dir = workingDir
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if stderrText := strings.TrimSpace(stderr.String()); stderrText != "" {
+			return "", fmt.Errorf("%w: %s", err, stderrText)
+		}
+
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
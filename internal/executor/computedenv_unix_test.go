@@ -0,0 +1,47 @@
+//go:build !windows
+// +build !windows
+
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestComputeEnvironment(t *testing.T) {
+	env := environment.New(map[string]string{
+		EnvCirrusComputedEnv:          "true",
+		EnvCirrusComputedEnvSensitive: "SECRET",
+		"CIRRUS_CHANGE_IN_REPO":       "0123456789abcdef",
+		"SHORT_SHA":                   "$(echo $CIRRUS_CHANGE_IN_REPO | cut -c1-7)",
+		"SECRET":                      "$(printf '  generated\\n')",
+		"NOT_COMPUTED":                "prefix $(echo x)",
+	})
+
+	require.NoError(t, computeEnvironment(context.Background(), env))
+	require.Equal(t, "0123456", env.Get("SHORT_SHA"))
+	require.Equal(t, "generated", env.Get("SECRET"))
+	require.Equal(t, "prefix $(echo x)", env.Get("NOT_COMPUTED"))
+	require.Equal(t, []string{"generated"}, env.SensitiveValues())
+}
+
+func TestComputeEnvironmentDisabled(t *testing.T) {
+	env := environment.New(map[string]string{
+		"SHORT_SHA": "$(echo computed)",
+	})
+
+	require.NoError(t, computeEnvironment(context.Background(), env))
+	require.Equal(t, "$(echo computed)", env.Get("SHORT_SHA"))
+}
+
+func TestComputeEnvironmentFailure(t *testing.T) {
+	env := environment.New(map[string]string{
+		EnvCirrusComputedEnv: "true",
+		"BROKEN":             "$(echo oops >&2; exit 1)",
+	})
+
+	require.EqualError(t, computeEnvironment(context.Background(), env),
+		"failed to compute the value of BROKEN: exit status 1: oops")
+}
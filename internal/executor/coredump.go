@@ -0,0 +1,122 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/coredump"
+	"github.com/dustin/go-humanize"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// EnvCirrusCoreDumps set to "true" makes the crashing user processes produce core dumps,
+	// which are uploaded as the "crashes" artifact when a script fails with a fatal signal.
+	//
+	// On Linux, the kernel's core_pattern is only changed when the agent runs as root,
+	// on macOS the /cores directory should be writable by the user the scripts run as.
+	EnvCirrusCoreDumps = "CIRRUS_CORE_DUMPS"
+
+	// EnvCirrusCoreDumpsMaxSize limits the total uncompressed size of the uploaded core dumps (2 GiB by default).
+	EnvCirrusCoreDumpsMaxSize = "CIRRUS_CORE_DUMPS_MAX_SIZE"
+
+	coreDumpsArtifact       = "crashes"
+	defaultCoreDumpsMaxSize = 2 * humanize.GiByte
+)
+
+// enableCoreDumps configures the core dumps (if requested), returning a function that undoes the changes.
+func (executor *Executor) enableCoreDumps(ctx context.Context) func() {
+	if executor.env.Get(EnvCirrusCoreDumps) != "true" {
+		return func() {}
+	}
+
+	dir := filepath.Join(os.TempDir(), fmt.Sprintf("cirrus-cores-%d", executor.taskIdentification.TaskId))
+
+	dirs, restore, err := coredump.Enable(dir, []string{executor.env.Get("CIRRUS_WORKING_DIR")})
+	if err != nil {
+		message := fmt.Sprintf("Core dumps requested via %s might not be collected: %v", EnvCirrusCoreDumps, err)
+		log.Println(message)
+		executor.reportWarning(ctx, client.ProblemAgent, message)
+	}
+
+	executor.coreDumpDirs = dirs
+
+	return func() {
+		if restore != nil {
+			restore()
+		}
+
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// uploadCoreDumps uploads the core dumps produced since the script's start
+// if the script has failed with a fatal signal.
+func (executor *Executor) uploadCoreDumps(
+	ctx context.Context,
+	logUploader *LogUploader,
+	since time.Time,
+	exitCode int,
+	signaled bool,
+) {
+	// The shells report the children killed by a signal with the 128+N exit codes
+	if len(executor.coreDumpDirs) == 0 || !(signaled || exitCode > 128) {
+		return
+	}
+
+	cores, err := coredump.Find(executor.coreDumpDirs, since)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nFailed to look for the core dumps: %v\n", err)
+
+		return
+	}
+	if len(cores) == 0 {
+		return
+	}
+
+	maxSize := uint64(defaultCoreDumpsMaxSize)
+	if rawMaxSize := executor.env.Get(EnvCirrusCoreDumpsMaxSize); rawMaxSize != "" {
+		maxSize, err = humanize.ParseBytes(rawMaxSize)
+		if err != nil {
+			_, _ = fmt.Fprintf(logUploader, "\nIgnoring invalid %s: %v\n", EnvCirrusCoreDumpsMaxSize, err)
+			maxSize = defaultCoreDumpsMaxSize
+		}
+	}
+
+	dir, err := executor.newDiagnosticsDir(coreDumpsArtifact)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nFailed to prepare the core dumps for upload: %v\n", err)
+
+		return
+	}
+
+	archive, err := os.Create(filepath.Join(dir, "cores.tar.gz"))
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nFailed to prepare the core dumps for upload: %v\n", err)
+
+		return
+	}
+
+	numIncluded, err := coredump.Archive(archive, cores, int64(maxSize))
+	_ = archive.Close()
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nFailed to archive the core dumps: %v\n", err)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "\nUploading %d of %d core dump(s) as the %q artifact (see %s inside)...\n",
+		numIncluded, len(cores), coreDumpsArtifact, coredump.HintsFile)
+
+	if !executor.uploadDiagnostics(ctx, logUploader, coreDumpsArtifact) {
+		log.Printf("Failed to upload the core dumps")
+	}
+
+	// Don't upload the same dumps again if the next script fails too
+	for _, core := range cores {
+		_ = os.Remove(core.Path)
+	}
+}
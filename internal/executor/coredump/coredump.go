@@ -0,0 +1,206 @@
+// Package coredump makes the crashing user processes produce core dumps in a known directory
+// and packs the dumps (along with the hints on how to symbolize them) into a size-capped archive.
+package coredump
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+var ErrUnsupported = errors.New("core dumps are only supported on Linux and macOS")
+
+// HintsFile is the name of the archive entry with the symbolization hints.
+const HintsFile = "HINTS.txt"
+
+// Core is a core dump file.
+type Core struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+
+	// Executable is the name of the crashed executable (if known from the file name)
+	Executable string
+}
+
+// Find returns the core dumps in the dirs (non-recursively) that were created since the specified time,
+// which are recognized by the "core" and "core.*" names used by both the Linux and macOS kernels.
+func Find(dirs []string, since time.Time) ([]Core, error) {
+	var result []Core
+
+	seen := map[string]struct{}{}
+
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			if name != "core" && !strings.HasPrefix(name, "core.") {
+				continue
+			}
+
+			path := filepath.Join(dir, name)
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+
+			info, err := entry.Info()
+			if err != nil || !info.Mode().IsRegular() || info.ModTime().Before(since) {
+				continue
+			}
+
+			result = append(result, Core{
+				Path:       path,
+				Size:       info.Size(),
+				ModTime:    info.ModTime(),
+				Executable: executableFromName(name),
+			})
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ModTime.Before(result[j].ModTime)
+	})
+
+	return result, nil
+}
+
+// Archive writes a gzip-compressed tarball with the core dumps whose total uncompressed size
+// doesn't exceed the maxSize, the skipped dumps are only mentioned in the hints.
+func Archive(w io.Writer, cores []Core, maxSize int64) (int, error) {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	var included []Core
+	var skipped []Core
+	var totalSize int64
+
+	for _, core := range cores {
+		if totalSize+core.Size > maxSize {
+			skipped = append(skipped, core)
+
+			continue
+		}
+
+		if err := addFile(tarWriter, core.Path); err != nil {
+			return 0, err
+		}
+
+		totalSize += core.Size
+		included = append(included, core)
+	}
+
+	hints := Hints(included, skipped)
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:    HintsFile,
+		Mode:    0644,
+		Size:    int64(len(hints)),
+		ModTime: time.Now(),
+	}); err != nil {
+		return 0, err
+	}
+	if _, err := io.WriteString(tarWriter, hints); err != nil {
+		return 0, err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return 0, err
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return 0, err
+	}
+
+	return len(included), nil
+}
+
+// Hints describes how to load the core dumps into a debugger.
+func Hints(included []Core, skipped []Core) string {
+	var sb strings.Builder
+
+	sb.WriteString("Load a core dump into a debugger along with the exact binary that has crashed ")
+	sb.WriteString("(built with the debug symbols) to get the symbolized backtrace:\n\n")
+
+	for _, core := range included {
+		executable := core.Executable
+		if executable == "" {
+			executable = "<executable>"
+		} else if path, err := exec.LookPath(executable); err == nil {
+			executable = path
+		}
+
+		name := filepath.Base(core.Path)
+
+		fmt.Fprintf(&sb, "%s (%d bytes, %s):\n", name, core.Size, core.ModTime.UTC().Format(time.RFC3339))
+		fmt.Fprintf(&sb, "    gdb %s %s -ex \"thread apply all bt\"\n", executable, name)
+		fmt.Fprintf(&sb, "    lldb %s --core %s -o \"bt all\"\n", executable, name)
+	}
+
+	if len(skipped) != 0 {
+		sb.WriteString("\nSkipped due to the size limit:\n")
+
+		for _, core := range skipped {
+			fmt.Fprintf(&sb, "    %s (%d bytes)\n", filepath.Base(core.Path), core.Size)
+		}
+	}
+
+	return sb.String()
+}
+
+// executableFromName extracts the executable name from the "core.<executable>.<pid>.<time>"
+// file name produced by the core_pattern set by Enable, the rest of the names don't carry it.
+func executableFromName(name string) string {
+	parts := strings.Split(name, ".")
+	if len(parts) < 4 {
+		return ""
+	}
+
+	// The executable name itself might contain dots
+	return strings.Join(parts[1:len(parts)-2], ".")
+}
+
+func addFile(tarWriter *tar.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Uname = ""
+	header.Gname = ""
+
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+
+	// The core might still be growing if the dump is in progress
+	_, err = io.CopyN(tarWriter, file, info.Size())
+
+	return err
+}
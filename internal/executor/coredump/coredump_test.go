@@ -0,0 +1,71 @@
+package coredump
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFind(t *testing.T) {
+	dir := t.TempDir()
+	since := time.Now().Add(-time.Minute)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "core.my.app.1234.1700000000"), []byte("new"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "core"), []byte("old"), 0600))
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "core"), since.Add(-time.Hour), since.Add(-time.Hour)))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "corefile.txt"), []byte("unrelated"), 0600))
+
+	cores, err := Find([]string{dir, dir, filepath.Join(dir, "missing")}, since)
+	require.NoError(t, err)
+	require.Len(t, cores, 1)
+	assert.Equal(t, "my.app", cores[0].Executable)
+	assert.EqualValues(t, 3, cores[0].Size)
+}
+
+func TestArchive(t *testing.T) {
+	dir := t.TempDir()
+
+	small := filepath.Join(dir, "core.small.1.1")
+	large := filepath.Join(dir, "core.large.2.2")
+	require.NoError(t, os.WriteFile(small, bytes.Repeat([]byte{1}, 10), 0600))
+	require.NoError(t, os.WriteFile(large, bytes.Repeat([]byte{2}, 100), 0600))
+
+	cores, err := Find([]string{dir}, time.Time{})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	numIncluded, err := Archive(&buf, cores, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 1, numIncluded)
+
+	gzipReader, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+
+	contents := map[string]string{}
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		contents[header.Name] = string(data)
+	}
+
+	assert.Contains(t, contents, "core.small.1.1")
+	assert.NotContains(t, contents, "core.large.2.2")
+	assert.Contains(t, contents[HintsFile], "core.small.1.1")
+	assert.Contains(t, contents[HintsFile], "Skipped due to the size limit:\n    core.large.2.2 (100 bytes)")
+}
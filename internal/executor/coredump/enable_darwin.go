@@ -0,0 +1,42 @@
+package coredump
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// macOSCoresDir is where the kernel puts the core dumps by default (see the kern.corefile sysctl).
+const macOSCoresDir = "/cores"
+
+// Enable lifts the core file size limit for the agent and its children, returning
+// the directories to look for the dumps in and a function that undoes the changes.
+//
+// The core dumps always end up in the /cores, which should be writable
+// by the user the scripts run as (e.g. via "sudo chmod 1777 /cores").
+func Enable(dir string, fallbackDirs []string) ([]string, func(), error) {
+	limit := &syscall.Rlimit{}
+
+	if err := syscall.Getrlimit(syscall.RLIMIT_CORE, limit); err != nil {
+		return nil, nil, err
+	}
+
+	limit.Cur = limit.Max
+
+	if err := syscall.Setrlimit(syscall.RLIMIT_CORE, limit); err != nil {
+		return nil, nil, fmt.Errorf("failed to lift the core file size limit: %w", err)
+	}
+
+	dirs := append([]string{macOSCoresDir}, fallbackDirs...)
+
+	if err := syscall.Access(macOSCoresDir, 0x2); err != nil {
+		return dirs, func() {}, fmt.Errorf("%s is not writable, the core dumps won't be produced: %w",
+			macOSCoresDir, err)
+	}
+
+	if _, err := os.Stat(dir); err == nil {
+		dirs = append(dirs, dir)
+	}
+
+	return dirs, func() {}, nil
+}
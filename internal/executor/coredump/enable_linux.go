@@ -0,0 +1,66 @@
+package coredump
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+const corePatternPath = "/proc/sys/kernel/core_pattern"
+
+// Enable lifts the core file size limit for the agent and its children and, when running as root,
+// points the kernel's core_pattern to the dir, returning the directories to look for the dumps in
+// and a function that restores the original core_pattern.
+//
+// Without root, the dumps are looked for in the dir and the fallbackDirs (e.g. the working directory,
+// where the default "core" pattern puts them), but the system-wide handlers like the systemd-coredump
+// will keep them to themselves.
+func Enable(dir string, fallbackDirs []string) ([]string, func(), error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, nil, err
+	}
+
+	// The crashing processes might be running as a different user
+	if err := os.Chmod(dir, 0777|os.ModeSticky); err != nil {
+		return nil, nil, err
+	}
+
+	// RLIM_INFINITY
+	limit := &syscall.Rlimit{Cur: ^uint64(0), Max: ^uint64(0)}
+	if err := syscall.Setrlimit(syscall.RLIMIT_CORE, limit); err != nil {
+		// Only root can raise the hard limit, raise the soft one up to it
+		if err := syscall.Getrlimit(syscall.RLIMIT_CORE, limit); err != nil {
+			return nil, nil, err
+		}
+
+		limit.Cur = limit.Max
+
+		if err := syscall.Setrlimit(syscall.RLIMIT_CORE, limit); err != nil {
+			return nil, nil, fmt.Errorf("failed to lift the core file size limit: %w", err)
+		}
+	}
+
+	dirs := append([]string{dir}, fallbackDirs...)
+
+	originalPattern, err := os.ReadFile(corePatternPath)
+	if err != nil {
+		return dirs, func() {}, nil
+	}
+
+	pattern := filepath.Join(dir, "core.%e.%p.%t")
+
+	if err := os.WriteFile(corePatternPath, []byte(pattern), 0644); err != nil {
+		if strings.HasPrefix(string(originalPattern), "|") {
+			return dirs, func() {}, fmt.Errorf("the core dumps are piped to %s and the core_pattern "+
+				"can't be changed without root", strings.TrimSpace(string(originalPattern[1:])))
+		}
+
+		return dirs, func() {}, nil
+	}
+
+	return dirs, func() {
+		_ = os.WriteFile(corePatternPath, originalPattern, 0644)
+	}, nil
+}
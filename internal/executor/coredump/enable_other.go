@@ -0,0 +1,8 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package coredump
+
+func Enable(dir string, fallbackDirs []string) ([]string, func(), error) {
+	return nil, nil, ErrUnsupported
+}
@@ -0,0 +1,141 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/bmatcuk/doublestar"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/cosign"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sigstore"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// EnvCirrusCosignKey contains the PEM-encoded private key (or a path to it) to sign with
+	// instead of the keyless signing with the task's CIRRUS_OIDC_TOKEN. Both the keys generated
+	// by "cosign generate-key-pair" (with EnvCirrusCosignPassword) and the plain ECDSA keys are supported.
+	EnvCirrusCosignKey      = "CIRRUS_COSIGN_KEY"
+	EnvCirrusCosignPassword = "CIRRUS_COSIGN_PASSWORD"
+
+	// EnvCirrusSigstoreFulcioURL and EnvCirrusSigstoreRekorURL override the public Sigstore instance,
+	// setting the latter to an empty value skips recording the signatures in the transparency log.
+	EnvCirrusSigstoreFulcioURL = "CIRRUS_SIGSTORE_FULCIO_URL"
+	EnvCirrusSigstoreRekorURL  = "CIRRUS_SIGSTORE_REKOR_URL"
+)
+
+func (executor *Executor) sigstoreClient() *sigstore.Client {
+	client := &sigstore.Client{
+		FulcioURL: sigstore.DefaultFulcioURL,
+		RekorURL:  sigstore.DefaultRekorURL,
+	}
+
+	if fulcioURL, ok := executor.env.Lookup(EnvCirrusSigstoreFulcioURL); ok {
+		client.FulcioURL = fulcioURL
+	}
+
+	if rekorURL, ok := executor.env.Lookup(EnvCirrusSigstoreRekorURL); ok {
+		client.RekorURL = rekorURL
+	}
+
+	return client
+}
+
+func (executor *Executor) cosignSigner(ctx context.Context) (*cosign.Signer, error) {
+	registry := &cosign.Registry{Credentials: cosign.DockerConfigCredentials}
+
+	rawKey, ok := executor.env.Lookup(EnvCirrusCosignKey)
+	if !ok {
+		identityToken, ok := executor.env.Lookup(EnvCirrusOIDCToken)
+		if !ok {
+			return nil, fmt.Errorf("either %s or %s is required for signing", EnvCirrusCosignKey, EnvCirrusOIDCToken)
+		}
+
+		return cosign.NewKeyless(ctx, executor.sigstoreClient(), registry, identityToken)
+	}
+
+	keyPEM := []byte(rawKey)
+	if !strings.HasPrefix(strings.TrimSpace(rawKey), "-----BEGIN") {
+		var err error
+
+		keyPEM, err = os.ReadFile(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", EnvCirrusCosignKey, err)
+		}
+	}
+
+	key, err := sigstore.LoadPrivateKey(keyPEM, []byte(executor.env.Get(EnvCirrusCosignPassword)))
+	if err != nil {
+		return nil, err
+	}
+
+	return cosign.NewWithKey(executor.sigstoreClient(), registry, key)
+}
+
+// signArtifacts signs the images (paths pinned to a digest, e.g. "ghcr.io/acme/app@sha256:...")
+// and the files matching the rest of the paths, returning the instruction that uploads
+// these files together with their signatures.
+func (executor *Executor) signArtifacts(
+	ctx context.Context,
+	logUploader *LogUploader,
+	artifactsInstruction *api.ArtifactsInstruction,
+	customEnv *environment.Environment,
+) (*api.ArtifactsInstruction, error) {
+	signer, err := executor.cosignSigner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workingDir := customEnv.Get("CIRRUS_WORKING_DIR")
+
+	result := &api.ArtifactsInstruction{
+		Type: artifactsInstruction.Type,
+	}
+
+	for _, path := range artifactsInstruction.Paths {
+		expandedPath := customEnv.ExpandText(path)
+
+		if cosign.IsImageReference(expandedPath) {
+			if err := signer.SignImage(ctx, expandedPath); err != nil {
+				return nil, fmt.Errorf("failed to sign image %s: %w", expandedPath, err)
+			}
+
+			_, _ = fmt.Fprintf(logUploader, "Signed image %s\n", expandedPath)
+
+			continue
+		}
+
+		result.Paths = append(result.Paths, path)
+
+		matches, err := doublestar.Glob(absolutePatterns(workingDir, []string{expandedPath})[0])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, match := range matches {
+			if info, err := os.Stat(match); err != nil || info.IsDir() {
+				continue
+			}
+
+			written, err := signer.SignBlob(ctx, match)
+			if err != nil {
+				return nil, fmt.Errorf("failed to sign %s: %w", match, err)
+			}
+
+			for _, writtenPath := range written {
+				relativePath, err := filepath.Rel(workingDir, writtenPath)
+				if err != nil {
+					return nil, err
+				}
+
+				result.Paths = append(result.Paths, filepath.ToSlash(relativePath))
+			}
+
+			_, _ = fmt.Fprintf(logUploader, "Signed %s\n", match)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,227 @@
+// Package cosign signs the files and the container images in a way that's compatible
+// with the cosign's "verify-blob" and "verify" commands, without having cosign installed.
+package cosign
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sigstore"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	// Format is the artifacts instruction format that requests signing
+	Format = "cosign"
+
+	SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+	SignatureAnnotation   = "dev.cosignproject.cosign/signature"
+	CertificateAnnotation = "dev.sigstore.cosign/certificate"
+	ChainAnnotation       = "dev.sigstore.cosign/chain"
+	BundleAnnotation      = "dev.sigstore.cosign/bundle"
+
+	emptyConfigMediaType = "application/vnd.oci.image.config.v1+json"
+	manifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+)
+
+type Signer struct {
+	key      *ecdsa.PrivateKey
+	sigstore *sigstore.Client
+	registry *Registry
+
+	// certificateChain is only set for the keyless signing and publicKey otherwise
+	certificateChain []string
+	publicKey        string
+}
+
+// NewKeyless returns the signer with an ephemeral key certified by Fulcio for the identity from the OIDC token.
+func NewKeyless(ctx context.Context, client *sigstore.Client, registry *Registry, identityToken string) (*Signer, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	certificateChain, err := client.RequestCertificate(ctx, key, identityToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		key:              key,
+		sigstore:         client,
+		registry:         registry,
+		certificateChain: certificateChain,
+	}, nil
+}
+
+// NewWithKey returns the signer that uses the provided key.
+func NewWithKey(client *sigstore.Client, registry *Registry, key *ecdsa.PrivateKey) (*Signer, error) {
+	publicKey, err := sigstore.MarshalPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		key:       key,
+		sigstore:  client,
+		registry:  registry,
+		publicKey: string(publicKey),
+	}, nil
+}
+
+// SignBlob signs the file and writes the base64-encoded signature next to it with the ".sig" extension
+// (and the certificate with the ".pem" extension for the keyless signing), returning the written paths.
+func (signer *Signer) SignBlob(ctx context.Context, path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, err
+	}
+
+	signature, _, err := signer.sign(ctx, hash.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	written := []string{path + ".sig"}
+
+	if err := os.WriteFile(path+".sig", []byte(base64.StdEncoding.EncodeToString(signature)), 0600); err != nil {
+		return nil, err
+	}
+
+	if len(signer.certificateChain) != 0 {
+		if err := os.WriteFile(path+".pem", []byte(signer.certificateChain[0]), 0600); err != nil {
+			return nil, err
+		}
+
+		written = append(written, path+".pem")
+	}
+
+	return written, nil
+}
+
+// SignImage pushes the signature of the image to its repository as the "sha256-<digest>.sig" tag.
+//
+// Note that the existing signatures under that tag are replaced rather than appended to.
+func (signer *Signer) SignImage(ctx context.Context, rawReference string) error {
+	reference, err := ParseReference(rawReference)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"critical": map[string]interface{}{
+			"identity": map[string]string{
+				"docker-reference": reference.Registry + "/" + reference.Repository,
+			},
+			"image": map[string]string{
+				"docker-manifest-digest": reference.Digest,
+			},
+			"type": "cosign container image signature",
+		},
+		"optional": nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(payload)
+
+	signature, entry, err := signer.sign(ctx, digest[:])
+	if err != nil {
+		return err
+	}
+
+	annotations := map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+	}
+
+	if len(signer.certificateChain) != 0 {
+		annotations[CertificateAnnotation] = signer.certificateChain[0]
+		annotations[ChainAnnotation] = strings.Join(signer.certificateChain[1:], "")
+	}
+
+	if entry != nil {
+		bundle, err := json.Marshal(map[string]interface{}{
+			"SignedEntryTimestamp": entry.Verification.SignedEntryTimestamp,
+			"Payload": map[string]interface{}{
+				"body":           entry.Body,
+				"integratedTime": entry.IntegratedTime,
+				"logIndex":       entry.LogIndex,
+				"logID":          entry.LogID,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		annotations[BundleAnnotation] = string(bundle)
+	}
+
+	configContent := []byte("{}")
+
+	configDigest, err := signer.registry.pushBlob(ctx, reference, configContent)
+	if err != nil {
+		return err
+	}
+
+	payloadDigest, err := signer.registry.pushBlob(ctx, reference, payload)
+	if err != nil {
+		return err
+	}
+
+	return signer.registry.pushManifest(ctx, reference, reference.SignatureTag(), &manifest{
+		SchemaVersion: 2,
+		MediaType:     manifestMediaType,
+		Config: descriptor{
+			MediaType: emptyConfigMediaType,
+			Size:      len(configContent),
+			Digest:    configDigest,
+		},
+		Layers: []descriptor{
+			{
+				MediaType:   SimpleSigningMediaType,
+				Size:        len(payload),
+				Digest:      payloadDigest,
+				Annotations: annotations,
+			},
+		},
+	})
+}
+
+// sign signs the SHA-256 digest and records the signature in the transparency log (if enabled)
+func (signer *Signer) sign(ctx context.Context, digest []byte) ([]byte, *sigstore.LogEntry, error) {
+	signature, err := ecdsa.SignASN1(rand.Reader, signer.key, digest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if signer.sigstore == nil || signer.sigstore.RekorURL == "" {
+		return signature, nil, nil
+	}
+
+	verifier := signer.publicKey
+	if len(signer.certificateChain) != 0 {
+		verifier = signer.certificateChain[0]
+	}
+
+	entry, err := signer.sigstore.UploadHashedRekord(ctx, digest, signature, verifier)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record the signature in the transparency log: %w", err)
+	}
+
+	return signature, entry, nil
+}
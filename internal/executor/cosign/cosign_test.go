@@ -0,0 +1,140 @@
+package cosign_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/cosign"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sigstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const digest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestParseReference(t *testing.T) {
+	for rawReference, expected := range map[string]cosign.Reference{
+		"ghcr.io/acme/app@" + digest:    {Registry: "ghcr.io", Repository: "acme/app", Digest: digest},
+		"ghcr.io/acme/app:v1@" + digest: {Registry: "ghcr.io", Repository: "acme/app", Digest: digest},
+		"localhost:5000/app@" + digest:  {Registry: "localhost:5000", Repository: "app", Digest: digest},
+		"acme/app@" + digest:            {Registry: "docker.io", Repository: "acme/app", Digest: digest},
+		"alpine@" + digest:              {Registry: "docker.io", Repository: "library/alpine", Digest: digest},
+	} {
+		reference, err := cosign.ParseReference(rawReference)
+		require.NoError(t, err, rawReference)
+		assert.Equal(t, expected, *reference, rawReference)
+	}
+
+	_, err := cosign.ParseReference("ghcr.io/acme/app:latest")
+	assert.ErrorIs(t, err, cosign.ErrInvalidReference)
+}
+
+func TestSignBlob(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := cosign.NewWithKey(&sigstore.Client{}, &cosign.Registry{}, key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "app.tar.gz")
+	require.NoError(t, os.WriteFile(path, []byte("release"), 0600))
+
+	written, err := signer.SignBlob(context.Background(), path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{path + ".sig"}, written)
+
+	encodedSignature, err := os.ReadFile(path + ".sig")
+	require.NoError(t, err)
+	signature, err := base64.StdEncoding.DecodeString(string(encodedSignature))
+	require.NoError(t, err)
+
+	contentDigest := sha256.Sum256([]byte("release"))
+	assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, contentDigest[:], signature))
+}
+
+func TestSignImage(t *testing.T) {
+	var lock sync.Mutex
+	blobs := map[string][]byte{}
+	var pushedManifest map[string]interface{}
+	var pushedTag string
+
+	var registryURL string
+
+	registry := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if request.URL.Path == "/token" {
+			assert.Equal(t, "repository:acme/app:pull,push", request.URL.Query().Get("scope"))
+			_, _ = writer.Write([]byte(`{"token": "secret"}`))
+
+			return
+		}
+
+		if request.Header.Get("Authorization") != "Bearer secret" {
+			writer.Header().Set("WWW-Authenticate",
+				fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, registryURL))
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch {
+		case request.Method == http.MethodHead:
+			writer.WriteHeader(http.StatusNotFound)
+		case request.Method == http.MethodPost && request.URL.Path == "/v2/acme/app/blobs/uploads/":
+			writer.Header().Set("Location", "/v2/acme/app/blobs/uploads/1?state=x")
+			writer.WriteHeader(http.StatusAccepted)
+		case request.Method == http.MethodPut && strings.HasPrefix(request.URL.Path, "/v2/acme/app/blobs/uploads/"):
+			content, _ := io.ReadAll(request.Body)
+			blobs[request.URL.Query().Get("digest")] = content
+			writer.WriteHeader(http.StatusCreated)
+		case request.Method == http.MethodPut && strings.HasPrefix(request.URL.Path, "/v2/acme/app/manifests/"):
+			pushedTag = strings.TrimPrefix(request.URL.Path, "/v2/acme/app/manifests/")
+			_ = json.NewDecoder(request.Body).Decode(&pushedManifest)
+			writer.WriteHeader(http.StatusCreated)
+		default:
+			writer.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer registry.Close()
+	registryURL = registry.URL
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := cosign.NewWithKey(&sigstore.Client{}, &cosign.Registry{}, key)
+	require.NoError(t, err)
+
+	host := strings.TrimPrefix(registry.URL, "http://")
+	require.NoError(t, signer.SignImage(context.Background(), host+"/acme/app@"+digest))
+
+	assert.Equal(t, strings.Replace(digest, ":", "-", 1)+".sig", pushedTag)
+	require.NotNil(t, pushedManifest)
+
+	layer := pushedManifest["layers"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, cosign.SimpleSigningMediaType, layer["mediaType"])
+
+	payload := blobs[layer["digest"].(string)]
+	assert.Contains(t, string(payload), digest)
+
+	signature, err := base64.StdEncoding.DecodeString(
+		layer["annotations"].(map[string]interface{})[cosign.SignatureAnnotation].(string))
+	require.NoError(t, err)
+
+	payloadDigest := sha256.Sum256(payload)
+	assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, payloadDigest[:], signature))
+}
@@ -0,0 +1,86 @@
+package cosign
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	dockerHubRegistry = "docker.io"
+	dockerHubHost     = "registry-1.docker.io"
+)
+
+var (
+	ErrInvalidReference = errors.New("invalid image reference")
+
+	digestRegex = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// Reference is a container image reference pinned to the manifest digest
+// (e.g. "ghcr.io/acme/app@sha256:..."), since signing a tag would be ambiguous.
+type Reference struct {
+	Registry   string
+	Repository string
+	Digest     string
+}
+
+// IsImageReference returns true if the artifacts instruction path refers to an image rather than the files.
+func IsImageReference(path string) bool {
+	return strings.Contains(path, "@sha256:")
+}
+
+func ParseReference(reference string) (*Reference, error) {
+	parts := strings.SplitN(reference, "@", 2)
+	if len(parts) != 2 || !digestRegex.MatchString(parts[1]) {
+		return nil, fmt.Errorf("%w %q: should be pinned to a sha256 digest", ErrInvalidReference, reference)
+	}
+
+	name := parts[0]
+
+	// Tag is redundant when the digest is specified
+	if idx := strings.LastIndexByte(name, ':'); idx > strings.LastIndexByte(name, '/') {
+		name = name[:idx]
+	}
+
+	result := &Reference{
+		Registry:   dockerHubRegistry,
+		Repository: name,
+		Digest:     parts[1],
+	}
+
+	// Same heuristic as Docker uses to tell the registry host from the repository namespace
+	components := strings.SplitN(name, "/", 2)
+	if len(components) == 2 && (strings.ContainsAny(components[0], ".:") || components[0] == "localhost") {
+		result.Registry = components[0]
+		result.Repository = components[1]
+	}
+
+	if result.Registry == dockerHubRegistry && !strings.Contains(result.Repository, "/") {
+		result.Repository = "library/" + result.Repository
+	}
+
+	if result.Repository == "" {
+		return nil, fmt.Errorf("%w %q: empty repository", ErrInvalidReference, reference)
+	}
+
+	return result, nil
+}
+
+// SignatureTag returns the tag under which cosign looks up the signatures of the image.
+func (reference *Reference) SignatureTag() string {
+	return strings.Replace(reference.Digest, ":", "-", 1) + ".sig"
+}
+
+func (reference *Reference) String() string {
+	return reference.Registry + "/" + reference.Repository + "@" + reference.Digest
+}
+
+func (reference *Reference) host() string {
+	if reference.Registry == dockerHubRegistry {
+		return dockerHubHost
+	}
+
+	return reference.Registry
+}
@@ -0,0 +1,307 @@
+package cosign
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var challengeParamRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// Registry is a minimal OCI distribution API client that can push the signature manifests.
+type Registry struct {
+	HTTPClient *http.Client
+
+	// Credentials returns the username and password for the registry host (if any)
+	Credentials func(registry string) (string, string)
+
+	tokens map[string]string
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Size        int               `json:"size"`
+	Digest      string            `json:"digest"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType"`
+	Config        descriptor   `json:"config"`
+	Layers        []descriptor `json:"layers"`
+}
+
+func (registry *Registry) pushBlob(ctx context.Context, reference *Reference, content []byte) (string, error) {
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+
+	response, err := registry.do(ctx, reference, http.MethodHead,
+		registry.url(reference, "/blobs/"+digest), nil, "")
+	if err != nil {
+		return "", err
+	}
+	_ = response.Body.Close()
+
+	if response.StatusCode == http.StatusOK {
+		return digest, nil
+	}
+
+	response, err = registry.do(ctx, reference, http.MethodPost,
+		registry.url(reference, "/blobs/uploads/"), nil, "")
+	if err != nil {
+		return "", err
+	}
+	_ = response.Body.Close()
+
+	if response.StatusCode != http.StatusAccepted {
+		return "", fmt.Errorf("failed to start the blob upload: HTTP %d", response.StatusCode)
+	}
+
+	location, err := response.Location()
+	if err != nil {
+		return "", fmt.Errorf("failed to start the blob upload: %w", err)
+	}
+
+	query := location.Query()
+	query.Set("digest", digest)
+	location.RawQuery = query.Encode()
+
+	response, err = registry.do(ctx, reference, http.MethodPut, location.String(), content,
+		"application/octet-stream")
+	if err != nil {
+		return "", err
+	}
+	_ = response.Body.Close()
+
+	if response.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("failed to upload the blob: HTTP %d", response.StatusCode)
+	}
+
+	return digest, nil
+}
+
+func (registry *Registry) pushManifest(ctx context.Context, reference *Reference, tag string, manifest *manifest) error {
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+
+	response, err := registry.do(ctx, reference, http.MethodPut, registry.url(reference, "/manifests/"+tag),
+		content, manifest.MediaType)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusCreated && response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+
+		return fmt.Errorf("failed to push the manifest: HTTP %d: %s", response.StatusCode,
+			strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
+
+func (registry *Registry) url(reference *Reference, path string) string {
+	scheme := "https"
+
+	// Docker also talks to the local registries over plain HTTP by default
+	host := reference.host()
+	if strings.HasPrefix(host, "localhost") || strings.HasPrefix(host, "127.0.0.1") {
+		scheme = "http"
+	}
+
+	return fmt.Sprintf("%s://%s/v2/%s%s", scheme, host, reference.Repository, path)
+}
+
+// do performs the request, authenticating once the registry asks for it
+func (registry *Registry) do(
+	ctx context.Context,
+	reference *Reference,
+	method string,
+	url string,
+	body []byte,
+	contentType string,
+) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		request, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+
+		if token, ok := registry.tokens[reference.Repository]; ok {
+			request.Header.Set("Authorization", token)
+		}
+
+		response, err := registry.httpClient().Do(request)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode != http.StatusUnauthorized || attempt != 0 {
+			return response, nil
+		}
+
+		challenge := response.Header.Get("WWW-Authenticate")
+		_ = response.Body.Close()
+
+		if err := registry.authenticate(ctx, reference, challenge); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (registry *Registry) authenticate(ctx context.Context, reference *Reference, challenge string) error {
+	if registry.tokens == nil {
+		registry.tokens = map[string]string{}
+	}
+
+	var username, password string
+	if registry.Credentials != nil {
+		username, password = registry.Credentials(reference.Registry)
+	}
+
+	if strings.HasPrefix(strings.ToLower(challenge), "basic") {
+		if username == "" {
+			return fmt.Errorf("registry %s requires the credentials", reference.Registry)
+		}
+
+		registry.tokens[reference.Repository] = "Basic " +
+			base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+
+		return nil
+	}
+
+	params := map[string]string{}
+	for _, match := range challengeParamRegex.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+
+	realm, ok := params["realm"]
+	if !ok || !strings.HasPrefix(strings.ToLower(challenge), "bearer") {
+		return fmt.Errorf("unsupported authentication challenge from %s: %q", reference.Registry, challenge)
+	}
+
+	tokenURL, err := url.Parse(realm)
+	if err != nil {
+		return err
+	}
+
+	query := tokenURL.Query()
+	if service, ok := params["service"]; ok {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull,push", reference.Repository))
+	tokenURL.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	if username != "" {
+		request.SetBasicAuth(username, password)
+	}
+
+	response, err := registry.httpClient().Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to obtain the token for %s: HTTP %d", reference.Registry, response.StatusCode)
+	}
+
+	var tokenResponse struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+		return err
+	}
+
+	token := tokenResponse.Token
+	if token == "" {
+		token = tokenResponse.AccessToken
+	}
+
+	registry.tokens[reference.Repository] = "Bearer " + token
+
+	return nil
+}
+
+func (registry *Registry) httpClient() *http.Client {
+	if registry.HTTPClient != nil {
+		return registry.HTTPClient
+	}
+
+	return http.DefaultClient
+}
+
+// DockerConfigCredentials looks up the registry credentials in the Docker's config.json,
+// credential helpers are not supported.
+func DockerConfigCredentials(registry string) (string, string) {
+	configDir := os.Getenv("DOCKER_CONFIG")
+	if configDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", ""
+		}
+
+		configDir = filepath.Join(homeDir, ".docker")
+	}
+
+	content, err := os.ReadFile(filepath.Join(configDir, "config.json"))
+	if err != nil {
+		return "", ""
+	}
+
+	var config struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+
+	if err := json.Unmarshal(content, &config); err != nil {
+		return "", ""
+	}
+
+	keys := []string{registry, "https://" + registry}
+	if registry == dockerHubRegistry {
+		keys = append(keys, "https://index.docker.io/v1/")
+	}
+
+	for _, key := range keys {
+		entry, ok := config.Auths[key]
+		if !ok {
+			continue
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			continue
+		}
+
+		credentials := strings.SplitN(string(decoded), ":", 2)
+		if len(credentials) == 2 {
+			return credentials[0], credentials[1]
+		}
+	}
+
+	return "", ""
+}
@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"log"
+	"time"
+)
+
+const crashReportTimeout = 30 * time.Second
+
+// ReportCrash reports the partial results of the task after the agent has panicked,
+// so that the server doesn't wait for the task that will never finish.
+func (executor *Executor) ReportCrash(reason interface{}) {
+	if executor.finishedReported {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), crashReportTimeout)
+	defer cancel()
+
+	message := fmt.Sprintf("Agent crashed: %v", reason)
+
+	// The command that was executing when the agent crashed will never finish
+	if command, startedAt := executor.health.currentCommand(); command != "" && executor.updateBatcher != nil {
+		executor.updateBatcher.Queue(&api.CommandResult{
+			Name:            command,
+			Status:          api.Status_FAILED,
+			DurationInNanos: time.Since(startedAt).Nanoseconds(),
+		})
+	}
+
+	// Make sure that the log of the step that was running is uploaded
+	if executor.stepLogUploader != nil {
+		_, _ = fmt.Fprintf(executor.stepLogUploader, "\n%s\n", message)
+		executor.stepLogUploader.Finalize()
+	}
+
+	for _, backgroundCommand := range executor.backgroundCommands {
+		_ = backgroundCommand.Cmd.Process.Kill()
+		_, _ = fmt.Fprintf(backgroundCommand.Logs, "\n%s\n", message)
+		backgroundCommand.Logs.Finalize()
+	}
+
+	var commandResults []*api.CommandResult
+
+	if executor.updateBatcher != nil {
+		executor.updateBatcher.Flush(ctx, executor.taskIdentification)
+		commandResults = executor.updateBatcher.History()
+	}
+
+	request := &api.ReportAgentFinishedRequest{
+		TaskIdentification:     executor.taskIdentification,
+		CacheRetrievalAttempts: executor.cacheAttempts.ToProto(),
+		CommandResults:         commandResults,
+		AgentCrashed:           true,
+		CrashReason:            fmt.Sprint(reason),
+	}
+
+	if _, err := client.CirrusClient.ReportAgentFinished(client.WithIdempotencyKey(ctx), request); err != nil {
+		log.Printf("Failed to report that the agent has finished after the crash: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/crashreport"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	crashReportsArtifact = "crash-reports"
+
+	// Heap dumps produced by WER can be huge, limit the total size of the uploaded reports
+	crashReportsMaxSize = 1024 * 1024 * 1024
+)
+
+// uploadCrashReports uploads the crash reports (macOS) and the WER minidumps (Windows)
+// that were produced since the failed step's start.
+func (executor *Executor) uploadCrashReports(ctx context.Context, logUploader *LogUploader, since time.Time) {
+	reports, err := crashreport.Find(crashreport.Locations(), since)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nFailed to look for the crash reports: %v\n", err)
+
+		return
+	}
+	if len(reports) == 0 {
+		return
+	}
+
+	dir, err := executor.newDiagnosticsDir(crashReportsArtifact)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nFailed to prepare the crash reports for upload: %v\n", err)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "\nFound %d crash report(s) produced during the step:\n", len(reports))
+
+	var totalSize int64
+
+	for _, report := range reports {
+		if totalSize+report.Size > crashReportsMaxSize {
+			_, _ = fmt.Fprintf(logUploader, "  %s (skipped, too large)\n", report.Path)
+
+			continue
+		}
+
+		if err := copyCrashReport(report.Path, filepath.Join(dir, report.Name)); err != nil {
+			_, _ = fmt.Fprintf(logUploader, "  %s (failed to copy: %v)\n", report.Path, err)
+
+			continue
+		}
+
+		totalSize += report.Size
+
+		_, _ = fmt.Fprintf(logUploader, "  %s\n", report.Path)
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "Uploading them as the %q artifact...\n", crashReportsArtifact)
+
+	if !executor.uploadDiagnostics(ctx, logUploader, crashReportsArtifact) {
+		log.Printf("Failed to upload the crash reports")
+	}
+}
+
+func copyCrashReport(src string, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	dstFile, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(dstFile, srcFile); err != nil {
+		_ = dstFile.Close()
+
+		return err
+	}
+
+	return dstFile.Close()
+}
@@ -0,0 +1,119 @@
+// Package crashreport finds the crash reports that the operating system produces for the crashed
+// processes: the DiagnosticReports on macOS and the Windows Error Reporting (WER) minidumps on Windows.
+package crashreport
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Location is a directory where the operating system stores the crash reports.
+type Location struct {
+	Dir string
+
+	// Extensions of the report files, e.g. ".crash"
+	Extensions []string
+
+	// Recursive is set when each report is stored in its own subdirectory
+	Recursive bool
+}
+
+// Report is a crash report file.
+type Report struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+
+	// Name is unique among the reports found by a single Find call
+	// and can be used to store the copies of the reports side by side
+	Name string
+}
+
+// Find returns the crash reports from the locations that were created since the specified time.
+func Find(locations []Location, since time.Time) ([]Report, error) {
+	var result []Report
+
+	seen := map[string]struct{}{}
+
+	for _, location := range locations {
+		err := filepath.WalkDir(location.Dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				// The location might not exist or be only partially readable
+				if entry == nil || entry.IsDir() {
+					return fs.SkipDir
+				}
+
+				return nil
+			}
+
+			if entry.IsDir() {
+				if path != location.Dir && !location.Recursive {
+					return fs.SkipDir
+				}
+
+				return nil
+			}
+
+			if !hasExtension(entry.Name(), location.Extensions) {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil || !info.Mode().IsRegular() || info.ModTime().Before(since) {
+				return nil
+			}
+
+			relPath, err := filepath.Rel(location.Dir, path)
+			if err != nil {
+				return nil
+			}
+
+			name := filepath.Join(filepath.Base(location.Dir), relPath)
+			if _, ok := seen[name]; ok {
+				return nil
+			}
+			seen[name] = struct{}{}
+
+			result = append(result, Report{
+				Path:    path,
+				Size:    info.Size(),
+				ModTime: info.ModTime(),
+				Name:    name,
+			})
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ModTime.Before(result[j].ModTime)
+	})
+
+	return result, nil
+}
+
+func hasExtension(name string, extensions []string) bool {
+	for _, extension := range extensions {
+		if strings.EqualFold(filepath.Ext(name), extension) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func homeDir() string {
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return dir
+}
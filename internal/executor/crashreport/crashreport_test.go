@@ -0,0 +1,45 @@
+package crashreport
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFind(t *testing.T) {
+	diagnosticReports := filepath.Join(t.TempDir(), "DiagnosticReports")
+	reportQueue := filepath.Join(t.TempDir(), "ReportQueue")
+	since := time.Now().Add(-time.Minute)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(diagnosticReports, "Retired"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(diagnosticReports, "app.crash"), []byte("new"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(diagnosticReports, "Retired", "retired.crash"), []byte("retired"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(diagnosticReports, "old.ips"), []byte("old"), 0600))
+	require.NoError(t, os.Chtimes(filepath.Join(diagnosticReports, "old.ips"), since.Add(-time.Hour), since.Add(-time.Hour)))
+	require.NoError(t, os.WriteFile(filepath.Join(diagnosticReports, "notes.txt"), []byte("unrelated"), 0600))
+
+	reportDir := filepath.Join(reportQueue, "AppCrash_app.exe_1234")
+	require.NoError(t, os.MkdirAll(reportDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(reportDir, "Report.wer"), []byte("report"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(reportDir, "memory.hdmp"), []byte("heap"), 0600))
+
+	reports, err := Find([]Location{
+		{Dir: diagnosticReports, Extensions: []string{".crash", ".ips"}},
+		{Dir: reportQueue, Extensions: []string{".wer"}, Recursive: true},
+		{Dir: filepath.Join(t.TempDir(), "missing"), Extensions: []string{".dmp"}},
+	}, since)
+	require.NoError(t, err)
+
+	var names []string
+	for _, report := range reports {
+		names = append(names, report.Name)
+	}
+
+	assert.ElementsMatch(t, []string{
+		filepath.Join("DiagnosticReports", "app.crash"),
+		filepath.Join("ReportQueue", "AppCrash_app.exe_1234", "Report.wer"),
+	}, names)
+}
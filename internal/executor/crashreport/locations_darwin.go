@@ -0,0 +1,20 @@
+package crashreport
+
+import "path/filepath"
+
+// Locations returns the per-user and system-wide DiagnosticReports directories.
+func Locations() []Location {
+	extensions := []string{".crash", ".ips", ".spin", ".hang"}
+
+	locations := []Location{
+		{Dir: "/Library/Logs/DiagnosticReports", Extensions: extensions},
+	}
+
+	if home := homeDir(); home != "" {
+		locations = append([]Location{
+			{Dir: filepath.Join(home, "Library", "Logs", "DiagnosticReports"), Extensions: extensions},
+		}, locations...)
+	}
+
+	return locations
+}
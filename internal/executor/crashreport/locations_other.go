@@ -0,0 +1,9 @@
+//go:build !darwin && !windows
+// +build !darwin,!windows
+
+package crashreport
+
+// Locations returns nothing since the crashes on the other platforms are only recorded as core dumps.
+func Locations() []Location {
+	return nil
+}
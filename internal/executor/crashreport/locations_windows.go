@@ -0,0 +1,36 @@
+package crashreport
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Locations returns the directories where Windows Error Reporting stores
+// the minidumps: the LocalDumps default and the per-report WER directories.
+func Locations() []Location {
+	var locations []Location
+
+	// See https://learn.microsoft.com/en-us/windows/win32/wer/collecting-user-mode-dumps
+	if localAppData := os.Getenv("LOCALAPPDATA"); localAppData != "" {
+		locations = append(locations, Location{
+			Dir:        filepath.Join(localAppData, "CrashDumps"),
+			Extensions: []string{".dmp"},
+		})
+		locations = append(locations, werLocations(filepath.Join(localAppData, "Microsoft", "Windows", "WER"))...)
+	}
+
+	if programData := os.Getenv("ProgramData"); programData != "" {
+		locations = append(locations, werLocations(filepath.Join(programData, "Microsoft", "Windows", "WER"))...)
+	}
+
+	return locations
+}
+
+func werLocations(werDir string) []Location {
+	extensions := []string{".wer", ".mdmp", ".dmp"}
+
+	return []Location{
+		{Dir: filepath.Join(werDir, "ReportQueue"), Extensions: extensions, Recursive: true},
+		{Dir: filepath.Join(werDir, "ReportArchive"), Extensions: extensions, Recursive: true},
+	}
+}
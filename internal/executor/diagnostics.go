@@ -0,0 +1,42 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// diagnosticsDir is relative to the CIRRUS_WORKING_DIR since the artifacts can only be uploaded from there.
+const diagnosticsDir = ".cirrus-diagnostics"
+
+// newDiagnosticsDir creates an empty directory for the diagnostic files
+// that will be uploaded as the named artifact with uploadDiagnostics.
+func (executor *Executor) newDiagnosticsDir(name string) (string, error) {
+	dir := filepath.Join(executor.env.Get("CIRRUS_WORKING_DIR"), diagnosticsDir, name)
+
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// uploadDiagnostics uploads the contents of the diagnostics directory created
+// with newDiagnosticsDir as the named artifact and removes the directory.
+func (executor *Executor) uploadDiagnostics(ctx context.Context, logUploader *LogUploader, name string) bool {
+	defer func() {
+		if err := os.RemoveAll(filepath.Join(executor.env.Get("CIRRUS_WORKING_DIR"), diagnosticsDir, name)); err != nil {
+			log.Printf("Failed to clean up the %s diagnostics: %v", name, err)
+		}
+	}()
+
+	return executor.UploadArtifacts(ctx, logUploader, name, &api.ArtifactsInstruction{
+		Paths: []string{diagnosticsDir + "/" + name + "/**"},
+	}, executor.env)
+}
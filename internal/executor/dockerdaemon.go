@@ -0,0 +1,69 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/dockerdaemon"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// EnvCirrusDockerDaemon set to "start" starts a (rootless, unless running as root) dockerd
+	// for the task, otherwise it's treated as a Docker host URL to connect to. In both cases
+	// the agent waits for the daemon to become ready and exports the DOCKER_HOST.
+	EnvCirrusDockerDaemon = "CIRRUS_DOCKER_DAEMON"
+
+	// EnvCirrusDockerDaemonTimeout is the number of seconds to wait for the daemon to become ready.
+	EnvCirrusDockerDaemonTimeout = "CIRRUS_DOCKER_DAEMON_TIMEOUT"
+
+	defaultDockerDaemonTimeout = time.Minute
+)
+
+// startDockerDaemon provides the Docker daemon (if requested), returning a function that stops it.
+func (executor *Executor) startDockerDaemon(ctx context.Context) (func(), error) {
+	mode := executor.env.Get(EnvCirrusDockerDaemon)
+	if mode == "" {
+		return func() {}, nil
+	}
+
+	dir, err := os.MkdirTemp("", "cirrus-dockerd-")
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Providing the Docker daemon (%s)...", mode)
+
+	daemon, err := dockerdaemon.Start(ctx, mode, dir, executor.dockerDaemonTimeout())
+	if err != nil {
+		_ = os.RemoveAll(dir)
+
+		return nil, fmt.Errorf("failed to provide the Docker daemon requested via %s: %w",
+			EnvCirrusDockerDaemon, err)
+	}
+
+	log.Printf("Docker daemon is ready at %s", daemon.Host)
+
+	executor.env.Set("DOCKER_HOST", daemon.Host)
+
+	return func() {
+		if err := daemon.Stop(); err != nil {
+			log.Printf("Failed to stop the Docker daemon: %v", err)
+		}
+
+		if err := os.RemoveAll(dir); err != nil {
+			log.Printf("Failed to clean up the Docker daemon's directory: %v", err)
+		}
+	}, nil
+}
+
+func (executor *Executor) dockerDaemonTimeout() time.Duration {
+	timeout, err := strconv.Atoi(executor.env.Get(EnvCirrusDockerDaemonTimeout))
+	if err != nil || timeout <= 0 {
+		return defaultDockerDaemonTimeout
+	}
+
+	return time.Duration(timeout) * time.Second
+}
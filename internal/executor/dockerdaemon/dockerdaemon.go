@@ -0,0 +1,131 @@
+// Package dockerdaemon provides a Docker daemon for the tasks that build or run containers,
+// either by starting a (rootless, when possible) dockerd or by connecting to a provided socket.
+package dockerdaemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/docker/docker/client"
+	"os"
+	"strings"
+	"time"
+)
+
+// ModeStart starts a new dockerd, as opposed to connecting to an existing daemon.
+const ModeStart = "start"
+
+const (
+	pingInterval = 500 * time.Millisecond
+	logTailLines = 20
+)
+
+var (
+	ErrUnsupported = errors.New("starting dockerd is not supported on this platform")
+	ErrInvalidMode = errors.New("expected either \"start\" or a Docker host URL (e.g. unix:///var/run/docker.sock)")
+)
+
+type Daemon struct {
+	// Host is the value for the DOCKER_HOST environment variable
+	Host string
+
+	// Only set for the daemons started by us
+	stop    func() error
+	exited  <-chan struct{}
+	logPath string
+}
+
+// Start starts dockerd in the directory dir (when mode is ModeStart) or uses the Docker host
+// specified by the mode, waiting for the daemon to become ready in both cases.
+func Start(ctx context.Context, mode string, dir string, timeout time.Duration) (*Daemon, error) {
+	var daemon *Daemon
+
+	switch {
+	case mode == ModeStart:
+		var err error
+
+		daemon, err = startDockerd(dir)
+		if err != nil {
+			return nil, err
+		}
+	case strings.Contains(mode, "://"):
+		daemon = &Daemon{Host: mode}
+	default:
+		return nil, fmt.Errorf("%w, got %q", ErrInvalidMode, mode)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// No need to wait for the daemon that has already exited
+	if daemon.exited != nil {
+		go func() {
+			select {
+			case <-daemon.exited:
+				cancel()
+			case <-waitCtx.Done():
+			}
+		}()
+	}
+
+	if err := WaitReady(waitCtx, daemon.Host); err != nil {
+		_ = daemon.Stop()
+
+		return nil, fmt.Errorf("Docker daemon at %s didn't become ready in %v: %w%s",
+			daemon.Host, timeout, err, daemon.logTail())
+	}
+
+	return daemon, nil
+}
+
+// Stop stops the daemon if it was started by us.
+func (daemon *Daemon) Stop() error {
+	if daemon.stop == nil {
+		return nil
+	}
+
+	return daemon.stop()
+}
+
+func (daemon *Daemon) logTail() string {
+	if daemon.logPath == "" {
+		return ""
+	}
+
+	logs, err := os.ReadFile(daemon.logPath)
+	if err != nil {
+		return ""
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(logs)), "\n")
+	if len(lines) > logTailLines {
+		lines = lines[len(lines)-logTailLines:]
+	}
+
+	return fmt.Sprintf("\nLast dockerd logs:\n%s", strings.Join(lines, "\n"))
+}
+
+// WaitReady pings the Docker daemon at the host until it responds or the ctx is done.
+func WaitReady(ctx context.Context, host string) error {
+	cli, err := client.NewClientWithOpts(client.WithHost(host), client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := cli.Ping(ctx)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package dockerdaemon
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+const stopTimeout = 15 * time.Second
+
+func startDockerd(dir string) (*Daemon, error) {
+	socketPath := filepath.Join(dir, "docker.sock")
+
+	args := []string{
+		"--host", "unix://" + socketPath,
+		"--data-root", filepath.Join(dir, "data"),
+		"--exec-root", filepath.Join(dir, "exec"),
+		"--pidfile", filepath.Join(dir, "docker.pid"),
+	}
+	env := os.Environ()
+
+	// Prefer the rootless mode, unless we're already root
+	binary := "dockerd"
+	if os.Geteuid() != 0 {
+		binary = "dockerd-rootless.sh"
+
+		runtimeDir := filepath.Join(dir, "run")
+		if err := os.MkdirAll(runtimeDir, 0700); err != nil {
+			return nil, err
+		}
+		env = append(env, "XDG_RUNTIME_DIR="+runtimeDir)
+	}
+
+	binaryPath, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, err
+	}
+
+	logPath := filepath.Join(dir, "dockerd.log")
+
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(binaryPath, args...)
+	cmd.Env = env
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	// Put the daemon (and the rootlesskit, containerd, etc.) into
+	// a separate process group to be able to stop them all at once
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if err := cmd.Start(); err != nil {
+		_ = logFile.Close()
+
+		return nil, err
+	}
+
+	exited := make(chan struct{})
+
+	go func() {
+		_ = cmd.Wait()
+		_ = logFile.Close()
+		close(exited)
+	}()
+
+	stop := func() error {
+		select {
+		case <-exited:
+			return nil
+		default:
+		}
+
+		pgid := -cmd.Process.Pid
+
+		if err := syscall.Kill(pgid, syscall.SIGTERM); err != nil {
+			return err
+		}
+
+		select {
+		case <-exited:
+			return nil
+		case <-time.After(stopTimeout):
+		}
+
+		if err := syscall.Kill(pgid, syscall.SIGKILL); err != nil {
+			return err
+		}
+
+		<-exited
+
+		return nil
+	}
+
+	return &Daemon{
+		Host:    "unix://" + socketPath,
+		stop:    stop,
+		exited:  exited,
+		logPath: logPath,
+	}, nil
+}
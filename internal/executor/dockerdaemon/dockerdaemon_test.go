@@ -0,0 +1,62 @@
+package dockerdaemon_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/dockerdaemon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStartInvalidMode(t *testing.T) {
+	_, err := dockerdaemon.Start(context.Background(), "rootful", t.TempDir(), time.Second)
+	assert.ErrorIs(t, err, dockerdaemon.ErrInvalidMode)
+}
+
+func TestStartProvidedHost(t *testing.T) {
+	var pings int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if !strings.HasSuffix(request.URL.Path, "/_ping") {
+			writer.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// Pretend that the daemon is still starting up
+		if atomic.AddInt32(&pings, 1) < 3 {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		writer.Header().Set("API-Version", "1.41")
+		_, _ = writer.Write([]byte("OK"))
+	}))
+	defer server.Close()
+
+	host := "tcp://" + strings.TrimPrefix(server.URL, "http://")
+
+	daemon, err := dockerdaemon.Start(context.Background(), host, t.TempDir(), 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, host, daemon.Host)
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&pings), int32(3))
+
+	// Daemons that weren't started by us are left intact
+	require.NoError(t, daemon.Stop())
+}
+
+func TestStartTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	host := "tcp://" + strings.TrimPrefix(server.URL, "http://")
+
+	_, err := dockerdaemon.Start(context.Background(), host, t.TempDir(), 2*time.Second)
+	require.Error(t, err)
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package dockerdaemon
+
+func startDockerd(dir string) (*Daemon, error) {
+	return nil, ErrUnsupported
+}
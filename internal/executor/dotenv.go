@@ -0,0 +1,100 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/dotenv"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// EnvCirrusDotenv is a comma- or newline-separated list of the .env files
+	// (relative to the CIRRUS_WORKING_DIR) to load after cloning the repository.
+	EnvCirrusDotenv = "CIRRUS_DOTENV"
+
+	// EnvCirrusDotenvOverride allows the .env files to override the task's variables.
+	EnvCirrusDotenvOverride = "CIRRUS_DOTENV_OVERRIDE"
+)
+
+// loadDotenvFiles loads the files listed in CIRRUS_DOTENV into the environment.
+//
+// Files listed later take precedence over the files listed earlier. The variables
+// already defined for the task are only overridden when CIRRUS_DOTENV_OVERRIDE is "true",
+// and CIRRUS_-prefixed variables are never overridden.
+//
+// Values of the well-known sensitive variables (e.g. *_TOKEN) and the values matching
+// one of the CIRRUS_MASK_PATTERNS are marked as sensitive.
+func loadDotenvFiles(env *environment.Environment, logs io.Writer) error {
+	rawPaths, ok := env.Lookup(EnvCirrusDotenv)
+	if !ok {
+		return nil
+	}
+
+	override := env.Get(EnvCirrusDotenvOverride) == "true"
+	maskPatterns, _ := ParseMaskPatterns(env.Get(EnvCirrusMaskPatterns))
+
+	variables := map[string]string{}
+
+	for _, path := range splitDotenvPaths(rawPaths) {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(env.Get("CIRRUS_WORKING_DIR"), path)
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		fileVariables, err := dotenv.Parse(file)
+		_ = file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		for key, value := range fileVariables {
+			if _, exists := env.Lookup(key); exists && (!override || strings.HasPrefix(key, "CIRRUS_")) {
+				_, _ = fmt.Fprintf(logs, "Not overriding %s with the value from %s\n", key, path)
+				continue
+			}
+
+			variables[key] = value
+		}
+
+		_, _ = fmt.Fprintf(logs, "Loaded %d variables from %s\n", len(fileVariables), path)
+	}
+
+	env.Merge(variables, false)
+
+	for key := range variables {
+		value := env.Get(key)
+
+		for _, pattern := range maskPatterns {
+			if pattern.MatchString(value) {
+				env.AddSensitiveValues(value)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func splitDotenvPaths(rawPaths string) []string {
+	var result []string
+
+	for _, path := range strings.FieldsFunc(rawPaths, func(r rune) bool {
+		return r == ',' || r == '\n'
+	}) {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		result = append(result, path)
+	}
+
+	return result
+}
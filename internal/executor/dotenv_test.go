@@ -0,0 +1,49 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/testutil"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadDotenvFiles(t *testing.T) {
+	dir := testutil.TempDir(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".env"),
+		[]byte("FOO=from-file\nBAR=bar\nAPI_TOKEN=token-value\nCIRRUS_BRANCH=evil\nKEY=sk-12345\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".env.ci"), []byte("BAR=overridden\n"), 0600))
+
+	env := environment.New(map[string]string{
+		"CIRRUS_WORKING_DIR":  dir,
+		"CIRRUS_BRANCH":       "main",
+		"FOO":                 "from-task",
+		EnvCirrusDotenv:       ".env, .env.ci",
+		EnvCirrusMaskPatterns: "sk-[0-9]+",
+	})
+
+	require.NoError(t, loadDotenvFiles(env, io.Discard))
+
+	require.Equal(t, "from-task", env.Get("FOO"))
+	require.Equal(t, "overridden", env.Get("BAR"))
+	require.Equal(t, "main", env.Get("CIRRUS_BRANCH"))
+	require.ElementsMatch(t, []string{"token-value", "sk-12345"}, env.SensitiveValues())
+
+	env.Set(EnvCirrusDotenvOverride, "true")
+	require.NoError(t, loadDotenvFiles(env, io.Discard))
+
+	require.Equal(t, "from-file", env.Get("FOO"))
+	require.Equal(t, "main", env.Get("CIRRUS_BRANCH"))
+}
+
+func TestLoadDotenvFilesMissing(t *testing.T) {
+	env := environment.New(map[string]string{
+		"CIRRUS_WORKING_DIR": testutil.TempDir(t),
+		EnvCirrusDotenv:      ".env",
+	})
+
+	require.Error(t, loadDotenvFiles(env, io.Discard))
+}
@@ -0,0 +1,124 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/egress"
+	"log"
+	"sort"
+	"strings"
+)
+
+// maxReportedViolations limits the number of distinct destinations listed in the warning
+const maxReportedViolations = 20
+
+// egressSettings are configured by the worker rather than taken from the task's
+// environment, otherwise the untrusted code the policy restricts could simply clear it.
+var egressSettings struct {
+	policy *egress.Policy
+	err    error
+}
+
+// ConfigureEgressPolicy restricts the destination hosts the scripts can connect to:
+// allow is a comma-separated list of the permitted hosts (see egress.Policy for the
+// pattern syntax) with all other destinations prohibited, and deny is a comma-separated
+// list of the prohibited hosts that takes precedence over the allow.
+//
+// An invalid policy fails the tasks instead of letting the scripts run unrestricted.
+func ConfigureEgressPolicy(allow string, deny string) {
+	egressSettings.policy, egressSettings.err = egress.ParsePolicy(allow, deny)
+}
+
+// startEgressProxy starts the policy-enforcing proxy (if an egress policy was configured) and points
+// the scripts to it, returning a function that stops the proxy and reports the violations.
+// The proxy is also stopped once the ctx is done.
+//
+// Note that only the proxy-aware tools (which is the majority of the package managers and HTTP
+// clients) are covered, use the agent's -sandbox-scripts or the firewall of the VM/container
+// to prevent the direct connections.
+func (executor *Executor) startEgressProxy(ctx context.Context) (func(), error) {
+	if egressSettings.err != nil {
+		return nil, fmt.Errorf("failed to parse the egress policy: %w", egressSettings.err)
+	}
+
+	policy := egressSettings.policy
+	if policy == nil || policy.Empty() {
+		return func() {}, nil
+	}
+
+	proxy, err := egress.Start(policy, func(violation egress.Violation) {
+		log.Printf("Egress policy violation: blocked a connection to %s", violation)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the egress proxy: %w", err)
+	}
+
+	log.Printf("Enforcing the egress policy via the proxy at %s", proxy.URL())
+
+	for _, key := range []string{"HTTP_PROXY", "HTTPS_PROXY", "http_proxy", "https_proxy"} {
+		executor.env.Set(key, proxy.URL())
+	}
+
+	// The local endpoints (HTTP cache, annotations server, services) are always reachable
+	noProxy := "localhost,127.0.0.1,::1"
+	if existing := executor.env.Get("NO_PROXY"); existing != "" {
+		noProxy = existing + "," + noProxy
+	}
+	executor.env.Set("NO_PROXY", noProxy)
+	executor.env.Set("no_proxy", noProxy)
+
+	stopped := make(chan struct{})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = proxy.Close()
+		case <-stopped:
+		}
+	}()
+
+	return func() {
+		close(stopped)
+
+		if err := proxy.Close(); err != nil {
+			log.Printf("Failed to stop the egress proxy: %v", err)
+		}
+
+		violations := proxy.Violations()
+		if len(violations) == 0 {
+			return
+		}
+
+		// The ctx is likely done by now (e.g. due to the timeout), but the violations are still worth reporting
+		executor.reportWarning(context.Background(), client.ProblemNetwork, egressViolationsMessage(violations))
+	}, nil
+}
+
+func egressViolationsMessage(violations []egress.Violation) string {
+	counts := map[string]int{}
+
+	for _, violation := range violations {
+		counts[violation.String()]++
+	}
+
+	destinations := make([]string, 0, len(counts))
+	for destination := range counts {
+		destinations = append(destinations, destination)
+	}
+	sort.Strings(destinations)
+
+	var described []string
+
+	for i, destination := range destinations {
+		if i == maxReportedViolations {
+			described = append(described, fmt.Sprintf("and %d more", len(destinations)-i))
+			break
+		}
+
+		described = append(described, fmt.Sprintf("%s (%d times)", destination, counts[destination]))
+	}
+
+	return fmt.Sprintf("Egress policy blocked %d connection attempts: %s",
+		len(violations), strings.Join(described, ", "))
+}
@@ -0,0 +1,87 @@
+package egress_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/egress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestPolicy(t *testing.T) {
+	policy, err := egress.ParsePolicy("*.github.com, proxy.golang.org, 10.0.0.0/8", "evil.github.com")
+	require.NoError(t, err)
+
+	assert.True(t, policy.Allowed("api.github.com"))
+	assert.True(t, policy.Allowed("PROXY.golang.org."))
+	assert.True(t, policy.Allowed("10.1.2.3"))
+	assert.False(t, policy.Allowed("github.com"))
+	assert.False(t, policy.Allowed("evil.github.com"))
+	assert.False(t, policy.Allowed("example.com"))
+	assert.False(t, policy.Allowed("192.168.0.1"))
+
+	denyOnly, err := egress.ParsePolicy("", "pastebin.com")
+	require.NoError(t, err)
+	assert.True(t, denyOnly.Allowed("example.com"))
+	assert.False(t, denyOnly.Allowed("pastebin.com"))
+}
+
+func TestPolicyInvalid(t *testing.T) {
+	_, err := egress.ParsePolicy("github.*", "")
+	assert.ErrorIs(t, err, egress.ErrInvalidPattern)
+
+	_, err = egress.ParsePolicy("", "10.0.0.0/99")
+	assert.ErrorIs(t, err, egress.ErrInvalidPattern)
+}
+
+func TestProxy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("Hello from the upstream!"))
+	}))
+	defer server.Close()
+
+	policy, err := egress.ParsePolicy("127.0.0.1", "")
+	require.NoError(t, err)
+
+	var violations []egress.Violation
+
+	proxy, err := egress.Start(policy, func(violation egress.Violation) {
+		violations = append(violations, violation)
+	})
+	require.NoError(t, err)
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL())
+	require.NoError(t, err)
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)},
+	}
+
+	// Allowed destination
+	response, err := httpClient.Get(server.URL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "Hello from the upstream!", string(body))
+
+	// Prohibited destination
+	response, err = httpClient.Get("http://example.com/")
+	require.NoError(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+
+	// Prohibited destination via a CONNECT tunnel
+	_, err = httpClient.Get("https://example.com/")
+	require.Error(t, err)
+
+	require.Len(t, violations, 2)
+	assert.Equal(t, "example.com:80", violations[0].String())
+	assert.Equal(t, "example.com:443", violations[1].String())
+	assert.Len(t, proxy.Violations(), 2)
+}
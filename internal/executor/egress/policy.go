@@ -0,0 +1,123 @@
+// Package egress restricts the destinations that the user scripts can connect to
+// by routing their traffic through a local HTTP proxy that enforces a host policy.
+package egress
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+var ErrInvalidPattern = errors.New("invalid egress policy pattern")
+
+// Policy decides which destination hosts are reachable. A host is allowed when it matches
+// none of the Deny patterns and either the Allow list is empty or it matches one of its patterns.
+//
+// A pattern can be:
+//
+//   - a hostname (e.g. "proxy.golang.org"), which matches only that hostname
+//   - a wildcard (e.g. "*.github.com"), which matches all subdomains, but not the domain itself
+//   - an IP address (e.g. "10.0.0.1") or a CIDR block (e.g. "10.0.0.0/8")
+//   - "*", which matches everything
+type Policy struct {
+	Allow []string
+	Deny  []string
+}
+
+// ParsePolicy parses the comma-separated allow and deny lists.
+func ParsePolicy(allow string, deny string) (*Policy, error) {
+	allowPatterns, err := parsePatterns(allow)
+	if err != nil {
+		return nil, err
+	}
+
+	denyPatterns, err := parsePatterns(deny)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Policy{
+		Allow: allowPatterns,
+		Deny:  denyPatterns,
+	}, nil
+}
+
+func parsePatterns(list string) ([]string, error) {
+	var result []string
+
+	for _, pattern := range strings.Split(list, ",") {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "" {
+			continue
+		}
+
+		if strings.Contains(pattern, "/") {
+			if _, _, err := net.ParseCIDR(pattern); err != nil {
+				return nil, fmt.Errorf("%w: %q is not a valid CIDR block", ErrInvalidPattern, pattern)
+			}
+		} else if strings.Contains(strings.TrimPrefix(pattern, "*."), "*") {
+			return nil, fmt.Errorf("%w: %q can only contain a wildcard as the first label",
+				ErrInvalidPattern, pattern)
+		}
+
+		result = append(result, pattern)
+	}
+
+	return result, nil
+}
+
+// Empty returns true if the policy doesn't restrict anything.
+func (policy *Policy) Empty() bool {
+	return len(policy.Allow) == 0 && len(policy.Deny) == 0
+}
+
+// Allowed returns true if the connections to the host are permitted.
+func (policy *Policy) Allowed(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	if matchesAny(host, policy.Deny) {
+		return false
+	}
+
+	return len(policy.Allow) == 0 || matchesAny(host, policy.Allow)
+}
+
+func matchesAny(host string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matches(host, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matches(host string, pattern string) bool {
+	if pattern == "*" {
+		return true
+	}
+
+	if strings.Contains(pattern, "/") {
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return false
+		}
+
+		_, ipNet, err := net.ParseCIDR(pattern)
+
+		return err == nil && ipNet.Contains(ip)
+	}
+
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(host, pattern[1:])
+	}
+
+	if patternIP := net.ParseIP(pattern); patternIP != nil {
+		ip := net.ParseIP(host)
+
+		return ip != nil && ip.Equal(patternIP)
+	}
+
+	return host == pattern
+}
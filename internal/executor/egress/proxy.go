@@ -0,0 +1,265 @@
+package egress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const dialTimeout = 30 * time.Second
+
+// hopByHopHeaders are only meaningful for a single connection and should not be forwarded
+var hopByHopHeaders = []string{
+	"Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Proxy-Connection",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
+// Violation is a connection attempt that was rejected by the policy.
+type Violation struct {
+	Host string
+	Port string
+	Time time.Time
+}
+
+func (violation Violation) String() string {
+	return net.JoinHostPort(violation.Host, violation.Port)
+}
+
+// Proxy is an HTTP proxy that supports both the plain HTTP requests
+// and the CONNECT tunnels (used for HTTPS and other TLS-based protocols).
+type Proxy struct {
+	policy      *Policy
+	onViolation func(violation Violation)
+
+	listener  net.Listener
+	server    *http.Server
+	transport *http.Transport
+
+	violationsLock sync.Mutex
+	violations     []Violation
+}
+
+// Start starts the proxy on a random local port. The onViolation callback (if not nil)
+// is invoked for each rejected connection attempt.
+func Start(policy *Policy, onViolation func(violation Violation)) (*Proxy, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := &Proxy{
+		policy:      policy,
+		onViolation: onViolation,
+		listener:    listener,
+		transport: &http.Transport{
+			// Never chain to the proxy configured for the agent itself
+			Proxy: nil,
+			DialContext: (&net.Dialer{
+				Timeout: dialTimeout,
+			}).DialContext,
+			IdleConnTimeout: 90 * time.Second,
+		},
+	}
+
+	proxy.server = &http.Server{
+		Handler:           proxy,
+		ReadHeaderTimeout: dialTimeout,
+	}
+
+	go func() {
+		_ = proxy.server.Serve(listener)
+	}()
+
+	return proxy, nil
+}
+
+// URL returns the value suitable for the HTTP_PROXY and HTTPS_PROXY environment variables.
+func (proxy *Proxy) URL() string {
+	return "http://" + proxy.listener.Addr().String()
+}
+
+// Violations returns the connection attempts rejected so far.
+func (proxy *Proxy) Violations() []Violation {
+	proxy.violationsLock.Lock()
+	defer proxy.violationsLock.Unlock()
+
+	return append([]Violation{}, proxy.violations...)
+}
+
+func (proxy *Proxy) Close() error {
+	proxy.transport.CloseIdleConnections()
+
+	return proxy.server.Close()
+}
+
+func (proxy *Proxy) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method == http.MethodConnect {
+		proxy.serveConnect(writer, request)
+		return
+	}
+
+	if !request.URL.IsAbs() {
+		http.Error(writer, "this is a proxy, only the absolute URLs are supported", http.StatusBadRequest)
+		return
+	}
+
+	if !proxy.check(request.URL.Hostname(), portOrDefault(request.URL.Port(), request.URL.Scheme)) {
+		http.Error(writer, fmt.Sprintf("connections to %s are prohibited by the egress policy",
+			request.URL.Hostname()), http.StatusForbidden)
+		return
+	}
+
+	outgoing := request.Clone(request.Context())
+	outgoing.RequestURI = ""
+	removeHopByHopHeaders(outgoing.Header)
+
+	response, err := proxy.transport.RoundTrip(outgoing)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer response.Body.Close()
+
+	removeHopByHopHeaders(response.Header)
+
+	for key, values := range response.Header {
+		for _, value := range values {
+			writer.Header().Add(key, value)
+		}
+	}
+
+	writer.WriteHeader(response.StatusCode)
+
+	_, _ = io.Copy(writer, response.Body)
+}
+
+func (proxy *Proxy) serveConnect(writer http.ResponseWriter, request *http.Request) {
+	host, port, err := net.SplitHostPort(request.Host)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !proxy.check(host, port) {
+		http.Error(writer, fmt.Sprintf("connections to %s are prohibited by the egress policy", host),
+			http.StatusForbidden)
+		return
+	}
+
+	hijacker, ok := writer.(http.Hijacker)
+	if !ok {
+		http.Error(writer, "tunneling is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(request.Context(), dialTimeout)
+	defer cancel()
+
+	upstream, err := (&net.Dialer{}).DialContext(ctx, "tcp", request.Host)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	client, buffered, err := hijacker.Hijack()
+	if err != nil {
+		_ = upstream.Close()
+		return
+	}
+
+	if _, err := client.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n")); err != nil {
+		_ = client.Close()
+		_ = upstream.Close()
+		return
+	}
+
+	// The client might have already sent some data (e.g. the TLS ClientHello)
+	if buffered.Reader.Buffered() != 0 {
+		if _, err := io.CopyN(upstream, buffered, int64(buffered.Reader.Buffered())); err != nil {
+			_ = client.Close()
+			_ = upstream.Close()
+			return
+		}
+	}
+
+	splice(client, upstream)
+}
+
+// check returns true if the connection is allowed, otherwise it records the violation.
+func (proxy *Proxy) check(host string, port string) bool {
+	if proxy.policy.Allowed(host) {
+		return true
+	}
+
+	violation := Violation{
+		Host: host,
+		Port: port,
+		Time: time.Now(),
+	}
+
+	proxy.violationsLock.Lock()
+	proxy.violations = append(proxy.violations, violation)
+	proxy.violationsLock.Unlock()
+
+	if proxy.onViolation != nil {
+		proxy.onViolation(violation)
+	}
+
+	return false
+}
+
+// splice copies the data in both directions until either side closes the connection.
+func splice(first net.Conn, second net.Conn) {
+	var wg sync.WaitGroup
+
+	copyAndClose := func(dst net.Conn, src net.Conn) {
+		defer wg.Done()
+
+		_, _ = io.Copy(dst, src)
+
+		// Let the other side know that there'll be no more data, but keep
+		// receiving the remaining data (e.g. the response to a request)
+		if tcpConn, ok := dst.(*net.TCPConn); ok {
+			_ = tcpConn.CloseWrite()
+		} else {
+			_ = dst.Close()
+		}
+	}
+
+	wg.Add(2)
+	go copyAndClose(first, second)
+	go copyAndClose(second, first)
+	wg.Wait()
+
+	_ = first.Close()
+	_ = second.Close()
+}
+
+func removeHopByHopHeaders(header http.Header) {
+	for _, key := range hopByHopHeaders {
+		header.Del(key)
+	}
+}
+
+func portOrDefault(port string, scheme string) string {
+	if port != "" {
+		return port
+	}
+
+	if scheme == "https" {
+		return "443"
+	}
+
+	return "80"
+}
@@ -0,0 +1,56 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestEgressProxyStopsWithContext(t *testing.T) {
+	ConfigureEgressPolicy("example.com", "")
+	defer ConfigureEgressPolicy("", "")
+
+	executor := &Executor{env: environment.New(map[string]string{
+		// Not honored, the policy comes from the agent's configuration
+		"CIRRUS_EGRESS_ALLOW": "*",
+	})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stopEgressProxy, err := executor.startEgressProxy(ctx)
+	require.NoError(t, err)
+	defer stopEgressProxy()
+
+	proxyURL, err := url.Parse(executor.env.Get("HTTP_PROXY"))
+	require.NoError(t, err)
+
+	conn, err := net.Dial("tcp", proxyURL.Host)
+	require.NoError(t, err)
+	_ = conn.Close()
+
+	cancel()
+
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", proxyURL.Host)
+		if err != nil {
+			return true
+		}
+		_ = conn.Close()
+
+		return false
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestEgressPolicyInvalid(t *testing.T) {
+	ConfigureEgressPolicy("github.*", "")
+	defer ConfigureEgressPolicy("", "")
+
+	executor := &Executor{env: environment.NewEmpty()}
+
+	_, err := executor.startEgressProxy(context.Background())
+	require.Error(t, err)
+}
@@ -2,14 +2,85 @@ package executor
 
 import (
 	"fmt"
+	"os"
+	"path"
+	"strings"
 )
 
+// EnvCirrusEnvDenylist is a comma- or newline-separated list of the variable names (or glob
+// patterns, e.g. "WORKER_*") that are never passed to the user scripts. It's only read from
+// the agent's process environment so that the tasks can't alter it.
+const EnvCirrusEnvDenylist = "CIRRUS_ENV_DENYLIST"
+
 func EnvMapAsSlice(env map[string]string) []string {
 	var result []string
 
 	for key, value := range env {
+		if isDeniedVariable(key) {
+			continue
+		}
+
 		result = append(result, fmt.Sprintf("%s=%s", key, value))
 	}
 
 	return result
 }
+
+// withoutDeniedVariables removes the CIRRUS_ENV_DENYLIST-ed variables from the KEY=VALUE slice.
+func withoutDeniedVariables(env []string) []string {
+	var result []string
+
+	for _, item := range env {
+		key := strings.SplitN(item, "=", 2)[0]
+
+		if isDeniedVariable(key) {
+			continue
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// validateEnvironment catches the variables that can't be passed to a process,
+// which otherwise results in an obscure "invalid argument" error when starting it.
+func validateEnvironment(env []string) error {
+	for _, item := range env {
+		if strings.ContainsRune(item, 0) {
+			key := strings.SplitN(item, "=", 2)[0]
+
+			return fmt.Errorf("environment variable %s contains a NUL character", key)
+		}
+	}
+
+	return nil
+}
+
+func isDeniedVariable(key string) bool {
+	denylist, ok := os.LookupEnv(EnvCirrusEnvDenylist)
+	if !ok {
+		return false
+	}
+
+	// The denylist itself is configured by the operator and is of no use to the scripts
+	if strings.EqualFold(key, EnvCirrusEnvDenylist) {
+		return true
+	}
+
+	for _, pattern := range strings.FieldsFunc(denylist, func(r rune) bool {
+		return r == ',' || r == '\n'
+	}) {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		// Variable names are case-insensitive on Windows, so err on the safe side
+		if matched, _ := path.Match(strings.ToUpper(pattern), strings.ToUpper(key)); matched {
+			return true
+		}
+	}
+
+	return false
+}
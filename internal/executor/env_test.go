@@ -72,3 +72,40 @@ func Test_Recursive(t *testing.T) {
 func TestEnvMapAsSlice(t *testing.T) {
 	assert.Equal(t, EnvMapAsSlice(map[string]string{"A": "B"}), []string{"A=B"})
 }
+
+func TestEnvDenylist(t *testing.T) {
+	t.Setenv(EnvCirrusEnvDenylist, "WORKER_*, agent_token")
+
+	assert.Equal(t, []string{"A=B"}, EnvMapAsSlice(map[string]string{
+		"A":                  "B",
+		"WORKER_CREDENTIALS": "secret",
+		"AGENT_TOKEN":        "secret",
+	}))
+	assert.Equal(t, []string{"PATH=/usr/bin"}, withoutDeniedVariables([]string{
+		"PATH=/usr/bin",
+		"WORKER_ID=1",
+		EnvCirrusEnvDenylist + "=WORKER_*",
+	}))
+}
+
+func TestValidateEnvironment(t *testing.T) {
+	assert.NoError(t, validateEnvironment([]string{
+		"MULTILINE=-----BEGIN KEY-----\r\nabc\n-----END KEY-----",
+		"QUOTES=\"double\" 'single' `backtick`",
+		"UNICODE=héllo ✓",
+		"=C:=C:\\Windows",
+	}))
+	assert.EqualError(t, validateEnvironment([]string{"BAD=a\x00b"}),
+		"environment variable BAD contains a NUL character")
+}
+
+func TestShortSensitiveValuesAreMasked(t *testing.T) {
+	uploader := LogUploader{
+		env: environment.New(map[string]string{
+			"SHORT_TOKEN": "abc",
+		}),
+	}
+
+	assert.Equal(t, "token HIDDEN-BY-CIRRUS-CI, encoded YWJj\n",
+		string(uploader.WithMaskedSensitiveValues([]byte("token abc, encoded YWJj\n"))))
+}
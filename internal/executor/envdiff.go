@@ -0,0 +1,73 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"io"
+	"strings"
+)
+
+// EnvCirrusEnvDiff controls the reporting of the environment changes made via CIRRUS_ENV:
+// "false" disables it and "report" additionally reports them as an annotation.
+const EnvCirrusEnvDiff = "CIRRUS_ENV_DIFF"
+
+// formatEnvironmentDiff renders the environment changes one per line, masking the sensitive values.
+func formatEnvironmentDiff(env *environment.Environment, changes []environment.Change) string {
+	var sb strings.Builder
+
+	for _, change := range changes {
+		switch change.Kind {
+		case environment.ChangeRemoved:
+			fmt.Fprintf(&sb, "  %s (%s)\n", change.Key, change.Kind)
+		default:
+			fmt.Fprintf(&sb, "  %s=%s (%s)\n", change.Key, env.Masked(change.Value), change.Kind)
+		}
+	}
+
+	return sb.String()
+}
+
+// reportEnvironmentDiff lets the user know which variables the following steps will inherit
+// from the step that just finished.
+func (executor *Executor) reportEnvironmentDiff(
+	ctx context.Context,
+	logs io.Writer,
+	commandName string,
+	before map[string]string,
+) {
+	mode := executor.env.Get(EnvCirrusEnvDiff)
+	if mode == "false" {
+		return
+	}
+
+	changes := environment.Diff(before, executor.env.Items())
+	if len(changes) == 0 {
+		return
+	}
+
+	details := formatEnvironmentDiff(executor.env, changes)
+
+	_, _ = fmt.Fprintf(logs, "\nEnvironment changes made via CIRRUS_ENV:\n%s", details)
+
+	if mode != "report" {
+		return
+	}
+
+	_, err := client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: executor.taskIdentification,
+		Annotations: []*api.Annotation{
+			{
+				Type:       api.Annotation_GENERIC,
+				Level:      api.Annotation_NOTICE,
+				Message:    fmt.Sprintf("%s changed %d environment variables", commandName, len(changes)),
+				RawDetails: details,
+			},
+		},
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(logs, "Failed to report the environment changes: %v\n", err)
+	}
+}
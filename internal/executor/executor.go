@@ -7,20 +7,32 @@ import (
 	"github.com/avast/retry-go"
 	"github.com/certifi/gocertifi"
 	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/annotationserver"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/cirrusenv"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/fssnapshot"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/oomwatcher"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/processtree"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/provenance"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/remote"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/updatebatcher"
-	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/http_cache"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/lifecyclehook"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/logsink"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/network"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/otlptrace"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	gitclient "github.com/go-git/go-git/v5/plumbing/transport/client"
 	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"log"
 	"math"
 	"net/http"
@@ -30,6 +42,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 )
@@ -44,6 +57,7 @@ type Executor struct {
 	taskIdentification   *api.TaskIdentification
 	serverToken          string
 	backgroundCommands   []CommandAndLogs
+	stepLogUploader      *LogUploader
 	httpCacheHost        string
 	commandFrom          string
 	commandTo            string
@@ -51,12 +65,53 @@ type Executor struct {
 	cacheAttempts        *CacheAttempts
 	env                  *environment.Environment
 	terminalWrapper      *terminalwrapper.Wrapper
+	terminalRecordings   string
+	terminalOnFailure    bool
+	terminalErr          error
+	updateBatcher        *updatebatcher.UpdateBatcher
+	health               healthTracker
+	testSplitDone        bool
+
+	// uploadsMtx serializes the artifact and cache uploads (and the state they update,
+	// e.g. the artifactsBytesUploaded), since the scripts can request them concurrently
+	// with the steps through the annotations server (see scriptActions)
+	uploadsMtx             sync.Mutex
+	artifactsBytesUploaded uint64
+	artifactDigests        *ArtifactDigests
+	provenanceSubjects     []provenance.Subject
+	annotationServer       *annotationserver.Server
+	scriptActions          *scriptActions
+	logSink                logsink.Sink
+
+	oidcTokenRefreshUnsupported bool
+
+	remoteTarget remote.Target
+	remoteSync   remote.SyncMode
+
+	coreDumpDirs []string
+
+	screenRecording *screenRecording
+
+	lifecycleHooks lifecyclehook.Hooks
+
+	workingDirSnapshotMode string
+	workingDirSnapshot     fssnapshot.Snapshotter
+
+	tracer *otlptrace.Tracer
+
+	// finishedReported is set once the ReportAgentFinished is sent to not repeat it after a crash
+	finishedReported bool
 }
 
 type StepResult struct {
 	Success        bool
 	SignaledToExit bool
 	Duration       time.Duration
+
+	// ExitCode and Attempts (the number of executions, see CIRRUS_RETRY_EXIT_CODES)
+	// are only set for the script instructions
+	ExitCode int
+	Attempts int
 }
 
 var (
@@ -85,6 +140,7 @@ func NewExecutor(
 		preCreatedWorkingDir: preCreatedWorkingDir,
 		cacheAttempts:        NewCacheAttempts(),
 		env:                  environment.NewEmpty(),
+		artifactDigests:      NewArtifactDigests(),
 	}
 }
 
@@ -92,22 +148,40 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 	// Start collecting metrics
 	metricsCtx, metricsCancel := context.WithCancel(ctx)
 	defer metricsCancel()
-	metricsResultChan := metrics.Run(metricsCtx, nil)
+	metricsConfig, metricsConfigErr := metrics.ConfigFromEnvironment()
+	if metricsConfigErr != nil {
+		log.Printf("Ignoring invalid metrics configuration: %v", metricsConfigErr)
+	}
+	liveMetricsInterval, liveMetricsIntervalErr := liveMetricsIntervalFromEnvironment()
+	if liveMetricsIntervalErr != nil {
+		log.Printf("Ignoring invalid live metrics configuration: %v", liveMetricsIntervalErr)
+		liveMetricsInterval = defaultLiveMetricsInterval
+	}
+	if liveMetricsInterval != 0 {
+		liveMetrics := newLiveMetricsReporter(executor.taskIdentification)
+		metricsConfig.OnSample = liveMetrics.OnSample
+		go liveMetrics.Run(metricsCtx, liveMetricsInterval)
+	}
+	metricsResultChan := metrics.RunWithConfig(metricsCtx, nil, metricsConfig)
 
 	log.Println("Getting initial commands...")
 
 	var response *api.CommandsResponse
 	var err error
 	var numRetries uint
+	var serverTime serverTimeObservation
+	var header metadata.MD
 
 	err = retry.Do(
 		func() error {
+			serverTime.sent = time.Now()
 			response, err = client.CirrusClient.InitialCommands(ctx, &api.InitialCommandsRequest{
 				TaskIdentification:  executor.taskIdentification,
-				LocalTimestamp:      time.Now().Unix(),
+				LocalTimestamp:      serverTime.sent.Unix(),
 				ContinueFromCommand: executor.commandFrom,
 				Retry:               numRetries != 0,
-			})
+			}, grpc.Header(&header))
+			serverTime.received = time.Now()
 			return err
 		}, retry.OnRetry(func(n uint, err error) {
 			numRetries++
@@ -127,49 +201,61 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 		return
 	}
 
+	if timestamp, resolution, ok := client.ServerTimestamp(response, header); ok {
+		serverTime.serverTime = timestamp
+		serverTime.resolution = resolution
+	}
+
 	executor.env.Merge(getScriptEnvironment(executor, response.Environment), false)
 
-	// Unbox VAULT[...] environment variables
-	var vaultUnboxer *vaultunboxer.VaultUnboxer
+	// Skewed clocks corrupt the timing data and break the TLS certificate validation
+	executor.checkClockSkew(ctx, &serverTime)
 
-	for key, value := range executor.env.Items() {
-		boxedValue, err := vaultunboxer.NewBoxedValue(value)
-		if err != nil {
-			if errors.Is(err, vaultunboxer.ErrNotABoxedValue) {
-				continue
-			}
+	// Variables referencing each other in a cycle are left unexpanded, let the user know why
+	if _, err := environment.ExpandEnvironment(executor.env.Items()); err != nil {
+		log.Println(err)
+		executor.reportWarning(ctx, client.ProblemUserScript, err.Error())
+	}
 
-			message := fmt.Sprintf("failed to parse a Vault-boxed value %s: %v", value, err)
-			log.Println(message)
-			executor.reportError(message)
+	// Unbox VAULT[...] and other secret manager-boxed environment variables
+	unboxers := &secretUnboxers{}
+	defer executor.cleanupUnboxers(unboxers)
 
-			return
-		}
+	if err := executor.unboxEnvironment(ctx, unboxers); err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemSecrets, message)
 
-		if vaultUnboxer == nil {
-			vaultUnboxer, err = vaultunboxer.NewFromEnvironment(ctx, executor.env)
-			if err != nil {
-				message := fmt.Sprintf("failed to initialize a Vault client: %v", err)
-				log.Println(message)
-				executor.reportError(message)
+		return
+	}
 
-				return
-			}
-		}
+	if err := computeEnvironment(ctx, executor.env); err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemUserScript, message)
 
-		unboxedValue, err := vaultUnboxer.Unbox(ctx, boxedValue)
-		if err != nil {
-			message := fmt.Sprintf("failed to unbox a Vault-boxed value %s: %v", value, err)
-			log.Println(message)
-			executor.reportError(message)
+		return
+	}
 
-			return
-		}
+	if err := validateRequiredEnvironment(executor.env); err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemSecrets, message)
 
-		executor.env.Set(key, unboxedValue)
-		executor.env.AddSensitiveValues(unboxedValue)
+		return
 	}
 
+	// Put the working directory on a RAM disk (if requested) for the I/O-bound builds
+	unmountRAMDisk, err := executor.mountWorkingDirRAMDisk(ctx)
+	if err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemStorage, message)
+
+		return
+	}
+	defer unmountRAMDisk()
+
 	workingDir, ok := executor.env.Lookup("CIRRUS_WORKING_DIR")
 	if ok {
 		EnsureFolderExists(workingDir)
@@ -180,7 +266,29 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 		log.Printf("Not changing current working directory because CIRRUS_WORKING_DIR is not set")
 	}
 
+	// Reset the working directory with a filesystem snapshot (if requested) on the persistent workers
+	deleteWorkingDirSnapshot := executor.prepareWorkingDirSnapshot(ctx)
+	defer deleteWorkingDirSnapshot()
+
+	// Let the worker prepare the host for the task
+	if err := executor.runLifecycleHook(ctx, lifecyclehook.PreStart, nil, log.Writer()); err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemAgent, message)
+
+		return
+	}
+
+	// Make the crashing processes produce the core dumps (if requested)
+	disableCoreDumps := executor.enableCoreDumps(ctx)
+	defer disableCoreDumps()
+
+	if executor.env.Get(EnvCirrusHeartbeatHealth) == "true" {
+		executor.health.Enable(executor.env.Get("CIRRUS_WORKING_DIR"))
+	}
+
 	commands := response.Commands
+	startedOn := clockskew.Now()
 
 	if cacheHost, ok := os.LookupEnv("CIRRUS_HTTP_CACHE_HOST"); ok {
 		executor.env.Set("CIRRUS_HTTP_CACHE_HOST", cacheHost)
@@ -191,6 +299,36 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 	}
 
 	executor.httpCacheHost = executor.env.Get("CIRRUS_HTTP_CACHE_HOST")
+
+	// Ship command logs to an external log sink (if configured)
+	logSink, err := logsink.NewFromEnvironment(executor.env, executor.taskIdentification.TaskId)
+	if err != nil {
+		message := fmt.Sprintf("Failed to initialize the log sink: %v", err)
+		log.Println(message)
+		executor.reportWarning(ctx, client.ProblemAgent, message)
+	} else if logSink != nil {
+		defer logSink.Close()
+		executor.logSink = logSink
+	}
+
+	// Start a local endpoint through which the user scripts can emit annotations
+	annotationServer, err := annotationserver.New()
+	if err != nil {
+		log.Printf("Failed to start annotations server: %v", err)
+	} else {
+		defer annotationServer.Close()
+		executor.annotationServer = annotationServer
+		executor.env.Set(annotationserver.EnvCirrusAnnotationsURL, annotationServer.URL())
+
+		// Let the user scripts upload the artifacts and the caches mid-step
+		executor.scriptActions = &scriptActions{executor: executor}
+		annotationServer.SetActions(executor.scriptActions)
+		executor.env.Set(annotationserver.EnvCirrusArtifactsURL, annotationServer.ArtifactsURL())
+		executor.env.Set(annotationserver.EnvCirrusCacheURL, annotationServer.CacheURL())
+		executor.env.Set(annotationserver.EnvCirrusAgentAPIToken, annotationServer.Token())
+		executor.env.AddSensitiveValues(annotationServer.Token())
+	}
+
 	subCtx, cancel := context.WithTimeout(ctx, time.Duration(response.TimeoutInSeconds)*time.Second)
 	defer cancel()
 	executor.env.AddSensitiveValues(response.SecretsToMask...)
@@ -199,6 +337,81 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 		return
 	}
 
+	// Load the Visual Studio developer environment (if requested) for the Windows C++ builds
+	if err := executor.loadVSDevEnv(subCtx); err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemAgent, message)
+
+		return
+	}
+
+	// Create a temporary keychain (if requested) for the macOS code signing
+	deleteKeychain, err := executor.setupKeychain(subCtx)
+	if err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemSecrets, message)
+
+		return
+	}
+	defer deleteKeychain()
+
+	// Provide a Docker daemon (if requested) for building images and running the services
+	stopDockerDaemon, err := executor.startDockerDaemon(subCtx)
+	if err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemAgent, message)
+
+		return
+	}
+	defer stopDockerDaemon()
+
+	// Start the dependent containers (if any were declared) before the scripts
+	stopServices, err := executor.startServices(subCtx)
+	if err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemAgent, message)
+
+		return
+	}
+	defer stopServices()
+
+	// Boot the simulators and emulators (if requested) for the mobile UI tests
+	stopVirtualDevices, err := executor.bootVirtualDevices(subCtx)
+	if err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemAgent, message)
+
+		return
+	}
+	defer stopVirtualDevices()
+
+	// Connect to the remote host (if requested) that will execute the scripts
+	disconnectRemoteTarget, err := executor.connectRemoteTarget(subCtx)
+	if err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemNetwork, message)
+
+		return
+	}
+	defer disconnectRemoteTarget()
+
+	// Restrict the destinations that the scripts can connect to (if requested)
+	stopEgressProxy, err := executor.startEgressProxy(subCtx)
+	if err != nil {
+		message := err.Error()
+		log.Println(message)
+		executor.reportError(client.ProblemNetwork, message)
+
+		return
+	}
+	defer stopEgressProxy()
+
 	// Launch terminal session for remote access (in case requested by the user)
 	var hasWaitForTerminalInstruction bool
 	var terminalServerAddress string
@@ -214,25 +427,47 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 	}
 
 	if hasWaitForTerminalInstruction {
-		expireIn := 15 * time.Minute
+		shellEnv, startup, err := executor.terminalShellEnv()
+		if err != nil {
+			log.Printf("Not launching the terminal: %v", err)
+			executor.terminalErr = err
+		} else {
+			defer func() {
+				_ = startup.Close()
+			}()
 
-		expireInString, ok := executor.env.Lookup("CIRRUS_TERMINAL_EXPIRATION_WINDOW")
-		if ok {
-			expireInInt, err := strconv.Atoi(expireInString)
-			if err == nil {
-				expireIn = time.Duration(expireInInt) * time.Second
-			}
+			executor.terminalWrapper = terminalwrapper.New(subCtx, executor.taskIdentification,
+				terminalServerAddress, executor.terminalExpirationWindow(), shellEnv, executor.terminalNumHosts())
+			executor.terminalWrapper.SetNotifier(startup.Notify)
 		}
+	}
+
+	failedAtLeastOnce := response.FailedAtLeastOnce
 
-		shellEnv := append(os.Environ(), EnvMapAsSlice(executor.env.Items())...)
+	// Export the OpenTelemetry trace (if configured)
+	executor.initializeTracer(ctx)
+	defer func() {
+		executor.shutdownTracer(ctx, !failedAtLeastOnce)
+	}()
 
-		executor.terminalWrapper = terminalwrapper.New(subCtx, executor.taskIdentification, terminalServerAddress,
-			expireIn, shellEnv)
+	// Detect the OOM kills to explain the otherwise mysterious command failures
+	oomWatcher, err := oomwatcher.New()
+	if err != nil {
+		log.Printf("OOM kill detection is disabled: %v", err)
 	}
 
-	failedAtLeastOnce := response.FailedAtLeastOnce
+	// Record the toolchain versions to explain the breakages caused by the image updates (if requested)
+	executor.reportToolInventory(ctx)
+
+	// Tell whether the VM-based tests can use the hardware virtualization on this runner
+	executor.reportVirtualization(ctx)
 
 	ub := updatebatcher.New()
+	ub.SetWindow(executor.updateBatchWindow())
+	executor.updateBatcher = ub
+	ubCtx, ubCancel := context.WithCancel(ctx)
+	defer ubCancel()
+	go ub.Run(ubCtx, executor.taskIdentification)
 
 	for _, command := range BoundedCommands(commands, executor.commandFrom, executor.commandTo) {
 		shouldRun := (command.ExecutionBehaviour == api.Command_ON_SUCCESS && !failedAtLeastOnce) ||
@@ -250,11 +485,25 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 			Name:   command.Name,
 			Status: api.Status_EXECUTING,
 		})
-		ub.Flush(ctx, executor.taskIdentification)
+		ub.MaybeFlush(ctx, executor.taskIdentification)
 
 		log.Printf("Executing %s...", command.Name)
 
+		executor.refreshOIDCToken(ctx, unboxers)
+
+		if oomWatcher != nil {
+			if err := oomWatcher.Snapshot(); err != nil {
+				log.Printf("Failed to snapshot the OOM kill counters: %v", err)
+			}
+		}
+
+		stepSpan := executor.startStepSpan(command)
+		executor.health.CommandStarted(command.Name)
 		stepResult, err := executor.performStep(subCtx, command)
+		executor.stepLogUploader = nil
+		executor.health.CommandFinished()
+		executor.endStepSpan(stepSpan, stepResult)
+		executor.reportMemoryEvents(ctx, oomWatcher, command.Name)
 		if err != nil {
 			return
 		}
@@ -271,16 +520,37 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 		} else {
 			currentCommandStatus = api.Status_FAILED
 		}
-		ub.Queue(&api.CommandResult{
+		commandResult := &api.CommandResult{
 			Name:            command.Name,
 			Status:          currentCommandStatus,
 			DurationInNanos: stepResult.Duration.Nanoseconds(),
 			SignaledToExit:  stepResult.SignaledToExit,
-		})
+		}
+		if stepResult.Attempts > 1 {
+			commandResult.Attempts = uint32(stepResult.Attempts)
+		}
+		ub.Queue(commandResult)
+		ub.MaybeFlush(ctx, executor.taskIdentification)
 	}
 
+	ubCancel()
 	ub.Flush(ctx, executor.taskIdentification)
 
+	// Let the worker inspect or clean up the host after the task
+	postTaskEnv := map[string]string{"CIRRUS_TASK_FAILED": strconv.FormatBool(failedAtLeastOnce)}
+	if err := executor.runLifecycleHook(ctx, lifecyclehook.PostTask, postTaskEnv, log.Writer()); err != nil {
+		log.Println(err.Error())
+		executor.reportWarning(ctx, client.ProblemAgent, err.Error())
+	}
+
+	// Expose the kernel-level problems (e.g. OOM kills and disk errors) behind the failures
+	if failedAtLeastOnce {
+		executor.uploadSystemLog(ctx, startedOn)
+	}
+
+	// Describe how the uploaded artifacts were produced (if requested)
+	executor.uploadProvenance(ctx, commands, ub.History(), startedOn)
+
 	log.Printf("Background commands to clean up after: %d!\n", len(executor.backgroundCommands))
 	for i := 0; i < len(executor.backgroundCommands); i++ {
 		backgroundCommand := executor.backgroundCommands[i]
@@ -302,12 +572,10 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 		for _, err := range metricsResult.Errors() {
 			message := fmt.Sprintf("Encountered an error while gathering resource utilization metrics: %v", err)
 			log.Print(message)
-			_, _ = client.CirrusClient.ReportAgentWarning(ctx, &api.ReportAgentProblemRequest{
-				TaskIdentification: executor.taskIdentification,
-				Message:            message,
-			})
+			executor.reportWarning(ctx, client.ProblemAgent, message)
 		}
 		resourceUtilization = metricsResult.ResourceUtilization
+		executor.reportAdditionalMetrics(ctx, metricsResult.Charts)
 	case <-time.After(3 * time.Second):
 		// Yes, we already use context.Context, but it seems that gopsutil is somewhat lacking it's support[1],
 		// so we err on the side of caution here.
@@ -315,15 +583,16 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 		// [1]: https://github.com/shirou/gopsutil/issues/724
 		message := "Failed to retrieve resource utilization metrics in time"
 		log.Print(message)
-		_, _ = client.CirrusClient.ReportAgentWarning(ctx, &api.ReportAgentProblemRequest{
-			TaskIdentification: executor.taskIdentification,
-			Message:            message,
-		})
+		executor.reportWarning(ctx, client.ProblemAgent, message)
 	}
 
+	// Let the server deduplicate the report in case the first attempt
+	// has actually succeeded, but we've failed to receive the response
+	finishedCtx := client.WithIdempotencyKey(ctx)
+
 	_ = retry.Do(
 		func() error {
-			_, err = client.CirrusClient.ReportAgentFinished(ctx, &api.ReportAgentFinishedRequest{
+			_, err = client.CirrusClient.ReportAgentFinished(finishedCtx, &api.ReportAgentFinishedRequest{
 				TaskIdentification:     executor.taskIdentification,
 				CacheRetrievalAttempts: executor.cacheAttempts.ToProto(),
 				ResourceUtilization:    resourceUtilization,
@@ -337,6 +606,7 @@ func (executor *Executor) RunBuild(ctx context.Context) {
 		retry.Attempts(2),
 		retry.Context(ctx),
 	)
+	executor.finishedReported = true
 }
 
 // BoundedCommands bounds a slice of commands with unique names to a half-open range [fromName, toName).
@@ -395,16 +665,15 @@ func getScriptEnvironment(executor *Executor, responseEnvironment map[string]str
 func (executor *Executor) performStep(ctx context.Context, currentStep *api.Command) (*StepResult, error) {
 	success := false
 	signaledToExit := false
+	exitCode := 0
+	scriptAttempts := 0
 	start := time.Now()
 
 	logUploader, err := NewLogUploader(ctx, executor, currentStep.Name)
 	if err != nil {
 		message := fmt.Sprintf("Failed to initialize command %s log upload: %v", currentStep.Name, err)
 
-		_, _ = client.CirrusClient.ReportAgentWarning(ctx, &api.ReportAgentProblemRequest{
-			TaskIdentification: executor.taskIdentification,
-			Message:            message,
-		})
+		executor.reportWarning(ctx, client.ProblemNetwork, message)
 
 		return &StepResult{
 			Success:  false,
@@ -413,6 +682,8 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 	}
 
 	if _, ok := currentStep.Instruction.(*api.Command_BackgroundScriptInstruction); !ok {
+		// Finalized by the ReportCrash() if the agent crashes during the step
+		executor.stepLogUploader = logUploader
 		defer logUploader.Finalize()
 	}
 
@@ -429,18 +700,76 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 	defer cirrusEnv.Close()
 	executor.env.Set("CIRRUS_ENV", cirrusEnv.Path())
 
+	executor.scriptActions.enter(ctx, logUploader, currentStep.Name)
+	defer executor.scriptActions.leave()
+
+	switch currentStep.Instruction.(type) {
+	case *api.Command_ScriptInstruction, *api.Command_BackgroundScriptInstruction:
+		if err := executor.splitTests(logUploader); err != nil {
+			message := fmt.Sprintf("Failed to split the tests: %v", err)
+			log.Print(message)
+			fmt.Fprintln(logUploader, message)
+			if _, ok := currentStep.Instruction.(*api.Command_BackgroundScriptInstruction); ok {
+				logUploader.Finalize()
+			}
+			return &StepResult{
+				Success:  false,
+				Duration: time.Since(start),
+			}, nil
+		}
+	}
+
 	switch instruction := currentStep.Instruction.(type) {
 	case *api.Command_ExitInstruction:
 		return nil, ErrStepExit
 	case *api.Command_CloneInstruction:
 		success = executor.CloneRepository(ctx, logUploader, executor.env)
+		if !success {
+			executor.reportWarning(ctx, client.ProblemClone, fmt.Sprintf("Failed to clone the repository in %s", currentStep.Name))
+		}
+		if success {
+			executor.snapshotWorkingDirAfterClone(ctx, logUploader)
+
+			if err := executor.runLifecycleHook(ctx, lifecyclehook.PostClone, nil, logUploader); err != nil {
+				fmt.Fprintln(logUploader, err.Error())
+				success = false
+
+				break
+			}
+
+			if err := loadDotenvFiles(executor.env, logUploader); err != nil {
+				message := fmt.Sprintf("Failed to load %s files: %v", EnvCirrusDotenv, err)
+				log.Print(message)
+				fmt.Fprintln(logUploader, message)
+				success = false
+			}
+		}
 	case *api.Command_FileInstruction:
 		success = executor.CreateFile(ctx, logUploader, instruction.FileInstruction, executor.env)
 	case *api.Command_ScriptInstruction:
-		cmd, err := executor.ExecuteScriptsStreamLogsAndWait(ctx, logUploader, currentStep.Name,
-			instruction.ScriptInstruction.Scripts, executor.env)
+		if executor.remoteTarget != nil {
+			exitCode, err = executor.executeScriptsRemotely(ctx, logUploader, instruction.ScriptInstruction.Scripts)
+			if err != nil {
+				_, _ = fmt.Fprintf(logUploader, "\nFailed to execute the scripts remotely: %v\n", err)
+			}
+			success = err == nil && exitCode == 0
+			scriptAttempts = 1
+
+			break
+		}
+
+		stopScreenRecording := executor.startScreenRecording(ctx, logUploader, currentStep.Name)
+
+		progressCtx, progressCancel := context.WithCancel(ctx)
+		go executor.watchProgress(progressCtx, currentStep.Name, cirrusEnv, start, progressInterval)
+
+		cmd, attempts, err := executor.executeScriptsWithRetries(ctx, logUploader, currentStep.Name,
+			instruction.ScriptInstruction.Scripts)
+		progressCancel()
+		scriptAttempts = attempts
 		success = err == nil && cmd.ProcessState.Success()
 		if err == nil {
+			exitCode = cmd.ProcessState.ExitCode()
 			if ws, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok {
 				signaledToExit = ws.Signaled()
 			}
@@ -448,6 +777,14 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 		if err == TimeOutError {
 			signaledToExit = false
 		}
+		if !success && err == nil && !signaledToExit {
+			success = executor.rerunFailedTests(ctx, logUploader, currentStep.Name, start)
+			if success {
+				exitCode = 0
+			}
+		}
+
+		stopScreenRecording(success)
 	case *api.Command_BackgroundScriptInstruction:
 		cmd, err := executor.ExecuteScriptsAndStreamLogs(ctx, logUploader,
 			instruction.BackgroundScriptInstruction.Scripts, executor.env)
@@ -475,25 +812,40 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 		success = executor.UploadArtifacts(ctx, logUploader, currentStep.Name,
 			instruction.ArtifactsInstruction, executor.env)
 	case *api.Command_WaitForTerminalInstruction:
-		operationChan := executor.terminalWrapper.Wait()
-
-	WaitForTerminalInstructionFor:
-		for {
-			switch operation := (<-operationChan).(type) {
-			case *terminalwrapper.LogOperation:
-				log.Println(operation.Message)
-				_, _ = fmt.Fprintln(logUploader, operation.Message)
-			case *terminalwrapper.ExitOperation:
-				success = operation.Success
-				break WaitForTerminalInstructionFor
-			}
+		if executor.terminalWrapper == nil {
+			_, _ = fmt.Fprintf(logUploader, "Terminal was not launched: %v\n", executor.terminalErr)
+			success = false
+
+			break
 		}
+
+		success = executor.waitForTerminal(logUploader, executor.terminalWrapper)
+
+		executor.uploadTerminalRecordings(ctx, logUploader)
 	default:
+		if name, rawInstruction, ok := pluginInstruction(currentStep); ok {
+			success = executor.runPlugin(ctx, logUploader, currentStep, name, rawInstruction)
+
+			break
+		}
+
 		log.Printf("Unsupported instruction %T", instruction)
 		success = false
 	}
 
-	cirrusEnvVariables, err := cirrusEnv.Consume()
+	if !success {
+		executor.writeFailureSnapshot(ctx, logUploader)
+
+		if _, ok := currentStep.Instruction.(*api.Command_ScriptInstruction); ok {
+			executor.uploadCoreDumps(ctx, logUploader, start, exitCode, signaledToExit)
+			executor.uploadCrashReports(ctx, logUploader, start)
+			executor.openTerminalOnFailure(ctx, logUploader)
+		}
+	}
+
+	executor.reportScriptAnnotations(ctx, logUploader)
+
+	cirrusEnvVariables, err := cirrusEnv.ConsumeOnto(executor.env.Items())
 	if err != nil {
 		message := fmt.Sprintf("Failed collect CIRRUS_ENV subsystem results: %v", err)
 		log.Print(message)
@@ -502,12 +854,16 @@ func (executor *Executor) performStep(ctx context.Context, currentStep *api.Comm
 
 	// Pick up new CIRRUS_ENV variables
 	_, isSensitive := executor.env.Lookup("CIRRUS_ENV_SENSITIVE")
+	envBefore := executor.env.Snapshot()
 	executor.env.Merge(cirrusEnvVariables, isSensitive)
+	executor.reportEnvironmentDiff(ctx, logUploader, currentStep.Name, envBefore)
 
 	return &StepResult{
 		Success:        success,
 		SignaledToExit: signaledToExit,
 		Duration:       time.Since(start),
+		ExitCode:       exitCode,
+		Attempts:       scriptAttempts,
 	}, nil
 }
 
@@ -517,9 +873,28 @@ func (executor *Executor) ExecuteScriptsStreamLogsAndWait(
 	commandName string,
 	scripts []string,
 	env *environment.Environment) (*exec.Cmd, error) {
-	cmd, err := ShellCommandsAndWait(ctx, scripts, env, func(bytes []byte) (int, error) {
+	var sampler *processtree.Sampler
+
+	samplerCtx, samplerCancel := context.WithCancel(ctx)
+	defer samplerCancel()
+
+	var onStart func(cmd *exec.Cmd)
+	if env.Get(EnvCirrusProcessTreeMetrics) != "false" {
+		onStart = func(cmd *exec.Cmd) {
+			sampler = processtree.New(int32(cmd.Process.Pid))
+			go sampleProcessTree(samplerCtx, sampler)
+		}
+	}
+
+	cmd, err := shellCommandsAndWait(ctx, scripts, env, func(bytes []byte) (int, error) {
 		return logUploader.Write(bytes)
-	}, executor.shouldKillProcesses())
+	}, executor.shouldKillProcesses(), onStart)
+
+	samplerCancel()
+	if sampler != nil {
+		_, _ = logUploader.Write([]byte(formatTopConsumers(sampler)))
+	}
+
 	return cmd, err
 }
 
@@ -609,6 +984,7 @@ func (executor *Executor) CloneRepository(
 	}
 	customClient := &http.Client{
 		Transport: &http.Transport{
+			DialContext:     network.DialContext,
 			TLSClientConfig: &tls.Config{RootCAs: cert_pool},
 		},
 		Timeout: 900 * time.Second,
@@ -617,6 +993,7 @@ func (executor *Executor) CloneRepository(
 	gitclient.InstallProtocol("http", githttp.NewClient(customClient))
 
 	var repo *git.Repository
+	var gitMetadata api.ReportGitMetadataRequest
 
 	if is_pr {
 		repo, err = git.PlainInit(working_dir, false)
@@ -642,9 +1019,12 @@ func (executor *Executor) CloneRepository(
 			Progress:   logUploader,
 			Depth:      clone_depth,
 		}
+		gitMetadata.Ref = fmt.Sprintf("refs/pull/%s/head", pr_number)
 		err = repo.FetchContext(ctx, fetchOptions)
 		if err != nil && strings.Contains(err.Error(), "couldn't find remote ref") {
 			logUploader.Write([]byte("\nFailed to fetch head ref! Trying to fall back to merge ref..."))
+			gitMetadata.Ref = fmt.Sprintf("refs/pull/%s/merge", pr_number)
+			gitMetadata.MergeRefFallback = true
 			mergeRefSpec := fmt.Sprintf("+refs/pull/%s/merge:refs/remotes/origin/pull/%[1]s", pr_number)
 			fetchOptions.RefSpecs = []config.RefSpec{config.RefSpec(mergeRefSpec)}
 			if clone_depth > 0 {
@@ -696,6 +1076,10 @@ func (executor *Executor) CloneRepository(
 			cloneOptions.ReferenceName = plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", branch))
 		}
 		logUploader.Write([]byte(fmt.Sprintf("\nCloning %s...\n", cloneOptions.ReferenceName)))
+		gitMetadata.Ref = cloneOptions.ReferenceName.String()
+		if is_tag {
+			gitMetadata.Tag = tag
+		}
 
 		repo, err = git.PlainCloneContext(ctx, working_dir, false, &cloneOptions)
 
@@ -776,6 +1160,11 @@ func (executor *Executor) CloneRepository(
 	logUploader.Write([]byte(fmt.Sprintf("\nChecked out %s on %s branch.", change, branch)))
 	logUploader.Write([]byte("\nSuccessfully cloned!"))
 
+	if head, err := repo.Head(); err == nil {
+		gitMetadata.Sha = head.Hash().String()
+	}
+	executor.reportGitMetadata(ctx, &gitMetadata)
+
 	return true
 }
 
@@ -808,10 +1197,12 @@ func retryableCloneError(err error) bool {
 	return false
 }
 
-func (executor *Executor) reportError(message string) {
-	request := api.ReportAgentProblemRequest{
-		TaskIdentification: executor.taskIdentification,
-		Message:            message,
-	}
-	_, _ = client.CirrusClient.ReportAgentError(context.Background(), &request)
+func (executor *Executor) reportError(category client.ProblemCategory, message string) {
+	request := client.NewProblemRequest(executor.taskIdentification, category, message)
+	_, _ = client.CirrusClient.ReportAgentError(context.Background(), request)
+}
+
+func (executor *Executor) reportWarning(ctx context.Context, category client.ProblemCategory, message string) {
+	request := client.NewProblemRequest(executor.taskIdentification, category, message)
+	_, _ = client.CirrusClient.ReportAgentWarning(ctx, request)
 }
@@ -0,0 +1,145 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	// EnvCirrusRetryExitCodes declares the exit codes that re-run the script, e.g. 137 for
+	// the container runtimes killed by the OOM killer or 139 for the flaky segfaults.
+	//
+	// The entries are separated by semicolons or newlines and each of them is either "codes"
+	// (applies to all scripts) or "command:codes", where the codes are comma-separated and
+	// optionally followed by "@attempts" (the total number of executions, 3 by default),
+	// for example: "pull_script:137@5; 139".
+	EnvCirrusRetryExitCodes = "CIRRUS_RETRY_EXIT_CODES"
+
+	defaultExitCodeRetryAttempts = 3
+)
+
+type exitCodeRetryPolicy struct {
+	codes    map[int]struct{}
+	attempts int
+}
+
+// shouldRetry returns true if the script that has finished with the exit code
+// on the specified attempt (1-based) should be executed again.
+func (policy *exitCodeRetryPolicy) shouldRetry(exitCode int, attempt int) bool {
+	if policy == nil || attempt >= policy.attempts {
+		return false
+	}
+
+	_, ok := policy.codes[exitCode]
+
+	return ok
+}
+
+// parseExitCodeRetryPolicies returns the policies keyed by the command name,
+// with the policy that applies to all of the scripts stored under the empty key.
+func parseExitCodeRetryPolicies(raw string) (map[string]*exitCodeRetryPolicy, error) {
+	result := map[string]*exitCodeRetryPolicy{}
+
+	entries := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ';' || r == '\n'
+	})
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		var commandName string
+		if idx := strings.LastIndexByte(entry, ':'); idx != -1 {
+			commandName, entry = strings.TrimSpace(entry[:idx]), entry[idx+1:]
+		}
+
+		policy := &exitCodeRetryPolicy{
+			codes:    map[int]struct{}{},
+			attempts: defaultExitCodeRetryAttempts,
+		}
+
+		if idx := strings.IndexByte(entry, '@'); idx != -1 {
+			attempts, err := strconv.Atoi(strings.TrimSpace(entry[idx+1:]))
+			if err != nil || attempts < 1 {
+				return nil, fmt.Errorf("invalid number of attempts in %q", entry)
+			}
+
+			policy.attempts, entry = attempts, entry[:idx]
+		}
+
+		for _, rawCode := range strings.Split(entry, ",") {
+			code, err := strconv.Atoi(strings.TrimSpace(rawCode))
+			if err != nil {
+				return nil, fmt.Errorf("invalid exit code %q", rawCode)
+			}
+
+			policy.codes[code] = struct{}{}
+		}
+
+		result[commandName] = policy
+	}
+
+	return result, nil
+}
+
+// exitCodeRetryPolicy returns the retry policy for the command (if any).
+func (executor *Executor) exitCodeRetryPolicy(commandName string) (*exitCodeRetryPolicy, error) {
+	policies, err := parseExitCodeRetryPolicies(executor.env.Get(EnvCirrusRetryExitCodes))
+	if err != nil {
+		return nil, err
+	}
+
+	if policy, ok := policies[commandName]; ok {
+		return policy, nil
+	}
+
+	return policies[""], nil
+}
+
+// executeScriptsWithRetries executes the scripts, re-running them as long as they fail with
+// one of the exit codes declared for the command, and returns the last execution's result
+// along with the number of executions.
+func (executor *Executor) executeScriptsWithRetries(
+	ctx context.Context,
+	logUploader *LogUploader,
+	commandName string,
+	scripts []string,
+) (*exec.Cmd, int, error) {
+	policy, err := executor.exitCodeRetryPolicy(commandName)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "Ignoring invalid %s: %v\n", EnvCirrusRetryExitCodes, err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		cmd, err := executor.ExecuteScriptsStreamLogsAndWait(ctx, logUploader, commandName, scripts, executor.env)
+		if err != nil || cmd.ProcessState.Success() {
+			return cmd, attempt, err
+		}
+
+		exitCode := effectiveExitCode(cmd)
+		if !policy.shouldRetry(exitCode, attempt) {
+			return cmd, attempt, nil
+		}
+
+		_, _ = fmt.Fprintf(logUploader, "\nRe-running the script since it has exited with code %d "+
+			"(attempt %d of %d)...\n", exitCode, attempt+1, policy.attempts)
+
+		executor.resetWorkingDirBeforeRetry(ctx, logUploader)
+	}
+}
+
+// effectiveExitCode returns the exit code the way the shells report it,
+// i.e. 128 plus the signal number for the processes killed by a signal.
+func effectiveExitCode(cmd *exec.Cmd) int {
+	if ws, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		return 128 + int(ws.Signal())
+	}
+
+	return cmd.ProcessState.ExitCode()
+}
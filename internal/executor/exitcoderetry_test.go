@@ -0,0 +1,42 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExitCodeRetryPolicy(t *testing.T) {
+	executor := &Executor{env: environment.New(map[string]string{
+		EnvCirrusRetryExitCodes: "pull_script:137,143@5;\n139",
+	})}
+
+	policy, err := executor.exitCodeRetryPolicy("pull_script")
+	require.NoError(t, err)
+	assert.True(t, policy.shouldRetry(137, 1))
+	assert.True(t, policy.shouldRetry(143, 4))
+	assert.False(t, policy.shouldRetry(143, 5))
+	assert.False(t, policy.shouldRetry(139, 1))
+
+	policy, err = executor.exitCodeRetryPolicy("build_script")
+	require.NoError(t, err)
+	assert.True(t, policy.shouldRetry(139, 2))
+	assert.False(t, policy.shouldRetry(139, 3))
+	assert.False(t, policy.shouldRetry(1, 1))
+}
+
+func TestExitCodeRetryPolicyUnset(t *testing.T) {
+	executor := &Executor{env: environment.New(map[string]string{})}
+
+	policy, err := executor.exitCodeRetryPolicy("build_script")
+	require.NoError(t, err)
+	assert.False(t, policy.shouldRetry(137, 1))
+}
+
+func TestExitCodeRetryPolicyInvalid(t *testing.T) {
+	for _, raw := range []string{"abc", "137@0", "build:137@x"} {
+		_, err := parseExitCodeRetryPolicies(raw)
+		assert.Error(t, err, raw)
+	}
+}
@@ -0,0 +1,33 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/failuresnapshot"
+	"io"
+	"os"
+	"time"
+)
+
+// EnvCirrusFailureSnapshot set to "false" disables the resource snapshot
+// appended to the log of the failed commands.
+const EnvCirrusFailureSnapshot = "CIRRUS_FAILURE_SNAPSHOT"
+
+// writeFailureSnapshot appends the top processes, the free disk space and the number
+// of open files to the failed command's log, giving an immediate context for the
+// resource-related flakes.
+func (executor *Executor) writeFailureSnapshot(ctx context.Context, logs io.Writer) {
+	if executor.env.Get(EnvCirrusFailureSnapshot) == "false" {
+		return
+	}
+
+	snapshotCtx, snapshotCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer snapshotCancel()
+
+	snapshot := failuresnapshot.Take(snapshotCtx, []string{
+		executor.env.Get("CIRRUS_WORKING_DIR"),
+		os.TempDir(),
+	})
+
+	_, _ = fmt.Fprintf(logs, "\n%s", snapshot)
+}
@@ -0,0 +1,161 @@
+package failuresnapshot
+
+import (
+	"context"
+	"fmt"
+	"github.com/dustin/go-humanize"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/process"
+	"sort"
+	"strings"
+)
+
+const numTopProcesses = 5
+
+type Process struct {
+	PID  int32
+	Name string
+
+	// CPUPercent is the CPU usage averaged over the process lifetime
+	CPUPercent float64
+	RSS        uint64
+
+	// NumFDs is the number of the open file descriptors, -1 when unknown
+	NumFDs int32
+}
+
+type Disk struct {
+	Path  string
+	Free  uint64
+	Total uint64
+}
+
+// Snapshot is a point-in-time view of the system resources taken when a command fails
+// to provide an immediate context for the resource-related flakes.
+type Snapshot struct {
+	TopByCPU []Process
+	TopByRSS []Process
+	Disks    []Disk
+
+	// OpenFiles and MaxOpenFiles are the system-wide numbers
+	// of the allocated file handles, zero when unknown
+	OpenFiles    uint64
+	MaxOpenFiles uint64
+}
+
+// Take captures the snapshot, including the disk space available on the volumes
+// containing the specified paths. Parts that cannot be collected are omitted.
+func Take(ctx context.Context, paths []string) *Snapshot {
+	snapshot := &Snapshot{}
+
+	if processes, err := process.ProcessesWithContext(ctx); err == nil {
+		var infos []Process
+
+		for _, p := range processes {
+			name, err := p.NameWithContext(ctx)
+			if err != nil {
+				// The process has probably exited
+				continue
+			}
+
+			info := Process{PID: p.Pid, Name: name, NumFDs: -1}
+
+			if cpuPercent, err := p.CPUPercentWithContext(ctx); err == nil {
+				info.CPUPercent = cpuPercent
+			}
+			if memoryInfo, err := p.MemoryInfoWithContext(ctx); err == nil {
+				info.RSS = memoryInfo.RSS
+			}
+			if numFDs, err := p.NumFDsWithContext(ctx); err == nil {
+				info.NumFDs = numFDs
+			}
+
+			infos = append(infos, info)
+		}
+
+		snapshot.TopByCPU = top(infos, func(a, b Process) bool { return a.CPUPercent > b.CPUPercent })
+		snapshot.TopByRSS = top(infos, func(a, b Process) bool { return a.RSS > b.RSS })
+	}
+
+	seen := map[string]bool{}
+
+	for _, path := range paths {
+		if path == "" || seen[path] {
+			continue
+		}
+		seen[path] = true
+
+		usage, err := disk.UsageWithContext(ctx, path)
+		if err != nil {
+			continue
+		}
+
+		snapshot.Disks = append(snapshot.Disks, Disk{Path: path, Free: usage.Free, Total: usage.Total})
+	}
+
+	snapshot.OpenFiles, snapshot.MaxOpenFiles = openFiles()
+
+	return snapshot
+}
+
+func top(processes []Process, less func(a, b Process) bool) []Process {
+	result := make([]Process, len(processes))
+	copy(result, processes)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return less(result[i], result[j])
+	})
+
+	if len(result) > numTopProcesses {
+		result = result[:numTopProcesses]
+	}
+
+	return result
+}
+
+func (snapshot *Snapshot) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("Resource snapshot at the time of the failure:\n")
+
+	writeProcesses := func(title string, processes []Process) {
+		if len(processes) == 0 {
+			return
+		}
+
+		fmt.Fprintf(&sb, "  %s:\n", title)
+
+		for _, p := range processes {
+			fmt.Fprintf(&sb, "    %s (PID %d): %.1f%% CPU, %s RSS", p.Name, p.PID, p.CPUPercent, humanize.Bytes(p.RSS))
+
+			if p.NumFDs >= 0 {
+				fmt.Fprintf(&sb, ", %d open files", p.NumFDs)
+			}
+
+			sb.WriteString("\n")
+		}
+	}
+
+	writeProcesses("Top processes by CPU", snapshot.TopByCPU)
+	writeProcesses("Top processes by memory", snapshot.TopByRSS)
+
+	if len(snapshot.Disks) != 0 {
+		sb.WriteString("  Disk space:\n")
+
+		for _, disk := range snapshot.Disks {
+			fmt.Fprintf(&sb, "    %s: %s free of %s\n", disk.Path, humanize.Bytes(disk.Free), humanize.Bytes(disk.Total))
+		}
+	}
+
+	if snapshot.OpenFiles != 0 {
+		fmt.Fprintf(&sb, "  Open files: %d", snapshot.OpenFiles)
+
+		if snapshot.MaxOpenFiles != 0 {
+			fmt.Fprintf(&sb, " of %d", snapshot.MaxOpenFiles)
+		}
+
+		sb.WriteString(" system-wide\n")
+	}
+
+	return sb.String()
+}
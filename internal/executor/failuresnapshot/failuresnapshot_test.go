@@ -0,0 +1,47 @@
+package failuresnapshot
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	snapshot := &Snapshot{
+		TopByCPU: []Process{
+			{PID: 42, Name: "java", CPUPercent: 150, RSS: 2 * 1000 * 1000 * 1000, NumFDs: 120},
+		},
+		TopByRSS: []Process{
+			{PID: 42, Name: "java", CPUPercent: 150, RSS: 2 * 1000 * 1000 * 1000, NumFDs: 120},
+			{PID: 43, Name: "node", CPUPercent: 5, RSS: 500 * 1000 * 1000, NumFDs: -1},
+		},
+		Disks: []Disk{
+			{Path: "/tmp/cirrus-ci-build", Free: 1000 * 1000 * 1000, Total: 100 * 1000 * 1000 * 1000},
+		},
+		OpenFiles:    1234,
+		MaxOpenFiles: 65536,
+	}
+
+	require.Equal(t, `Resource snapshot at the time of the failure:
+  Top processes by CPU:
+    java (PID 42): 150.0% CPU, 2.0 GB RSS, 120 open files
+  Top processes by memory:
+    java (PID 42): 150.0% CPU, 2.0 GB RSS, 120 open files
+    node (PID 43): 5.0% CPU, 500 MB RSS
+  Disk space:
+    /tmp/cirrus-ci-build: 1.0 GB free of 100 GB
+  Open files: 1234 of 65536 system-wide
+`, snapshot.String())
+}
+
+func TestTop(t *testing.T) {
+	var processes []Process
+
+	for i := int32(1); i <= 10; i++ {
+		processes = append(processes, Process{PID: i, RSS: uint64(i)})
+	}
+
+	result := top(processes, func(a, b Process) bool { return a.RSS > b.RSS })
+	require.Len(t, result, numTopProcesses)
+	require.EqualValues(t, 10, result[0].PID)
+	require.EqualValues(t, 6, result[4].PID)
+}
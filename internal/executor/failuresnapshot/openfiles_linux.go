@@ -0,0 +1,45 @@
+//go:build linux
+// +build linux
+
+package failuresnapshot
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// openFiles parses /proc/sys/fs/file-nr, which contains the number of allocated
+// file handles, the number of unused ones (always zero since Linux 2.6) and the maximum.
+func openFiles() (uint64, uint64) {
+	content, err := os.ReadFile("/proc/sys/fs/file-nr")
+	if err != nil {
+		return 0, 0
+	}
+
+	return parseFileNr(string(content))
+}
+
+func parseFileNr(content string) (uint64, uint64) {
+	fields := strings.Fields(content)
+	if len(fields) != 3 {
+		return 0, 0
+	}
+
+	allocated, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0
+	}
+
+	unused, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil || unused > allocated {
+		return 0, 0
+	}
+
+	max, err := strconv.ParseUint(fields[2], 10, 64)
+	if err != nil {
+		return allocated - unused, 0
+	}
+
+	return allocated - unused, max
+}
@@ -0,0 +1,16 @@
+package failuresnapshot
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseFileNr(t *testing.T) {
+	allocated, max := parseFileNr("1234\t0\t9223372036854775807\n")
+	require.EqualValues(t, 1234, allocated)
+	require.EqualValues(t, uint64(9223372036854775807), max)
+
+	allocated, max = parseFileNr("garbage")
+	require.Zero(t, allocated)
+	require.Zero(t, max)
+}
@@ -0,0 +1,147 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/flakyrerun"
+	"log"
+	"strconv"
+	"time"
+)
+
+const (
+	// EnvCirrusRerunFailedTests is set to the test framework (go, pytest or jest) to re-run
+	// the failed tests of a failed script once and treat the script as passed if they pass.
+	EnvCirrusRerunFailedTests = "CIRRUS_RERUN_FAILED_TESTS"
+
+	// EnvCirrusRerunJUnit contains the glob patterns of the JUnit XML reports produced by the script.
+	EnvCirrusRerunJUnit = "CIRRUS_RERUN_JUNIT"
+
+	// EnvCirrusRerunCommand overrides the framework's default command (e.g. "poetry run pytest").
+	EnvCirrusRerunCommand = "CIRRUS_RERUN_COMMAND"
+
+	// EnvCirrusRerunMaxTests limits the number of failed tests to re-run, since a lot
+	// of failures is more likely to be a genuine breakage than flakiness.
+	EnvCirrusRerunMaxTests = "CIRRUS_RERUN_MAX_TESTS"
+
+	defaultRerunMaxTests = 20
+)
+
+type flakyTestDetails struct {
+	Test     flakyrerun.FailedTest `json:"test"`
+	Flaky    bool                  `json:"flaky"`
+	Attempts []string              `json:"attempts"`
+}
+
+// rerunFailedTests re-runs the tests that failed in the script started at the specified time
+// (if requested), returning true if all of them have passed on the re-run.
+func (executor *Executor) rerunFailedTests(
+	ctx context.Context,
+	logUploader *LogUploader,
+	commandName string,
+	start time.Time,
+) bool {
+	framework := executor.env.Get(EnvCirrusRerunFailedTests)
+	if framework == "" {
+		return false
+	}
+
+	rawPatterns := executor.env.Get(EnvCirrusRerunJUnit)
+	if rawPatterns == "" {
+		_, _ = fmt.Fprintf(logUploader, "\nNot re-running the failed tests: %s is not set\n", EnvCirrusRerunJUnit)
+		return false
+	}
+
+	patterns := absolutePatterns(executor.env.Get("CIRRUS_WORKING_DIR"), splitPatterns(rawPatterns))
+
+	// Truncate to accommodate the file systems with a coarse modification time granularity
+	failedTests, err := flakyrerun.LoadFailures(patterns, start.Truncate(time.Second))
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nNot re-running the failed tests: %v\n", err)
+		return false
+	}
+
+	if len(failedTests) == 0 {
+		_, _ = fmt.Fprintf(logUploader, "\nNot re-running the failed tests: no failed tests found in the JUnit reports\n")
+		return false
+	}
+
+	maxTests := defaultRerunMaxTests
+	if value, err := strconv.Atoi(executor.env.Get(EnvCirrusRerunMaxTests)); err == nil && value > 0 {
+		maxTests = value
+	}
+
+	if len(failedTests) > maxTests {
+		_, _ = fmt.Fprintf(logUploader, "\nNot re-running the failed tests: %d tests failed, which is more than %d\n",
+			len(failedTests), maxTests)
+		return false
+	}
+
+	script, err := flakyrerun.RerunScript(framework, executor.env.Get(EnvCirrusRerunCommand), failedTests)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "\nNot re-running the failed tests: %v\n", err)
+		return false
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "\nRe-running %d failed test(s) to check whether they're flaky...\n%s\n",
+		len(failedTests), script)
+
+	cmd, err := executor.ExecuteScriptsStreamLogsAndWait(ctx, logUploader, commandName, []string{script}, executor.env)
+	passed := err == nil && cmd.ProcessState.Success()
+
+	if passed {
+		_, _ = fmt.Fprintf(logUploader, "\nAll of the failed tests have passed on re-run and are considered flaky\n")
+	} else {
+		_, _ = fmt.Fprintf(logUploader, "\nThe failed tests have failed on re-run too\n")
+	}
+
+	executor.reportRerunResults(ctx, failedTests, passed)
+
+	return passed
+}
+
+func (executor *Executor) reportRerunResults(ctx context.Context, failedTests []flakyrerun.FailedTest, passed bool) {
+	var annotations []*api.Annotation
+
+	for _, failedTest := range failedTests {
+		details := flakyTestDetails{
+			Test:     failedTest,
+			Flaky:    passed,
+			Attempts: []string{"failed", "failed"},
+		}
+
+		level := api.Annotation_FAILURE
+		message := "Failed both initially and on re-run"
+
+		if passed {
+			details.Attempts[1] = "passed"
+			level = api.Annotation_WARNING
+			message = "Flaky test: failed initially, but passed on re-run"
+		}
+
+		rawDetails, err := json.Marshal(details)
+		if err != nil {
+			log.Printf("Failed to serialize the re-run results: %v", err)
+			return
+		}
+
+		annotations = append(annotations, &api.Annotation{
+			Type:               api.Annotation_TEST_RESULT,
+			Level:              level,
+			Message:            message,
+			RawDetails:         string(rawDetails),
+			FullyQualifiedName: failedTest.ID(),
+		})
+	}
+
+	_, err := client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: executor.taskIdentification,
+		Annotations:        annotations,
+	})
+	if err != nil {
+		log.Printf("Failed to report the re-run results: %v", err)
+	}
+}
@@ -0,0 +1,194 @@
+// Package flakyrerun builds the commands that re-run only the failed tests
+// of the supported test frameworks based on their JUnit XML reports.
+package flakyrerun
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+const (
+	FrameworkGo     = "go"
+	FrameworkPytest = "pytest"
+	FrameworkJest   = "jest"
+)
+
+var (
+	ErrUnknownFramework = errors.New("unknown test framework, supported frameworks are: go, pytest and jest")
+	ErrNoFailedTests    = errors.New("no failed tests to re-run")
+
+	defaultCommands = map[string]string{
+		FrameworkGo:     "go test",
+		FrameworkPytest: "python -m pytest",
+		FrameworkJest:   "npx jest",
+	}
+)
+
+// RerunScript returns the script that re-runs the failed tests using the framework's command
+// (or the specified one, e.g. "poetry run pytest", when not empty).
+func RerunScript(framework string, command string, tests []FailedTest) (string, error) {
+	defaultCommand, ok := defaultCommands[framework]
+	if !ok {
+		return "", fmt.Errorf("%w, got %q", ErrUnknownFramework, framework)
+	}
+
+	if command == "" {
+		command = defaultCommand
+	}
+
+	if len(tests) == 0 {
+		return "", ErrNoFailedTests
+	}
+
+	switch framework {
+	case FrameworkGo:
+		return goRerunScript(command, tests), nil
+	case FrameworkPytest:
+		return pytestRerunScript(command, tests), nil
+	default:
+		return jestRerunScript(command, tests)
+	}
+}
+
+// ID returns a human-readable identifier of the test.
+func (test FailedTest) ID() string {
+	if test.ClassName == "" {
+		return test.Name
+	}
+
+	return test.ClassName + "." + test.Name
+}
+
+// goRerunScript re-runs the failed top-level tests of each package separately, since
+// the classname reported by go-junit-report and gotestsum is the package's import path.
+func goRerunScript(command string, tests []FailedTest) string {
+	var packages []string
+	testsByPackage := map[string][]string{}
+
+	for _, test := range tests {
+		// Subtests can only be re-run together with their parent
+		name := strings.SplitN(test.Name, "/", 2)[0]
+
+		names, ok := testsByPackage[test.ClassName]
+		if !ok {
+			packages = append(packages, test.ClassName)
+		}
+
+		if !contains(names, name) {
+			testsByPackage[test.ClassName] = append(names, name)
+		}
+	}
+
+	var commands []string
+
+	for _, pkg := range packages {
+		runRegex := fmt.Sprintf("^(%s)$", strings.Join(testsByPackage[pkg], "|"))
+		commands = append(commands, fmt.Sprintf("%s -count=1 -run %s %s", command, quote(runRegex), quote(pkg)))
+	}
+
+	return strings.Join(commands, " && ")
+}
+
+func pytestRerunScript(command string, tests []FailedTest) string {
+	var nodeIDs []string
+
+	for _, test := range tests {
+		nodeID := pytestNodeID(test)
+
+		if !contains(nodeIDs, nodeID) {
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+	}
+
+	var quoted []string
+	for _, nodeID := range nodeIDs {
+		quoted = append(quoted, quote(nodeID))
+	}
+
+	return fmt.Sprintf("%s %s", command, strings.Join(quoted, " "))
+}
+
+// pytestNodeID converts the JUnit test case into a pytest node ID (e.g. "tests/test_api.py::TestUsers::test_create"),
+// relying on the file attribute when it's reported and on the classes being capitalized otherwise.
+func pytestNodeID(test FailedTest) string {
+	components := strings.Split(test.ClassName, ".")
+
+	var modulePath string
+	var classes []string
+
+	if test.File != "" {
+		modulePath = test.File
+		moduleComponents := strings.Split(strings.TrimSuffix(path.Clean(test.File), ".py"), "/")
+
+		if len(components) >= len(moduleComponents) {
+			classes = components[len(moduleComponents):]
+		}
+	} else {
+		var moduleComponents []string
+
+		for i, component := range components {
+			if component != "" && unicode.IsUpper([]rune(component)[0]) {
+				classes = components[i:]
+				break
+			}
+
+			moduleComponents = append(moduleComponents, component)
+		}
+
+		modulePath = strings.Join(moduleComponents, "/") + ".py"
+	}
+
+	return strings.Join(append(append([]string{modulePath}, classes...), test.Name), "::")
+}
+
+func jestRerunScript(command string, tests []FailedTest) (string, error) {
+	var files []string
+	var names []string
+
+	for _, test := range tests {
+		if test.File == "" {
+			return "", fmt.Errorf("test %q has no file attribute, make sure that jest-junit "+
+				"is configured with addFileAttribute set to true", test.ID())
+		}
+
+		if !contains(files, test.File) {
+			files = append(files, test.File)
+		}
+
+		name := regexp.QuoteMeta(test.Name)
+		if !contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	var quotedFiles []string
+	for _, file := range files {
+		quotedFiles = append(quotedFiles, quote(file))
+	}
+
+	return fmt.Sprintf("%s %s -t %s", command, strings.Join(quotedFiles, " "),
+		quote(strings.Join(names, "|"))), nil
+}
+
+func quote(s string) string {
+	if runtime.GOOS == "windows" {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, candidate := range haystack {
+		if candidate == needle {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+//go:build !windows
+// +build !windows
+
+package flakyrerun_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/flakyrerun"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFailures(t *testing.T) {
+	failures, err := flakyrerun.ParseFailures(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" file="tests/test_api.py">
+    <testcase classname="tests.test_api.TestUsers" name="test_create"><failure message="boom"/></testcase>
+    <testcase classname="tests.test_api.TestUsers" name="test_delete"/>
+    <testcase classname="tests.test_api" name="test_health"><error message="timeout"/></testcase>
+  </testsuite>
+</testsuites>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []flakyrerun.FailedTest{
+		{ClassName: "tests.test_api.TestUsers", Name: "test_create", File: "tests/test_api.py"},
+		{ClassName: "tests.test_api", Name: "test_health", File: "tests/test_api.py"},
+	}, failures)
+}
+
+func TestParseFailuresSingleSuite(t *testing.T) {
+	failures, err := flakyrerun.ParseFailures(strings.NewReader(`<testsuite name="pkg">
+  <testcase classname="github.com/acme/pkg" name="TestA"><failure/></testcase>
+</testsuite>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []flakyrerun.FailedTest{{ClassName: "github.com/acme/pkg", Name: "TestA"}}, failures)
+}
+
+func TestLoadFailuresIgnoresStaleReports(t *testing.T) {
+	dir := t.TempDir()
+	report := `<testsuite><testcase classname="pkg" name="TestA"><failure/></testcase></testsuite>`
+
+	stalePath := filepath.Join(dir, "stale.xml")
+	require.NoError(t, os.WriteFile(stalePath, []byte(report), 0600))
+	require.NoError(t, os.Chtimes(stalePath, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour)))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "fresh.xml"), []byte(report), 0600))
+
+	failures, err := flakyrerun.LoadFailures([]string{filepath.Join(dir, "*.xml")}, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Len(t, failures, 1)
+}
+
+func TestRerunScriptGo(t *testing.T) {
+	script, err := flakyrerun.RerunScript(flakyrerun.FrameworkGo, "", []flakyrerun.FailedTest{
+		{ClassName: "github.com/acme/a", Name: "TestA"},
+		{ClassName: "github.com/acme/a", Name: "TestB/subtest"},
+		{ClassName: "github.com/acme/a", Name: "TestB"},
+		{ClassName: "github.com/acme/b", Name: "TestC"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "go test -count=1 -run '^(TestA|TestB)$' 'github.com/acme/a' && "+
+		"go test -count=1 -run '^(TestC)$' 'github.com/acme/b'", script)
+}
+
+func TestRerunScriptPytest(t *testing.T) {
+	script, err := flakyrerun.RerunScript(flakyrerun.FrameworkPytest, "poetry run pytest", []flakyrerun.FailedTest{
+		{ClassName: "tests.test_api.TestUsers", Name: "test_create", File: "tests/test_api.py"},
+		{ClassName: "tests.test_models", Name: "test_user[param]"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "poetry run pytest 'tests/test_api.py::TestUsers::test_create' "+
+		"'tests/test_models.py::test_user[param]'", script)
+}
+
+func TestRerunScriptJest(t *testing.T) {
+	script, err := flakyrerun.RerunScript(flakyrerun.FrameworkJest, "", []flakyrerun.FailedTest{
+		{ClassName: "Users create", Name: "Users create (works)", File: "src/users.test.js"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `npx jest 'src/users.test.js' -t 'Users create \(works\)'`, script)
+
+	_, err = flakyrerun.RerunScript(flakyrerun.FrameworkJest, "", []flakyrerun.FailedTest{{Name: "works"}})
+	assert.Error(t, err)
+}
+
+func TestRerunScriptErrors(t *testing.T) {
+	_, err := flakyrerun.RerunScript("rspec", "", []flakyrerun.FailedTest{{Name: "works"}})
+	assert.ErrorIs(t, err, flakyrerun.ErrUnknownFramework)
+
+	_, err = flakyrerun.RerunScript(flakyrerun.FrameworkGo, "", nil)
+	assert.ErrorIs(t, err, flakyrerun.ErrNoFailedTests)
+}
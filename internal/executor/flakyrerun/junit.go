@@ -0,0 +1,131 @@
+package flakyrerun
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/bmatcuk/doublestar"
+	"io"
+	"os"
+	"time"
+)
+
+// FailedTest is a failed JUnit test case.
+type FailedTest struct {
+	ClassName string `json:"classname"`
+	Name      string `json:"name"`
+	File      string `json:"file,omitempty"`
+}
+
+type junitTestSuites struct {
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	File   string           `xml:"file,attr"`
+	Cases  []junitTestCase  `xml:"testcase"`
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestCase struct {
+	Name      string    `xml:"name,attr"`
+	ClassName string    `xml:"classname,attr"`
+	File      string    `xml:"file,attr"`
+	Failures  []xmlNode `xml:"failure"`
+	Errors    []xmlNode `xml:"error"`
+}
+
+type xmlNode struct{}
+
+// LoadFailures collects the failed tests from the JUnit XML reports matching
+// the glob patterns that were modified after since, to ignore the stale reports.
+func LoadFailures(patterns []string, since time.Time) ([]FailedTest, error) {
+	var result []FailedTest
+
+	for _, pattern := range patterns {
+		paths, err := doublestar.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+
+			if info.ModTime().Before(since) {
+				continue
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				return nil, err
+			}
+
+			failures, err := ParseFailures(file)
+			_ = file.Close()
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+			}
+
+			result = append(result, failures...)
+		}
+	}
+
+	return result, nil
+}
+
+// ParseFailures returns the test cases that have either a <failure> or an <error> in the JUnit XML report.
+func ParseFailures(r io.Reader) ([]FailedTest, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The root element is either <testsuites> or a single <testsuite>
+	var suites junitTestSuites
+	if err := xml.Unmarshal(content, &suites); err != nil {
+		return nil, err
+	}
+
+	if len(suites.Suites) == 0 {
+		var suite junitTestSuite
+		if err := xml.Unmarshal(content, &suite); err != nil {
+			return nil, err
+		}
+		suites.Suites = []junitTestSuite{suite}
+	}
+
+	var result []FailedTest
+
+	for _, suite := range suites.Suites {
+		result = appendFailures(result, suite)
+	}
+
+	return result, nil
+}
+
+func appendFailures(result []FailedTest, suite junitTestSuite) []FailedTest {
+	for _, testCase := range suite.Cases {
+		if len(testCase.Failures) == 0 && len(testCase.Errors) == 0 {
+			continue
+		}
+
+		file := testCase.File
+		if file == "" {
+			file = suite.File
+		}
+
+		result = append(result, FailedTest{
+			ClassName: testCase.ClassName,
+			Name:      testCase.Name,
+			File:      file,
+		})
+	}
+
+	for _, nested := range suite.Suites {
+		result = appendFailures(result, nested)
+	}
+
+	return result
+}
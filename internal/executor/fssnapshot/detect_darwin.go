@@ -0,0 +1,32 @@
+package fssnapshot
+
+import (
+	"syscall"
+)
+
+func detectFilesystem(dir string) (filesystem, error) {
+	var stat syscall.Statfs_t
+
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return "", err
+	}
+
+	var name []byte
+
+	for _, c := range stat.Fstypename {
+		if c == 0 {
+			break
+		}
+
+		name = append(name, byte(c))
+	}
+
+	switch string(name) {
+	case "apfs":
+		return filesystemAPFS, nil
+	case "zfs":
+		return filesystemZFS, nil
+	default:
+		return "", ErrUnsupported
+	}
+}
@@ -0,0 +1,43 @@
+package fssnapshot
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// See statfs(2)
+const (
+	btrfsSuperMagic = 0x9123683e
+	zfsSuperMagic   = 0x2fc12fc2
+)
+
+// btrfsSubvolumeRootInode is the inode number of the root directory of any btrfs subvolume.
+const btrfsSubvolumeRootInode = 256
+
+func detectFilesystem(dir string) (filesystem, error) {
+	var stat syscall.Statfs_t
+
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return "", err
+	}
+
+	switch uint32(stat.Type) {
+	case btrfsSuperMagic:
+		info, err := os.Stat(dir)
+		if err != nil {
+			return "", err
+		}
+
+		if sys, ok := info.Sys().(*syscall.Stat_t); !ok || sys.Ino != btrfsSubvolumeRootInode {
+			return "", fmt.Errorf("%w: %s is on btrfs, but it's not a subvolume, "+
+				"create one with \"btrfs subvolume create %s\"", ErrUnsupported, dir, dir)
+		}
+
+		return filesystemBtrfs, nil
+	case zfsSuperMagic:
+		return filesystemZFS, nil
+	default:
+		return "", ErrUnsupported
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package fssnapshot
+
+func detectFilesystem(dir string) (filesystem, error) {
+	return "", ErrUnsupported
+}
@@ -0,0 +1,331 @@
+// Package fssnapshot captures the state of a directory using the copy-on-write filesystem
+// features and restores it later, which is orders of magnitude faster than deleting the
+// directory and re-creating its contents (e.g. re-cloning the repository).
+//
+// The supported filesystems are btrfs (the directory should be a subvolume), ZFS (the directory
+// should be the mountpoint of a dataset) and APFS (the directory is cloned with clonefile(2)).
+package fssnapshot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrUnsupported = errors.New("the filesystem doesn't support snapshots, " +
+		"only btrfs subvolumes, ZFS datasets and APFS volumes are supported")
+	ErrNotExist = errors.New("snapshot doesn't exist")
+)
+
+// Snapshotter manages a single named snapshot of a directory.
+type Snapshotter interface {
+	// Filesystem is the name of the filesystem used to take the snapshot (e.g. "btrfs")
+	Filesystem() string
+
+	// Exists returns true if the snapshot was previously created (possibly by another process)
+	Exists(ctx context.Context) (bool, error)
+
+	// Create captures the current state of the directory, replacing the existing snapshot
+	Create(ctx context.Context) error
+
+	// Rollback restores the directory to the state captured in the snapshot. The directory
+	// might be re-created, so the processes should re-open it (e.g. with os.Chdir) afterwards.
+	Rollback(ctx context.Context) error
+
+	// Delete removes the snapshot
+	Delete(ctx context.Context) error
+}
+
+type filesystem string
+
+const (
+	filesystemBtrfs filesystem = "btrfs"
+	filesystemZFS   filesystem = "zfs"
+	filesystemAPFS  filesystem = "apfs"
+)
+
+// New returns the snapshotter for the dir that is appropriate for its filesystem,
+// the name distinguishes the snapshots of the same directory.
+func New(ctx context.Context, dir string, name string) (Snapshotter, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mountpoints and the subvolumes are matched against the real path
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fs, err := detectFilesystem(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	switch fs {
+	case filesystemBtrfs:
+		return &btrfs{dir: dir, snapshot: siblingPath(dir, name)}, nil
+	case filesystemZFS:
+		dataset, err := zfsDataset(ctx, dir)
+		if err != nil {
+			return nil, err
+		}
+
+		return &zfs{dataset: dataset, snapshot: fmt.Sprintf("%s@cirrus-%s", dataset, name)}, nil
+	case filesystemAPFS:
+		return &apfs{dir: dir, snapshot: siblingPath(dir, name)}, nil
+	default:
+		return nil, ErrUnsupported
+	}
+}
+
+// siblingPath is where the snapshot is stored for the filesystems that expose
+// the snapshots as directories, which should be on the same volume as the dir.
+func siblingPath(dir string, name string) string {
+	return filepath.Join(filepath.Dir(dir), fmt.Sprintf(".%s.cirrus-snapshot-%s", filepath.Base(dir), name))
+}
+
+type btrfs struct {
+	dir      string
+	snapshot string
+}
+
+func (snapshotter *btrfs) Filesystem() string {
+	return string(filesystemBtrfs)
+}
+
+func (snapshotter *btrfs) Exists(ctx context.Context) (bool, error) {
+	return pathExists(snapshotter.snapshot)
+}
+
+func (snapshotter *btrfs) Create(ctx context.Context) error {
+	if err := snapshotter.Delete(ctx); err != nil && !errors.Is(err, ErrNotExist) {
+		return err
+	}
+
+	_, err := run(ctx, "btrfs", "subvolume", "snapshot", "-r", snapshotter.dir, snapshotter.snapshot)
+
+	return err
+}
+
+func (snapshotter *btrfs) Rollback(ctx context.Context) error {
+	exists, err := snapshotter.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotExist
+	}
+
+	// Subvolumes can't be rolled back in place, so replace the directory
+	// with a writable snapshot of the read-only one
+	if _, err := run(ctx, "btrfs", "subvolume", "delete", snapshotter.dir); err != nil {
+		return err
+	}
+
+	_, err = run(ctx, "btrfs", "subvolume", "snapshot", snapshotter.snapshot, snapshotter.dir)
+
+	return err
+}
+
+func (snapshotter *btrfs) Delete(ctx context.Context) error {
+	exists, err := snapshotter.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotExist
+	}
+
+	_, err = run(ctx, "btrfs", "subvolume", "delete", snapshotter.snapshot)
+
+	return err
+}
+
+type zfs struct {
+	dataset  string
+	snapshot string
+}
+
+func (snapshotter *zfs) Filesystem() string {
+	return string(filesystemZFS)
+}
+
+func (snapshotter *zfs) Exists(ctx context.Context) (bool, error) {
+	output, err := run(ctx, "zfs", "list", "-H", "-t", "snapshot", "-o", "name", "-d", "1", snapshotter.dataset)
+	if err != nil {
+		return false, err
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) == snapshotter.snapshot {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (snapshotter *zfs) Create(ctx context.Context) error {
+	if err := snapshotter.Delete(ctx); err != nil && !errors.Is(err, ErrNotExist) {
+		return err
+	}
+
+	_, err := run(ctx, "zfs", "snapshot", snapshotter.snapshot)
+
+	return err
+}
+
+func (snapshotter *zfs) Rollback(ctx context.Context) error {
+	exists, err := snapshotter.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotExist
+	}
+
+	// -r destroys the snapshots taken after this one, which would otherwise prevent the rollback
+	_, err = run(ctx, "zfs", "rollback", "-r", snapshotter.snapshot)
+
+	return err
+}
+
+func (snapshotter *zfs) Delete(ctx context.Context) error {
+	exists, err := snapshotter.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotExist
+	}
+
+	_, err = run(ctx, "zfs", "destroy", snapshotter.snapshot)
+
+	return err
+}
+
+// zfsDataset finds the dataset that is mounted at the dir.
+func zfsDataset(ctx context.Context, dir string) (string, error) {
+	output, err := run(ctx, "zfs", "list", "-H", "-t", "filesystem", "-o", "name,mountpoint")
+	if err != nil {
+		return "", err
+	}
+
+	return parseZFSDataset(output, dir)
+}
+
+func parseZFSDataset(output string, dir string) (string, error) {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 2 {
+			continue
+		}
+
+		if filepath.Clean(fields[1]) == filepath.Clean(dir) {
+			return fields[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s is on ZFS, but it's not a mountpoint of a dataset, "+
+		"create one with \"zfs create -o mountpoint=%s <pool>/<name>\"", ErrUnsupported, dir, dir)
+}
+
+// apfs stores the snapshot as a clone of the dir, the APFS volume snapshots
+// are reserved for the Time Machine and can't be reverted selectively.
+type apfs struct {
+	dir      string
+	snapshot string
+}
+
+func (snapshotter *apfs) Filesystem() string {
+	return string(filesystemAPFS)
+}
+
+func (snapshotter *apfs) Exists(ctx context.Context) (bool, error) {
+	return pathExists(snapshotter.snapshot)
+}
+
+func (snapshotter *apfs) Create(ctx context.Context) error {
+	if err := os.RemoveAll(snapshotter.snapshot); err != nil {
+		return err
+	}
+
+	// -c clones the files with clonefile(2) instead of copying their contents
+	_, err := run(ctx, "cp", "-c", "-p", "-R", snapshotter.dir, snapshotter.snapshot)
+
+	return err
+}
+
+func (snapshotter *apfs) Rollback(ctx context.Context) error {
+	exists, err := snapshotter.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotExist
+	}
+
+	// Keep the directory itself, so that the processes that reference it remain unaffected
+	entries, err := os.ReadDir(snapshotter.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(snapshotter.dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	// The trailing slash makes the BSD cp copy the contents of the snapshot instead of the snapshot itself
+	_, err = run(ctx, "cp", "-c", "-p", "-R", snapshotter.snapshot+"/", snapshotter.dir)
+
+	return err
+}
+
+func (snapshotter *apfs) Delete(ctx context.Context) error {
+	exists, err := snapshotter.Exists(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotExist
+	}
+
+	return os.RemoveAll(snapshotter.snapshot)
+}
+
+func pathExists(path string) (bool, error) {
+	_, err := os.Lstat(path)
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+func run(ctx context.Context, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%q failed: %w: %s", strings.Join(cmd.Args, " "), err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
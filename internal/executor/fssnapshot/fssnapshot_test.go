@@ -0,0 +1,64 @@
+package fssnapshot
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseZFSDataset(t *testing.T) {
+	output := "tank\t/tank\n" +
+		"tank/ci\t/var/ci\n" +
+		"tank/ci/build\t/var/ci/build\n"
+
+	dataset, err := parseZFSDataset(output, "/var/ci/build")
+	require.NoError(t, err)
+	assert.Equal(t, "tank/ci/build", dataset)
+
+	_, err = parseZFSDataset(output, "/var/ci/build/nested")
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestSiblingPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("/var", "ci", ".build.cirrus-snapshot-clone"),
+		siblingPath(filepath.Join("/var", "ci", "build"), "clone"))
+}
+
+// TestRoundTrip only runs when the temporary directory is on a supported filesystem
+// (e.g. on the macOS runners), since creating a btrfs subvolume or a ZFS dataset requires root.
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	dir := filepath.Join(t.TempDir(), "build")
+	require.NoError(t, os.Mkdir(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pristine.txt"), []byte("pristine"), 0600))
+
+	snapshotter, err := New(ctx, dir, "test")
+	if errors.Is(err, ErrUnsupported) {
+		t.Skipf("the temporary directory doesn't support snapshots: %v", err)
+	}
+	require.NoError(t, err)
+
+	exists, err := snapshotter.Exists(ctx)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	require.NoError(t, snapshotter.Create(ctx))
+	defer func() {
+		require.NoError(t, snapshotter.Delete(ctx))
+	}()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pristine.txt"), []byte("modified"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build.log"), []byte("garbage"), 0600))
+
+	require.NoError(t, snapshotter.Rollback(ctx))
+
+	content, err := os.ReadFile(filepath.Join(dir, "pristine.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "pristine", string(content))
+	assert.NoFileExists(t, filepath.Join(dir, "build.log"))
+}
@@ -0,0 +1,46 @@
+package gcpsmunboxer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type BoxedValue struct {
+	name string
+}
+
+const (
+	prefix = "GCPSM["
+	suffix = "]"
+)
+
+var (
+	ErrNotABoxedValue    = errors.New("doesn't look like a GCP Secret Manager-boxed value")
+	ErrInvalidBoxedValue = errors.New("GCP Secret Manager-boxed value has an invalid format")
+)
+
+func NewBoxedValue(rawBoxedValue string) (*BoxedValue, error) {
+	if !strings.HasPrefix(rawBoxedValue, prefix) || !strings.HasSuffix(rawBoxedValue, suffix) {
+		return nil, ErrNotABoxedValue
+	}
+
+	name := strings.TrimSuffix(strings.TrimPrefix(rawBoxedValue, prefix), suffix)
+
+	// projects/{project}/secrets/{secret}/versions/{version}
+	parts := strings.Split(name, "/")
+	if len(parts) != 6 || parts[0] != "projects" || parts[2] != "secrets" || parts[4] != "versions" {
+		return nil, fmt.Errorf("%w: secret version name should be in the "+
+			"projects/PROJECT/secrets/SECRET/versions/VERSION format", ErrInvalidBoxedValue)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("%w: secret version name contains an empty element", ErrInvalidBoxedValue)
+		}
+	}
+
+	return &BoxedValue{
+		name: name,
+	}, nil
+}
@@ -0,0 +1,381 @@
+package gcpsmunboxer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+)
+
+const (
+	EnvCirrusGCPWorkloadIdentityProvider = "CIRRUS_GCP_WORKLOAD_IDENTITY_PROVIDER"
+	EnvCirrusGCPServiceAccount           = "CIRRUS_GCP_SERVICE_ACCOUNT"
+
+	cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	defaultTokenURI = "https://oauth2.googleapis.com/token"
+	stsTokenURI     = "https://sts.googleapis.com/v1/token"
+)
+
+// Refresh the access token when it has less than that left to live
+const tokenRefreshMargin = time.Minute
+
+type fetchTokenFunc func(ctx context.Context) (string, time.Duration, error)
+
+// TokenSource provides OAuth 2.0 access tokens for the Google Cloud APIs
+// and refreshes them when they're about to expire.
+type TokenSource struct {
+	fetch fetchTokenFunc
+
+	token     string
+	expiresAt time.Time
+}
+
+func NewStaticTokenSource(token string) *TokenSource {
+	return &TokenSource{
+		fetch: func(ctx context.Context) (string, time.Duration, error) {
+			return token, 0, nil
+		},
+	}
+}
+
+// NewTokenSourceFromEnvironment implements a subset of the Application Default Credentials[1]
+// and additionally supports the workload identity federation with the task's CIRRUS_OIDC_TOKEN.
+//
+// [1]: https://cloud.google.com/docs/authentication/application-default-credentials
+func NewTokenSourceFromEnvironment(env *environment.Environment) (*TokenSource, error) {
+	lookup := func(key string) string {
+		if value, ok := env.Lookup(key); ok {
+			return value
+		}
+
+		return os.Getenv(key)
+	}
+
+	if provider := lookup(EnvCirrusGCPWorkloadIdentityProvider); provider != "" {
+		oidcToken, ok := env.Lookup("CIRRUS_OIDC_TOKEN")
+		if !ok {
+			return nil, fmt.Errorf("%s is set, but no CIRRUS_OIDC_TOKEN was provided",
+				EnvCirrusGCPWorkloadIdentityProvider)
+		}
+
+		return &TokenSource{
+			fetch: federatedToken(provider, oidcToken, lookup(EnvCirrusGCPServiceAccount)),
+		}, nil
+	}
+
+	credentialsPath := lookup("GOOGLE_APPLICATION_CREDENTIALS")
+	if credentialsPath == "" {
+		if configDir, err := os.UserConfigDir(); err == nil {
+			wellKnownPath := filepath.Join(configDir, "gcloud", "application_default_credentials.json")
+
+			if _, err := os.Stat(wellKnownPath); err == nil {
+				credentialsPath = wellKnownPath
+			}
+		}
+	}
+
+	if credentialsPath != "" {
+		fetch, err := credentialsFileToken(credentialsPath)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TokenSource{fetch: fetch}, nil
+	}
+
+	return &TokenSource{fetch: metadataToken}, nil
+}
+
+func (tokenSource *TokenSource) Token(ctx context.Context) (string, error) {
+	if tokenSource.token != "" &&
+		(tokenSource.expiresAt.IsZero() || time.Until(tokenSource.expiresAt) > tokenRefreshMargin) {
+		return tokenSource.token, nil
+	}
+
+	token, expiresIn, err := tokenSource.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tokenSource.token = token
+	tokenSource.expiresAt = time.Time{}
+
+	if expiresIn != 0 {
+		tokenSource.expiresAt = time.Now().Add(expiresIn)
+	}
+
+	return token, nil
+}
+
+func federatedToken(provider string, oidcToken string, serviceAccount string) fetchTokenFunc {
+	if !strings.HasPrefix(provider, "//") {
+		provider = "//iam.googleapis.com/" + strings.TrimPrefix(provider, "/")
+	}
+
+	return func(ctx context.Context) (string, time.Duration, error) {
+		body, err := json.Marshal(map[string]string{
+			"grantType":          "urn:ietf:params:oauth:grant-type:token-exchange",
+			"audience":           provider,
+			"scope":              cloudPlatformScope,
+			"requestedTokenType": "urn:ietf:params:oauth:token-type:access_token",
+			"subjectToken":       oidcToken,
+			"subjectTokenType":   "urn:ietf:params:oauth:token-type:jwt",
+		})
+		if err != nil {
+			return "", 0, err
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, stsTokenURI, bytes.NewReader(body))
+		if err != nil {
+			return "", 0, err
+		}
+		request.Header.Set("Content-Type", "application/json")
+
+		token, expiresIn, err := doTokenRequest(request)
+		if err != nil || serviceAccount == "" {
+			return token, expiresIn, err
+		}
+
+		return impersonatedToken(ctx, token, serviceAccount)
+	}
+}
+
+func impersonatedToken(ctx context.Context, token string, serviceAccount string) (string, time.Duration, error) {
+	body, err := json.Marshal(map[string][]string{
+		"scope": {cloudPlatformScope},
+	})
+	if err != nil {
+		return "", 0, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken",
+			url.PathEscape(serviceAccount)), bytes.NewReader(body))
+	if err != nil {
+		return "", 0, err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Content-Type", "application/json")
+
+	responseBody, err := doRequest(request)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var impersonationResponse struct {
+		AccessToken string    `json:"accessToken"`
+		ExpireTime  time.Time `json:"expireTime"`
+	}
+
+	if err := json.Unmarshal(responseBody, &impersonationResponse); err != nil {
+		return "", 0, err
+	}
+
+	return impersonationResponse.AccessToken, time.Until(impersonationResponse.ExpireTime), nil
+}
+
+func credentialsFileToken(path string) (fetchTokenFunc, error) {
+	credentialsBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GCP credentials file: %w", err)
+	}
+
+	var credentials struct {
+		Type string `json:"type"`
+
+		// "service_account" type
+		ClientEmail  string `json:"client_email"`
+		PrivateKeyID string `json:"private_key_id"`
+		PrivateKey   string `json:"private_key"`
+		TokenURI     string `json:"token_uri"`
+
+		// "authorized_user" type
+		ClientID     string `json:"client_id"`
+		ClientSecret string `json:"client_secret"`
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	if err := json.Unmarshal(credentialsBytes, &credentials); err != nil {
+		return nil, fmt.Errorf("failed to parse GCP credentials file: %w", err)
+	}
+
+	tokenURI := credentials.TokenURI
+	if tokenURI == "" {
+		tokenURI = defaultTokenURI
+	}
+
+	switch credentials.Type {
+	case "service_account":
+		privateKey, err := parsePrivateKey(credentials.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(ctx context.Context) (string, time.Duration, error) {
+			assertion, err := signJWT(privateKey, credentials.PrivateKeyID, map[string]interface{}{
+				"iss":   credentials.ClientEmail,
+				"scope": cloudPlatformScope,
+				"aud":   tokenURI,
+				"iat":   time.Now().Unix(),
+				"exp":   time.Now().Add(time.Hour).Unix(),
+			})
+			if err != nil {
+				return "", 0, err
+			}
+
+			return postTokenForm(ctx, tokenURI, url.Values{
+				"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+				"assertion":  {assertion},
+			})
+		}, nil
+	case "authorized_user":
+		return func(ctx context.Context) (string, time.Duration, error) {
+			return postTokenForm(ctx, tokenURI, url.Values{
+				"grant_type":    {"refresh_token"},
+				"client_id":     {credentials.ClientID},
+				"client_secret": {credentials.ClientSecret},
+				"refresh_token": {credentials.RefreshToken},
+			})
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported GCP credentials type %q", credentials.Type)
+	}
+}
+
+func metadataToken(ctx context.Context) (string, time.Duration, error) {
+	host := os.Getenv("GCE_METADATA_HOST")
+	if host == "" {
+		host = "metadata.google.internal"
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("http://%s/computeMetadata/v1/instance/service-accounts/default/token", host), nil)
+	if err != nil {
+		return "", 0, err
+	}
+	request.Header.Set("Metadata-Flavor", "Google")
+
+	return doTokenRequest(request)
+}
+
+func postTokenForm(ctx context.Context, tokenURI string, form url.Values) (string, time.Duration, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return doTokenRequest(request)
+}
+
+func doTokenRequest(request *http.Request) (string, time.Duration, error) {
+	body, err := doRequest(request)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var tokenResponse struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+
+	if err := json.Unmarshal(body, &tokenResponse); err != nil {
+		return "", 0, err
+	}
+
+	if tokenResponse.AccessToken == "" {
+		return "", 0, errors.New("token endpoint returned no access token")
+	}
+
+	return tokenResponse.AccessToken, time.Duration(tokenResponse.ExpiresIn) * time.Second, nil
+}
+
+func doRequest(request *http.Request) ([]byte, error) {
+	httpClient := &http.Client{Timeout: time.Minute}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad response status from %s %d: %s", request.URL.Host, response.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+func parsePrivateKey(rawPrivateKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(rawPrivateKey))
+	if block == nil {
+		return nil, errors.New("GCP credentials file contains no PEM-encoded private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GCP service account private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GCP service account private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+func signJWT(privateKey *rsa.PrivateKey, keyID string, claims map[string]interface{}) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"kid": keyID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
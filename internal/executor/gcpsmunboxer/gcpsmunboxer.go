@@ -0,0 +1,91 @@
+package gcpsmunboxer
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+)
+
+const DefaultEndpoint = "https://secretmanager.googleapis.com"
+
+type GCPSMUnboxer struct {
+	httpClient  *http.Client
+	endpoint    string
+	tokenSource *TokenSource
+
+	cache map[string]string
+}
+
+func New(endpoint string, tokenSource *TokenSource) *GCPSMUnboxer {
+	return &GCPSMUnboxer{
+		httpClient:  &http.Client{Timeout: time.Minute},
+		endpoint:    endpoint,
+		tokenSource: tokenSource,
+		cache:       map[string]string{},
+	}
+}
+
+func NewFromEnvironment(env *environment.Environment) (*GCPSMUnboxer, error) {
+	tokenSource, err := NewTokenSourceFromEnvironment(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(DefaultEndpoint, tokenSource), nil
+}
+
+func (unboxer *GCPSMUnboxer) Unbox(ctx context.Context, selector *BoxedValue) (string, error) {
+	if value, ok := unboxer.cache[selector.name]; ok {
+		return value, nil
+	}
+
+	token, err := unboxer.tokenSource.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain GCP access token: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("%s/v1/%s:access", unboxer.endpoint, selector.name), nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	response, err := unboxer.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+
+		return "", fmt.Errorf("failed to access secret version %s: %s: %s",
+			selector.name, response.Status, body)
+	}
+
+	var accessResponse struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&accessResponse); err != nil {
+		return "", fmt.Errorf("failed to parse secret version %s: %w", selector.name, err)
+	}
+
+	value, err := base64.StdEncoding.DecodeString(accessResponse.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret version %s: %w", selector.name, err)
+	}
+
+	unboxer.cache[selector.name] = string(value)
+
+	return string(value), nil
+}
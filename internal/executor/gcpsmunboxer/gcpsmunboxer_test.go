@@ -0,0 +1,99 @@
+package gcpsmunboxer_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/gcpsmunboxer"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/testutil"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBoxedValues(t *testing.T) {
+	_, err := gcpsmunboxer.NewBoxedValue("VAULT[secret/data/keys data.admin]")
+	require.ErrorIs(t, err, gcpsmunboxer.ErrNotABoxedValue)
+
+	_, err = gcpsmunboxer.NewBoxedValue("GCPSM[projects/my-project/secrets/my-secret]")
+	require.ErrorIs(t, err, gcpsmunboxer.ErrInvalidBoxedValue)
+
+	_, err = gcpsmunboxer.NewBoxedValue("GCPSM[projects//secrets/my-secret/versions/latest]")
+	require.ErrorIs(t, err, gcpsmunboxer.ErrInvalidBoxedValue)
+
+	_, err = gcpsmunboxer.NewBoxedValue("GCPSM[projects/my-project/secrets/my-secret/versions/latest]")
+	require.NoError(t, err)
+}
+
+func TestUnbox(t *testing.T) {
+	var numAccesses int
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		require.Equal(t, "/v1/projects/my-project/secrets/my-secret/versions/latest:access", request.URL.Path)
+
+		numAccesses++
+
+		fmt.Fprint(writer, `{"payload":{"data":"c2VjcmV0IHZhbHVl"}}`)
+	}))
+	defer server.Close()
+
+	unboxer := gcpsmunboxer.New(server.URL, gcpsmunboxer.NewStaticTokenSource("token"))
+
+	boxedValue, err := gcpsmunboxer.NewBoxedValue("GCPSM[projects/my-project/secrets/my-secret/versions/latest]")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		value, err := unboxer.Unbox(context.Background(), boxedValue)
+		require.NoError(t, err)
+		require.Equal(t, "secret value", value)
+	}
+
+	require.Equal(t, 1, numAccesses)
+}
+
+func TestServiceAccountCredentials(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.NoError(t, request.ParseForm())
+		require.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", request.Form.Get("grant_type"))
+		require.NotEmpty(t, request.Form.Get("assertion"))
+
+		fmt.Fprint(writer, `{"access_token":"service account token","expires_in":3600}`)
+	}))
+	defer server.Close()
+
+	credentials, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "ci@my-project.iam.gserviceaccount.com",
+		"private_key_id": "key-id",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes})),
+		"token_uri":      server.URL,
+	})
+	require.NoError(t, err)
+
+	credentialsPath := filepath.Join(testutil.TempDir(t), "credentials.json")
+	require.NoError(t, os.WriteFile(credentialsPath, credentials, 0600))
+
+	tokenSource, err := gcpsmunboxer.NewTokenSourceFromEnvironment(environment.New(map[string]string{
+		"GOOGLE_APPLICATION_CREDENTIALS": credentialsPath,
+	}))
+	require.NoError(t, err)
+
+	token, err := tokenSource.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "service account token", token)
+}
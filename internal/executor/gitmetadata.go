@@ -0,0 +1,21 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+)
+
+// reportGitMetadata lets the server know what was actually checked out,
+// since the branches might've moved between the scheduling and the execution.
+func (executor *Executor) reportGitMetadata(ctx context.Context, gitMetadata *api.ReportGitMetadataRequest) {
+	gitMetadata.TaskIdentification = executor.taskIdentification
+
+	_, err := client.CirrusClient.ReportGitMetadata(ctx, gitMetadata)
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		log.Printf("Failed to report the Git metadata: %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/load"
+	"sync"
+	"time"
+)
+
+// EnvCirrusHeartbeatHealth is set to "true" by the servers that
+// support the health data being included in the heartbeats.
+const EnvCirrusHeartbeatHealth = "CIRRUS_HEARTBEAT_HEALTH"
+
+// healthTracker keeps track of what's currently being executed for the heartbeats.
+type healthTracker struct {
+	mtx          sync.Mutex
+	enabled      bool
+	workingDir   string
+	command      string
+	commandStart time.Time
+}
+
+func (tracker *healthTracker) Enable(workingDir string) {
+	tracker.mtx.Lock()
+	defer tracker.mtx.Unlock()
+
+	tracker.enabled = true
+	tracker.workingDir = workingDir
+}
+
+func (tracker *healthTracker) CommandStarted(name string) {
+	tracker.mtx.Lock()
+	defer tracker.mtx.Unlock()
+
+	tracker.command = name
+	tracker.commandStart = time.Now()
+}
+
+func (tracker *healthTracker) CommandFinished() {
+	tracker.mtx.Lock()
+	defer tracker.mtx.Unlock()
+
+	tracker.command = ""
+}
+
+// currentCommand returns the command that is being executed (if any) and when it was started.
+func (tracker *healthTracker) currentCommand() (string, time.Time) {
+	tracker.mtx.Lock()
+	defer tracker.mtx.Unlock()
+
+	return tracker.command, tracker.commandStart
+}
+
+// HeartbeatHealth returns the health data to include in the heartbeats
+// or nil if the server doesn't support it.
+func (executor *Executor) HeartbeatHealth(ctx context.Context) *client.HeartbeatHealth {
+	tracker := &executor.health
+
+	tracker.mtx.Lock()
+	if !tracker.enabled {
+		tracker.mtx.Unlock()
+
+		return nil
+	}
+
+	health := &client.HeartbeatHealth{
+		CurrentCommand: tracker.command,
+	}
+	if tracker.command != "" {
+		health.CurrentCommandElapsed = time.Since(tracker.commandStart)
+	}
+	workingDir := tracker.workingDir
+	tracker.mtx.Unlock()
+
+	if usage, err := disk.UsageWithContext(ctx, workingDir); err == nil {
+		health.FreeDiskBytes = usage.Free
+	}
+
+	if avg, err := load.AvgWithContext(ctx); err == nil {
+		health.LoadAverage1m = avg.Load1
+	}
+
+	return health
+}
@@ -0,0 +1,29 @@
+package executor
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHeartbeatHealth(t *testing.T) {
+	executor := &Executor{}
+
+	// Not supported by the server
+	require.Nil(t, executor.HeartbeatHealth(context.Background()))
+
+	executor.health.Enable(t.TempDir())
+
+	health := executor.HeartbeatHealth(context.Background())
+	require.NotNil(t, health)
+	require.Empty(t, health.CurrentCommand)
+	require.NotZero(t, health.FreeDiskBytes)
+
+	executor.health.CommandStarted("build")
+	health = executor.HeartbeatHealth(context.Background())
+	require.Equal(t, "build", health.CurrentCommand)
+	require.Positive(t, health.CurrentCommandElapsed)
+
+	executor.health.CommandFinished()
+	require.Empty(t, executor.HeartbeatHealth(context.Background()).CurrentCommand)
+}
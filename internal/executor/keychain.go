@@ -0,0 +1,96 @@
+package executor
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/keychain"
+	"log"
+	"strings"
+)
+
+const (
+	// EnvCirrusKeychain set to "true" creates a temporary unlocked keychain for the task
+	// that's deleted once the task finishes.
+	EnvCirrusKeychain = "CIRRUS_KEYCHAIN"
+
+	// EnvCirrusKeychainCertificates is a comma-separated list of the environment variables
+	// that hold the Base64-encoded PKCS#12 bundles to import into the temporary keychain
+	// (implies EnvCirrusKeychain). The bundle password is taken from the <NAME>_PASSWORD variable.
+	EnvCirrusKeychainCertificates = "CIRRUS_KEYCHAIN_CERTIFICATES"
+
+	// EnvCirrusKeychainPath and EnvCirrusKeychainPassword are exported to the scripts
+	// for the tools that need to reference the temporary keychain explicitly.
+	EnvCirrusKeychainPath     = "CIRRUS_KEYCHAIN_PATH"
+	EnvCirrusKeychainPassword = "CIRRUS_KEYCHAIN_PASSWORD"
+)
+
+// keychainCertificates returns the names of the variables holding the certificates to import.
+func (executor *Executor) keychainCertificates() []string {
+	var result []string
+
+	for _, name := range strings.Split(executor.env.Get(EnvCirrusKeychainCertificates), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		result = append(result, name)
+	}
+
+	return result
+}
+
+// setupKeychain creates the temporary keychain (if requested), returning a function that deletes it.
+func (executor *Executor) setupKeychain(ctx context.Context) (func(), error) {
+	certificates := executor.keychainCertificates()
+
+	if executor.env.Get(EnvCirrusKeychain) != "true" && len(certificates) == 0 {
+		return func() {}, nil
+	}
+
+	log.Println("Creating a temporary keychain...")
+
+	kc, err := keychain.Create(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the keychain requested via %s: %w", EnvCirrusKeychain, err)
+	}
+
+	cleanup := func() {
+		// Use a fresh context since the task's one might be already cancelled
+		if err := kc.Close(context.Background()); err != nil {
+			log.Printf("Failed to delete the temporary keychain: %v", err)
+		}
+	}
+
+	for _, name := range certificates {
+		encoded, ok := executor.env.Lookup(name)
+		if !ok {
+			cleanup()
+
+			return nil, fmt.Errorf("certificate variable %s listed in %s is not set",
+				name, EnvCirrusKeychainCertificates)
+		}
+
+		bundle, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+		if err != nil {
+			cleanup()
+
+			return nil, fmt.Errorf("failed to decode the certificate from %s: %w", name, err)
+		}
+
+		if err := kc.Import(ctx, bundle, executor.env.Get(name+"_PASSWORD")); err != nil {
+			cleanup()
+
+			return nil, fmt.Errorf("failed to import the certificate from %s: %w", name, err)
+		}
+
+		log.Printf("Imported the certificate from %s", name)
+	}
+
+	executor.env.AddSensitiveValues(kc.Password)
+	executor.env.Set(EnvCirrusKeychainPath, kc.Path)
+	executor.env.Set(EnvCirrusKeychainPassword, kc.Password)
+
+	return cleanup, nil
+}
@@ -0,0 +1,202 @@
+// Package keychain manages a temporary macOS keychain for the code signing pipelines, wrapping
+// the "security" command boilerplate: creating and unlocking the keychain, adding it to the search
+// list, importing the signing certificates and granting the signing tools access to their keys.
+package keychain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// lockTimeoutSeconds prevents the keychain from locking itself in the middle of the long builds.
+const lockTimeoutSeconds = "21600"
+
+var ErrUnsupported = errors.New("keychains are only available on macOS")
+
+type runFunc func(ctx context.Context, args ...string) ([]byte, error)
+
+type Keychain struct {
+	Path     string
+	Password string
+
+	run               runFunc
+	dir               string
+	searchList        []string
+	addedToSearchList bool
+}
+
+// Create creates an unlocked keychain in a new temporary directory and puts it
+// first in the user's search list so that codesign and friends can find the identities.
+func Create(ctx context.Context) (*Keychain, error) {
+	if runtime.GOOS != "darwin" {
+		return nil, ErrUnsupported
+	}
+
+	return create(ctx, runSecurity)
+}
+
+func create(ctx context.Context, run runFunc) (*Keychain, error) {
+	dir, err := os.MkdirTemp("", "cirrus-keychain-")
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := randomPassword()
+	if err != nil {
+		_ = os.RemoveAll(dir)
+
+		return nil, err
+	}
+
+	keychain := &Keychain{
+		Path:     filepath.Join(dir, "cirrus.keychain-db"),
+		Password: password,
+		run:      run,
+		dir:      dir,
+	}
+
+	if err := keychain.setup(ctx); err != nil {
+		_ = keychain.Close(ctx)
+
+		return nil, err
+	}
+
+	return keychain, nil
+}
+
+func (keychain *Keychain) setup(ctx context.Context) error {
+	if _, err := keychain.run(ctx, "create-keychain", "-p", keychain.Password, keychain.Path); err != nil {
+		return fmt.Errorf("failed to create the keychain: %w", err)
+	}
+
+	if _, err := keychain.run(ctx, "set-keychain-settings", "-lut", lockTimeoutSeconds, keychain.Path); err != nil {
+		return fmt.Errorf("failed to configure the keychain: %w", err)
+	}
+
+	if _, err := keychain.run(ctx, "unlock-keychain", "-p", keychain.Password, keychain.Path); err != nil {
+		return fmt.Errorf("failed to unlock the keychain: %w", err)
+	}
+
+	output, err := keychain.run(ctx, "list-keychains", "-d", "user")
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the keychain search list: %w", err)
+	}
+	keychain.searchList = parseSearchList(output)
+
+	args := append([]string{"list-keychains", "-d", "user", "-s", keychain.Path}, keychain.searchList...)
+	if _, err := keychain.run(ctx, args...); err != nil {
+		return fmt.Errorf("failed to add the keychain to the search list: %w", err)
+	}
+	keychain.addedToSearchList = true
+
+	return nil
+}
+
+// Import imports the PKCS#12 bundle (a certificate and its private key) and allows
+// the Apple tools to use the private key without prompting for the keychain password.
+func (keychain *Keychain) Import(ctx context.Context, bundle []byte, password string) error {
+	file, err := os.CreateTemp(keychain.dir, "certificate-*.p12")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(bundle); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if _, err := keychain.run(ctx, "import", file.Name(), "-k", keychain.Path, "-f", "pkcs12",
+		"-P", password, "-T", "/usr/bin/codesign", "-T", "/usr/bin/productsign",
+		"-T", "/usr/bin/security"); err != nil {
+		return fmt.Errorf("failed to import the certificate: %w", err)
+	}
+
+	if _, err := keychain.run(ctx, "set-key-partition-list", "-S", "apple-tool:,apple:,codesign:",
+		"-s", "-k", keychain.Password, keychain.Path); err != nil {
+		return fmt.Errorf("failed to set the partition list: %w", err)
+	}
+
+	return nil
+}
+
+// Close restores the original search list and deletes the keychain.
+func (keychain *Keychain) Close(ctx context.Context) error {
+	var result error
+
+	if keychain.addedToSearchList {
+		args := append([]string{"list-keychains", "-d", "user", "-s"}, keychain.searchList...)
+		if _, err := keychain.run(ctx, args...); err != nil {
+			result = fmt.Errorf("failed to restore the keychain search list: %w", err)
+		}
+	}
+
+	if _, err := os.Stat(keychain.Path); err == nil {
+		if _, err := keychain.run(ctx, "delete-keychain", keychain.Path); err != nil && result == nil {
+			result = fmt.Errorf("failed to delete the keychain: %w", err)
+		}
+	}
+
+	if err := os.RemoveAll(keychain.dir); err != nil && result == nil {
+		result = err
+	}
+
+	return result
+}
+
+// parseSearchList parses the "security list-keychains" output, which has one quoted path per line.
+func parseSearchList(output []byte) []string {
+	var result []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+
+	for scanner.Scan() {
+		path := strings.Trim(strings.TrimSpace(scanner.Text()), "\"")
+		if path == "" {
+			continue
+		}
+
+		result = append(result, path)
+	}
+
+	return result
+}
+
+func randomPassword() (string, error) {
+	buf := make([]byte, 24)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buf), nil
+}
+
+func runSecurity(ctx context.Context, args ...string) ([]byte, error) {
+	output, err := exec.CommandContext(ctx, "security", args...).CombinedOutput()
+	if err != nil {
+		message := strings.TrimSpace(string(output))
+		if message == "" {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: %s", err, message)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,79 @@
+package keychain
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"strings"
+	"testing"
+)
+
+type fakeSecurity struct {
+	calls [][]string
+}
+
+func (fake *fakeSecurity) run(ctx context.Context, args ...string) ([]byte, error) {
+	fake.calls = append(fake.calls, args)
+
+	switch args[0] {
+	case "create-keychain":
+		if err := os.WriteFile(args[len(args)-1], []byte{}, 0600); err != nil {
+			return nil, err
+		}
+	case "list-keychains":
+		if len(args) == 3 {
+			return []byte("    \"/Users/admin/Library/Keychains/login.keychain-db\"\n" +
+				"    \"/Library/Keychains/System.keychain\"\n"), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (fake *fakeSecurity) commands() []string {
+	var result []string
+
+	for _, call := range fake.calls {
+		result = append(result, call[0])
+	}
+
+	return result
+}
+
+func TestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeSecurity{}
+
+	keychain, err := create(ctx, fake.run)
+	require.NoError(t, err)
+	assert.NotEmpty(t, keychain.Password)
+	assert.FileExists(t, keychain.Path)
+
+	require.NoError(t, keychain.Import(ctx, []byte("bundle"), "secret"))
+
+	importCall := fake.calls[5]
+	assert.Equal(t, "import", importCall[0])
+	assert.Contains(t, strings.Join(importCall, " "), "-P secret")
+
+	require.NoError(t, keychain.Close(ctx))
+	assert.NoDirExists(t, keychain.dir)
+
+	assert.Equal(t, []string{
+		"create-keychain",
+		"set-keychain-settings",
+		"unlock-keychain",
+		"list-keychains",
+		"list-keychains",
+		"import",
+		"set-key-partition-list",
+		"list-keychains",
+		"delete-keychain",
+	}, fake.commands())
+
+	// The keychain goes first in the search list and the original order is restored afterwards
+	assert.Equal(t, []string{"list-keychains", "-d", "user", "-s", keychain.Path,
+		"/Users/admin/Library/Keychains/login.keychain-db", "/Library/Keychains/System.keychain"}, fake.calls[4])
+	assert.Equal(t, []string{"list-keychains", "-d", "user", "-s",
+		"/Users/admin/Library/Keychains/login.keychain-db", "/Library/Keychains/System.keychain"}, fake.calls[7])
+}
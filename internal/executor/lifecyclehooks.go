@@ -0,0 +1,33 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/lifecyclehook"
+	"io"
+	"strconv"
+)
+
+// SetLifecycleHooks configures the worker's scripts to run at the task's lifecycle points.
+func (executor *Executor) SetLifecycleHooks(hooks lifecyclehook.Hooks) {
+	executor.lifecycleHooks = hooks
+}
+
+// runLifecycleHook runs the hook (if configured) with the task's coordinates, but without
+// the rest of the task's environment, since the hooks are a part of the worker, not of the task.
+func (executor *Executor) runLifecycleHook(
+	ctx context.Context,
+	hook lifecyclehook.Hook,
+	extraEnv map[string]string,
+	output io.Writer,
+) error {
+	env := map[string]string{
+		"CIRRUS_TASK_ID":     strconv.FormatInt(executor.taskIdentification.TaskId, 10),
+		"CIRRUS_WORKING_DIR": executor.env.Get("CIRRUS_WORKING_DIR"),
+	}
+	for key, value := range extraEnv {
+		env[key] = value
+	}
+
+	return lifecyclehook.RunAndReport(ctx, executor.taskIdentification, hook, executor.lifecycleHooks[hook],
+		env, output)
+}
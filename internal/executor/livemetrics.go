@@ -0,0 +1,116 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// EnvCirrusMetricsLiveInterval controls how often the interim resource utilization
+// snapshots are pushed while the task is running, "0" disables the live metrics.
+const EnvCirrusMetricsLiveInterval = "CIRRUS_METRICS_LIVE_INTERVAL"
+
+const defaultLiveMetricsInterval = 30 * time.Second
+
+// liveMetricsReporter keeps the latest resource utilization snapshot produced
+// by the metrics collector and periodically pushes it to the server.
+type liveMetricsReporter struct {
+	report func(ctx context.Context, resourceUtilization *api.ResourceUtilization) error
+
+	mtx      sync.Mutex
+	latest   *api.ResourceUtilization
+	reported *api.ResourceUtilization
+}
+
+func newLiveMetricsReporter(taskIdentification *api.TaskIdentification) *liveMetricsReporter {
+	return &liveMetricsReporter{
+		report: func(ctx context.Context, resourceUtilization *api.ResourceUtilization) error {
+			_, err := client.CirrusClient.ReportResourceUtilization(ctx, &api.ReportResourceUtilizationRequest{
+				TaskIdentification:  taskIdentification,
+				ResourceUtilization: resourceUtilization,
+			})
+
+			return err
+		},
+	}
+}
+
+func liveMetricsIntervalFromEnvironment() (time.Duration, error) {
+	rawInterval, ok := os.LookupEnv(EnvCirrusMetricsLiveInterval)
+	if !ok {
+		return defaultLiveMetricsInterval, nil
+	}
+
+	if rawInterval == "0" {
+		return 0, nil
+	}
+
+	interval, err := time.ParseDuration(rawInterval)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", EnvCirrusMetricsLiveInterval, err)
+	}
+	if interval < time.Second {
+		return 0, fmt.Errorf("%s should be at least 1s", EnvCirrusMetricsLiveInterval)
+	}
+
+	return interval, nil
+}
+
+// OnSample is meant to be used as metrics.Config's OnSample callback.
+func (reporter *liveMetricsReporter) OnSample(resourceUtilization *api.ResourceUtilization) {
+	reporter.mtx.Lock()
+	defer reporter.mtx.Unlock()
+
+	reporter.latest = resourceUtilization
+}
+
+// Run pushes the latest snapshot every interval until the context is cancelled
+// or the server turns out to not support the live metrics.
+func (reporter *liveMetricsReporter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := reporter.reportLatest(ctx); err != nil {
+				if status.Code(err) == codes.Unimplemented {
+					return
+				}
+				if ctx.Err() != nil {
+					return
+				}
+
+				log.Printf("Failed to report live resource utilization metrics: %v", err)
+			}
+		}
+	}
+}
+
+func (reporter *liveMetricsReporter) reportLatest(ctx context.Context) error {
+	reporter.mtx.Lock()
+	latest := reporter.latest
+	reporter.mtx.Unlock()
+
+	// Nothing new was sampled since the last report
+	if latest == nil || latest == reporter.reported {
+		return nil
+	}
+
+	if err := reporter.report(ctx, latest); err != nil {
+		return err
+	}
+
+	reporter.reported = latest
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestLiveMetricsReporterReportsOnlyNewSnapshots(t *testing.T) {
+	var reported []*api.ResourceUtilization
+
+	reporter := &liveMetricsReporter{
+		report: func(ctx context.Context, resourceUtilization *api.ResourceUtilization) error {
+			reported = append(reported, resourceUtilization)
+			return nil
+		},
+	}
+
+	// Nothing sampled yet
+	require.NoError(t, reporter.reportLatest(context.Background()))
+	require.Empty(t, reported)
+
+	first := &api.ResourceUtilization{CpuTotal: 1}
+	reporter.OnSample(first)
+	require.NoError(t, reporter.reportLatest(context.Background()))
+	require.NoError(t, reporter.reportLatest(context.Background()))
+	require.Equal(t, []*api.ResourceUtilization{first}, reported)
+
+	second := &api.ResourceUtilization{CpuTotal: 2}
+	reporter.OnSample(second)
+	require.NoError(t, reporter.reportLatest(context.Background()))
+	require.Equal(t, []*api.ResourceUtilization{first, second}, reported)
+}
+
+func TestLiveMetricsReporterStopsWhenUnimplemented(t *testing.T) {
+	calls := 0
+
+	reporter := &liveMetricsReporter{
+		report: func(ctx context.Context, resourceUtilization *api.ResourceUtilization) error {
+			calls++
+			return status.Error(codes.Unimplemented, "unknown method")
+		},
+	}
+	reporter.OnSample(&api.ResourceUtilization{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	reporter.Run(ctx, 10*time.Millisecond)
+
+	require.NoError(t, ctx.Err())
+	require.Equal(t, 1, calls)
+}
+
+func TestLiveMetricsIntervalFromEnvironment(t *testing.T) {
+	interval, err := liveMetricsIntervalFromEnvironment()
+	require.NoError(t, err)
+	require.Equal(t, defaultLiveMetricsInterval, interval)
+
+	t.Setenv(EnvCirrusMetricsLiveInterval, "0")
+	interval, err = liveMetricsIntervalFromEnvironment()
+	require.NoError(t, err)
+	require.Zero(t, interval)
+
+	t.Setenv(EnvCirrusMetricsLiveInterval, "1m")
+	interval, err = liveMetricsIntervalFromEnvironment()
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, interval)
+
+	t.Setenv(EnvCirrusMetricsLiveInterval, "10ms")
+	_, err = liveMetricsIntervalFromEnvironment()
+	require.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package executor
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/dustin/go-humanize"
+	"unicode/utf8"
+)
+
+// Fraction of the invalid UTF-8 bytes in a write
+// after which the write is considered binary
+const binaryInvalidFraction = 0.3
+
+// WithValidUTF8 replaces invalid UTF-8 sequences with the Unicode replacement character
+// and suppresses writes that look like binary data, replacing them with a summary.
+//
+// Incomplete UTF-8 sequences at the end of the write are held back until the next write.
+func (uploader *LogUploader) WithValidUTF8(input []byte) []byte {
+	if len(uploader.heldUTF8) != 0 {
+		input = append(uploader.heldUTF8, input...)
+		uploader.heldUTF8 = nil
+	}
+
+	// Hold back the incomplete UTF-8 sequence (if any)
+	for i := 1; i < utf8.UTFMax && i <= len(input); i++ {
+		tail := input[len(input)-i:]
+
+		if !utf8.RuneStart(tail[0]) {
+			continue
+		}
+
+		if !utf8.FullRune(tail) {
+			uploader.heldUTF8 = append([]byte{}, tail...)
+			input = input[:len(input)-i]
+		}
+
+		break
+	}
+
+	if len(input) == 0 {
+		return nil
+	}
+
+	if looksBinary(input) {
+		uploader.suppressedBinary += len(input)
+
+		return nil
+	}
+
+	return append(uploader.binarySummary(), toValidUTF8(input)...)
+}
+
+// FlushSuppressedBinary returns the summary of the binary output suppressed since
+// the last write and the held back incomplete UTF-8 sequence (if any).
+func (uploader *LogUploader) FlushSuppressedBinary() []byte {
+	result := append(uploader.binarySummary(), toValidUTF8(uploader.heldUTF8)...)
+	uploader.heldUTF8 = nil
+
+	return result
+}
+
+func (uploader *LogUploader) binarySummary() []byte {
+	if uploader.suppressedBinary == 0 {
+		return nil
+	}
+
+	result := []byte(fmt.Sprintf("\n[binary output suppressed (%s)]\n",
+		humanize.Bytes(uint64(uploader.suppressedBinary))))
+	uploader.suppressedBinary = 0
+
+	return result
+}
+
+func toValidUTF8(input []byte) []byte {
+	return bytes.ToValidUTF8(input, []byte(string(utf8.RuneError)))
+}
+
+func looksBinary(input []byte) bool {
+	if bytes.IndexByte(input, 0) != -1 {
+		return true
+	}
+
+	var numInvalid int
+
+	for remaining := input; len(remaining) != 0; {
+		r, size := utf8.DecodeRune(remaining)
+		if r == utf8.RuneError && size == 1 {
+			numInvalid++
+		}
+
+		remaining = remaining[size:]
+	}
+
+	return float64(numInvalid) > float64(len(input))*binaryInvalidFraction
+}
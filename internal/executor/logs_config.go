@@ -0,0 +1,79 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/dustin/go-humanize"
+	"strconv"
+	"time"
+)
+
+const (
+	EnvCirrusLogBufferSize    = "CIRRUS_LOG_BUFFER_SIZE"
+	EnvCirrusLogMaxChunkSize  = "CIRRUS_LOG_MAX_CHUNK_SIZE"
+	EnvCirrusLogFlushInterval = "CIRRUS_LOG_FLUSH_INTERVAL"
+
+	// When the log uploader falls behind, send chunks that are this
+	// many times bigger to catch up using fewer RPC calls
+	backpressureChunkSizeMultiplier = 4
+)
+
+type LogUploaderConfig struct {
+	// Maximum number of log chunks that are buffered before
+	// the writes start to block, waiting for the upload
+	BufferSize int
+
+	// Maximum amount of bytes sent in a single RPC call
+	MaxChunkSize int
+
+	// How long to wait for more output before sending a chunk,
+	// zero means to send the output as soon as it's available
+	FlushInterval time.Duration
+}
+
+func DefaultLogUploaderConfig() LogUploaderConfig {
+	return LogUploaderConfig{
+		BufferSize:   128,
+		MaxChunkSize: 1 * 1024 * 1024,
+	}
+}
+
+// NewLogUploaderConfigFromEnvironment returns the default configuration overridden by the environment
+// variables, falling back to the defaults for the variables that cannot be parsed.
+func NewLogUploaderConfigFromEnvironment(env *environment.Environment) (LogUploaderConfig, []error) {
+	config := DefaultLogUploaderConfig()
+
+	var errs []error
+
+	if rawBufferSize, ok := env.Lookup(EnvCirrusLogBufferSize); ok {
+		bufferSize, err := strconv.Atoi(rawBufferSize)
+		if err == nil && bufferSize > 0 {
+			config.BufferSize = bufferSize
+		} else {
+			errs = append(errs, fmt.Errorf("%s should be a positive integer, got %q",
+				EnvCirrusLogBufferSize, rawBufferSize))
+		}
+	}
+
+	if rawMaxChunkSize, ok := env.Lookup(EnvCirrusLogMaxChunkSize); ok {
+		maxChunkSize, err := humanize.ParseBytes(rawMaxChunkSize)
+		if err == nil && maxChunkSize > 0 {
+			config.MaxChunkSize = int(maxChunkSize)
+		} else {
+			errs = append(errs, fmt.Errorf("%s should be a positive size (e.g. 512KB), got %q",
+				EnvCirrusLogMaxChunkSize, rawMaxChunkSize))
+		}
+	}
+
+	if rawFlushInterval, ok := env.Lookup(EnvCirrusLogFlushInterval); ok {
+		flushInterval, err := time.ParseDuration(rawFlushInterval)
+		if err == nil && flushInterval >= 0 {
+			config.FlushInterval = flushInterval
+		} else {
+			errs = append(errs, fmt.Errorf("%s should be a non-negative duration (e.g. 500ms), got %q",
+				EnvCirrusLogFlushInterval, rawFlushInterval))
+		}
+	}
+
+	return config, errs
+}
@@ -0,0 +1,180 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/avast/retry-go"
+	"github.com/certifi/gocertifi"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/network"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/throttle"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const EnvCirrusLogFallbackURL = "CIRRUS_LOG_FALLBACK_URL"
+
+// logStreamClient is the part of the api.CirrusCIService_StreamLogsClient used by the LogUploader.
+type logStreamClient interface {
+	Send(*api.LogEntry) error
+	CloseSend() error
+	CloseAndRecv() (*api.UploadLogsResponse, error)
+}
+
+// HTTPLogStreamClient uploads the log chunks to an HTTPS endpoint and is used
+// when the StreamLogs() gRPC call is blocked (e.g. by a middlebox not supporting HTTP/2).
+//
+// Each chunk is POSTed separately with the task_id, command, raw and offset query parameters,
+// the latter allows the server to de-duplicate the chunks re-sent after a failed request.
+type HTTPLogStreamClient struct {
+	ctx                context.Context
+	httpClient         *http.Client
+	url                string
+	taskIdentification *api.TaskIdentification
+	commandName        string
+	raw                bool
+	offset             int64
+}
+
+func NewHTTPLogStreamClient(
+	ctx context.Context,
+	url string,
+	taskIdentification *api.TaskIdentification,
+	commandName string,
+	raw bool,
+) *HTTPLogStreamClient {
+	// See the comment in NewHTTPSUploader()
+	certPool, _ := gocertifi.CACerts()
+
+	return &HTTPLogStreamClient{
+		ctx: ctx,
+		httpClient: &http.Client{
+			Transport: throttle.NewTransport(&http.Transport{
+				DialContext: network.DialContext,
+				TLSClientConfig: &tls.Config{
+					RootCAs: certPool,
+				},
+			}, throttle.Global()),
+			Timeout: time.Minute,
+		},
+		url:                url,
+		taskIdentification: taskIdentification,
+		commandName:        commandName,
+		raw:                raw,
+	}
+}
+
+func (client *HTTPLogStreamClient) Send(entry *api.LogEntry) error {
+	// The log key is passed along with each chunk instead
+	chunk := entry.GetChunk()
+	if chunk == nil || len(chunk.Data) == 0 {
+		return nil
+	}
+
+	err := retry.Do(func() error {
+		return client.post(chunk.Data)
+	}, retry.Delay(time.Second), retry.Attempts(3), retry.Context(client.ctx))
+	if err != nil {
+		return err
+	}
+
+	client.offset += int64(len(chunk.Data))
+
+	return nil
+}
+
+func (client *HTTPLogStreamClient) post(data []byte) error {
+	requestURL, err := url.Parse(client.url)
+	if err != nil {
+		return err
+	}
+
+	query := requestURL.Query()
+	query.Set("task_id", strconv.FormatInt(client.taskIdentification.TaskId, 10))
+	query.Set("command", client.commandName)
+	query.Set("raw", strconv.FormatBool(client.raw))
+	query.Set("offset", strconv.FormatInt(client.offset, 10))
+	requestURL.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(client.ctx, http.MethodPost, requestURL.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+client.taskIdentification.Secret)
+	request.Header.Set("Content-Type", "application/octet-stream")
+
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated &&
+		response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("bad response status while uploading logs for %s %d: %s",
+			client.commandName, response.StatusCode, response.Status)
+	}
+
+	return nil
+}
+
+func (client *HTTPLogStreamClient) CloseSend() error {
+	return nil
+}
+
+func (client *HTTPLogStreamClient) CloseAndRecv() (*api.UploadLogsResponse, error) {
+	return &api.UploadLogsResponse{BytesLogged: client.offset}, nil
+}
+
+// initializeClient initializes the gRPC log client (streaming or raw) and falls back
+// to the HTTP log upload if the former fails and CIRRUS_LOG_FALLBACK_URL is configured.
+func (uploader *LogUploader) initializeClient(ctx context.Context, raw bool) (logStreamClient, error) {
+	if uploader.usingFallback {
+		return uploader.newFallbackClient(ctx, raw), nil
+	}
+
+	var logClient logStreamClient
+	var err error
+
+	if raw {
+		logClient, err = InitializeLogSaveClient(ctx, uploader.taskIdentification, uploader.commandName, true,
+			uploader.callOptions...)
+	} else {
+		logClient, err = InitializeLogStreamClient(ctx, uploader.taskIdentification, uploader.commandName, false,
+			uploader.callOptions...)
+	}
+	if err == nil || uploader.fallbackURL == "" {
+		return logClient, err
+	}
+
+	log.Printf("Falling back to the HTTP log upload for %s: %v\n", uploader.commandName, err)
+	uploader.usingFallback = true
+
+	return uploader.newFallbackClient(ctx, raw), nil
+}
+
+// switchToFallback replaces the gRPC log streaming client that failed to send a chunk
+// with the HTTP one, since the gRPC streams are established lazily and a middlebox
+// blocking them is usually only noticed when sending.
+func (uploader *LogUploader) switchToFallback(err error) bool {
+	if uploader.fallbackURL == "" || uploader.usingFallback {
+		return false
+	}
+
+	log.Printf("Falling back to the HTTP log upload for %s: %v\n", uploader.commandName, err)
+	_ = uploader.client.CloseSend()
+	uploader.client = uploader.newFallbackClient(context.Background(), false)
+	uploader.usingFallback = true
+
+	return true
+}
+
+func (uploader *LogUploader) newFallbackClient(ctx context.Context, raw bool) logStreamClient {
+	return NewHTTPLogStreamClient(ctx, uploader.fallbackURL, uploader.taskIdentification,
+		uploader.commandName, raw)
+}
@@ -0,0 +1,50 @@
+package executor_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPLogStreamClient(t *testing.T) {
+	var offsets []string
+	var received []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "Bearer secret", request.Header.Get("Authorization"))
+		require.Equal(t, "42", request.URL.Query().Get("task_id"))
+		require.Equal(t, "main", request.URL.Query().Get("command"))
+
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+
+		offsets = append(offsets, request.URL.Query().Get("offset"))
+		received = append(received, body...)
+
+		writer.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := executor.NewHTTPLogStreamClient(context.Background(), server.URL,
+		&api.TaskIdentification{TaskId: 42, Secret: "secret"}, "main", false)
+
+	require.NoError(t, client.Send(&api.LogEntry{Value: &api.LogEntry_Key{Key: &api.LogEntry_LogKey{}}}))
+
+	for _, chunk := range []string{"Hello, ", "World!\n"} {
+		require.NoError(t, client.Send(&api.LogEntry{
+			Value: &api.LogEntry_Chunk{Chunk: &api.DataChunk{Data: []byte(chunk)}},
+		}))
+	}
+
+	response, err := client.CloseAndRecv()
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"0", "7"}, offsets)
+	require.Equal(t, "Hello, World!\n", string(received))
+	require.EqualValues(t, 14, response.BytesLogged)
+}
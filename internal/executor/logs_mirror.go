@@ -0,0 +1,30 @@
+package executor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const EnvCirrusLogMirrorDir = "CIRRUS_LOG_MIRROR_DIR"
+
+// openLogMirror creates a file that will receive a copy of the (already masked) command's logs,
+// the directory is taken either from the task's environment or from the agent's environment.
+func openLogMirror(executor *Executor, commandName string) (*os.File, error) {
+	mirrorDir, ok := executor.env.Lookup(EnvCirrusLogMirrorDir)
+	if !ok {
+		mirrorDir, ok = os.LookupEnv(EnvCirrusLogMirrorDir)
+	}
+	if !ok || mirrorDir == "" {
+		return nil, nil
+	}
+
+	taskDir := filepath.Join(mirrorDir, strconv.FormatInt(executor.taskIdentification.TaskId, 10))
+
+	if err := os.MkdirAll(taskDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log mirror directory %s: %w", taskDir, err)
+	}
+
+	return os.Create(filepath.Join(taskDir, filepath.Base(commandName)+".log"))
+}
@@ -0,0 +1,79 @@
+package executor
+
+import (
+	"bytes"
+)
+
+const EnvCirrusLogRawProgress = "CIRRUS_LOG_RAW_PROGRESS"
+
+// WithCollapsedProgress collapses the lines that are repeatedly rewritten using
+// the carriage return (e.g. progress bars) into their final state.
+//
+// The first frame of such line is passed through immediately, while the subsequent
+// frames are held back until the line is terminated, at which point only the last
+// frame is emitted. Windows-style line endings (\r\n) are kept intact.
+func (uploader *LogUploader) WithCollapsedProgress(input []byte) []byte {
+	// Fast path
+	if !uploader.heldCarriageReturn && !uploader.inProgressFrame && !bytes.ContainsRune(input, '\r') {
+		return input
+	}
+
+	result := make([]byte, 0, len(input))
+
+	for _, b := range input {
+		if uploader.heldCarriageReturn {
+			uploader.heldCarriageReturn = false
+
+			if b == '\n' {
+				result = uploader.appendProgressFrame(result)
+				result = append(result, '\r', '\n')
+
+				continue
+			}
+
+			// A bare carriage return, the previous frame is now obsolete
+			uploader.inProgressFrame = true
+			uploader.progressFrame = uploader.progressFrame[:0]
+		}
+
+		switch {
+		case b == '\r':
+			uploader.heldCarriageReturn = true
+		case b == '\n':
+			result = uploader.appendProgressFrame(result)
+			result = append(result, '\n')
+		case uploader.inProgressFrame:
+			uploader.progressFrame = append(uploader.progressFrame, b)
+		default:
+			result = append(result, b)
+		}
+	}
+
+	return result
+}
+
+// FlushCollapsedProgress returns the output held back by WithCollapsedProgress().
+func (uploader *LogUploader) FlushCollapsedProgress() []byte {
+	result := uploader.appendProgressFrame(nil)
+
+	if uploader.heldCarriageReturn {
+		result = append(result, '\r')
+		uploader.heldCarriageReturn = false
+	}
+
+	return result
+}
+
+func (uploader *LogUploader) appendProgressFrame(result []byte) []byte {
+	if !uploader.inProgressFrame {
+		return result
+	}
+
+	result = append(result, '\r')
+	result = append(result, uploader.progressFrame...)
+
+	uploader.inProgressFrame = false
+	uploader.progressFrame = uploader.progressFrame[:0]
+
+	return result
+}
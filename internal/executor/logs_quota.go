@@ -0,0 +1,149 @@
+package executor
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/dustin/go-humanize"
+)
+
+const EnvCirrusLogMaxSize = "CIRRUS_LOG_MAX_SIZE"
+
+// WithQuota enforces the per-command log size quota: the first half of the quota
+// is passed through as is, while the rest of the output is retained in a rolling
+// tail buffer that is emitted by FlushQuota() once the command finishes.
+//
+// This way both the beginning of the log and the failure message at its end are kept.
+//
+// Since the masking happens later in WriteChunk(), the cut points are moved off the
+// sensitive values to avoid leaving their unmasked parts on either side of the cut.
+func (uploader *LogUploader) WithQuota(input []byte) []byte {
+	if uploader.MaxSize == 0 {
+		return input
+	}
+
+	if !uploader.overQuota {
+		headLeft := uploader.MaxSize/2 - uploader.headSize
+
+		if len(input) <= headLeft {
+			uploader.headSize += len(input)
+
+			return input
+		}
+
+		cut, _ := uploader.sensitiveValueBounds(input, headLeft)
+
+		uploader.headSize += cut
+		uploader.overQuota = true
+		uploader.appendTail(input[cut:])
+
+		return input[:cut]
+	}
+
+	uploader.appendTail(input)
+
+	return nil
+}
+
+// FlushQuota returns the truncation marker followed by the retained tail
+// of the output, or nothing if the quota was never exceeded.
+func (uploader *LogUploader) FlushQuota() []byte {
+	if !uploader.overQuota {
+		return nil
+	}
+
+	uploader.compactTail()
+
+	result := []byte(fmt.Sprintf("\n\n... %s of output truncated because the log exceeded %s ...\n\n",
+		humanize.Bytes(uint64(uploader.truncatedSize)), humanize.Bytes(uint64(uploader.MaxSize))))
+	result = append(result, uploader.tail...)
+
+	uploader.overQuota = false
+	uploader.tail = nil
+
+	return result
+}
+
+func (uploader *LogUploader) tailLimit() int {
+	return uploader.MaxSize - uploader.MaxSize/2
+}
+
+func (uploader *LogUploader) appendTail(input []byte) {
+	uploader.tail = append(uploader.tail, input...)
+
+	// Compact lazily to avoid copying the tail on each write
+	if len(uploader.tail) > 2*uploader.tailLimit() {
+		uploader.compactTail()
+	}
+}
+
+func (uploader *LogUploader) compactTail() {
+	excess := len(uploader.tail) - uploader.tailLimit()
+	if excess <= 0 {
+		return
+	}
+
+	// Cut at the line boundary (if possible) to avoid
+	// starting the tail in the middle of the line
+	cut := excess
+	if uploader.tail[cut-1] != '\n' {
+		if idx := bytes.IndexByte(uploader.tail[cut:], '\n'); idx != -1 {
+			cut += idx + 1
+		}
+	}
+
+	_, cut = uploader.sensitiveValueBounds(uploader.tail, cut)
+
+	uploader.truncatedSize += cut
+	uploader.tail = append([]byte{}, uploader.tail[cut:]...)
+}
+
+// sensitiveValueBounds returns the start and the end of the sensitive values
+// (or their encoded variants) that span over the offset in the input, or the
+// offset itself if there are none.
+func (uploader *LogUploader) sensitiveValueBounds(input []byte, offset int) (int, int) {
+	start, end := offset, offset
+
+	if uploader.env == nil {
+		return start, end
+	}
+
+	extend := func(value string) {
+		// Only the occurrences starting in this window span over the offset
+		windowStart := offset - len(value) + 1
+		if windowStart < 0 {
+			windowStart = 0
+		}
+		windowEnd := offset + len(value) - 1
+		if windowEnd > len(input) {
+			windowEnd = len(input)
+		}
+
+		for i := windowStart; i < windowEnd; {
+			idx := bytes.Index(input[i:windowEnd], []byte(value))
+			if idx == -1 {
+				break
+			}
+
+			matchStart := i + idx
+			if matchStart < start {
+				start = matchStart
+			}
+			if matchEnd := matchStart + len(value); matchEnd > end {
+				end = matchEnd
+			}
+
+			i = matchStart + 1
+		}
+	}
+
+	for _, value := range uploader.env.SensitiveValues() {
+		extend(value)
+
+		for _, encodedValue := range environment.EncodedVariants(value) {
+			extend(encodedValue)
+		}
+	}
+
+	return start, end
+}
@@ -0,0 +1,45 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWithQuotaDoesNotSplitSensitiveValues(t *testing.T) {
+	uploader := LogUploader{
+		MaxSize: 16,
+		env: environment.New(map[string]string{
+			"API_TOKEN": "s3cr3t",
+		}),
+	}
+
+	// Both the head cut (at 8 bytes) and the tail cut (at 15 bytes) fall in the middle of the secret
+	output := uploader.WithQuota([]byte("token=s3cr3t\n"))
+	output = append(output, uploader.WithQuota([]byte("filler s3cr3txyz"))...)
+	output = append(output, uploader.FlushQuota()...)
+
+	assert.Equal(t, "token=\n\n... 20 B of output truncated because the log exceeded 16 B ...\n\nxyz", string(output))
+}
+
+func TestSensitiveValueBounds(t *testing.T) {
+	uploader := LogUploader{
+		env: environment.New(map[string]string{
+			"API_TOKEN": "s3cr3t",
+		}),
+	}
+
+	input := []byte("abc s3cr3t def")
+
+	for offset := 5; offset < 10; offset++ {
+		start, end := uploader.sensitiveValueBounds(input, offset)
+		assert.Equal(t, 4, start)
+		assert.Equal(t, 10, end)
+	}
+
+	for _, offset := range []int{0, 4, 10, 14} {
+		start, end := uploader.sensitiveValueBounds(input, offset)
+		assert.Equal(t, offset, start)
+		assert.Equal(t, offset, end)
+	}
+}
@@ -0,0 +1,32 @@
+package executor
+
+import (
+	"regexp"
+)
+
+const (
+	EnvCirrusLogSanitize = "CIRRUS_LOG_SANITIZE"
+
+	// LogSanitizeANSI strips ANSI color and cursor movement sequences
+	LogSanitizeANSI = "ansi"
+
+	// LogSanitizeControl additionally strips terminal control characters
+	// other than newlines, carriage returns and tabs
+	LogSanitizeControl = "control"
+)
+
+var (
+	ansiSequenceRegex     = regexp.MustCompile("\x1b\\[[0-?]*[ -/]*[@-~]|\x1b\\][^\x07\x1b]*(?:\x07|\x1b\\\\)|\x1b[@-Z\\\\-_]")
+	controlCharacterRegex = regexp.MustCompile("[\x00-\x08\x0b\x0c\x0e-\x1f\x7f]")
+)
+
+func (uploader *LogUploader) WithSanitizedOutput(input []byte) []byte {
+	switch uploader.Sanitize {
+	case LogSanitizeANSI:
+		return ansiSequenceRegex.ReplaceAll(input, nil)
+	case LogSanitizeControl:
+		return controlCharacterRegex.ReplaceAll(ansiSequenceRegex.ReplaceAll(input, nil), nil)
+	default:
+		return input
+	}
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	EnvCirrusMetricsInterval  = "CIRRUS_METRICS_INTERVAL"
+	EnvCirrusMetricsMaxPoints = "CIRRUS_METRICS_MAX_POINTS"
+)
+
+type Config struct {
+	// Interval is a fixed interval between the samples, when zero, the samples are taken
+	// every second during the first minute and every 10 seconds afterwards
+	Interval time.Duration
+
+	// MaxPoints limits the number of points in each chart, when reached, the chart is
+	// downsampled by averaging the adjacent points, zero means no limit
+	MaxPoints int
+
+	// OnSample, when set, is called after each sample with a copy of the metrics
+	// collected so far, which allows reporting them while the task is still running
+	OnSample func(resourceUtilization *api.ResourceUtilization)
+}
+
+// ConfigFromEnvironment reads the Config from the agent's process environment
+// since the metrics are collected before the task's environment is known.
+func ConfigFromEnvironment() (Config, error) {
+	var config Config
+
+	if rawInterval, ok := os.LookupEnv(EnvCirrusMetricsInterval); ok {
+		interval, err := time.ParseDuration(rawInterval)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to parse %s: %w", EnvCirrusMetricsInterval, err)
+		}
+		if interval < 100*time.Millisecond {
+			return Config{}, fmt.Errorf("%s should be at least 100ms", EnvCirrusMetricsInterval)
+		}
+
+		config.Interval = interval
+	}
+
+	if rawMaxPoints, ok := os.LookupEnv(EnvCirrusMetricsMaxPoints); ok {
+		maxPoints, err := strconv.Atoi(rawMaxPoints)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to parse %s: %w", EnvCirrusMetricsMaxPoints, err)
+		}
+		if maxPoints < 2 {
+			return Config{}, fmt.Errorf("%s should be at least 2", EnvCirrusMetricsMaxPoints)
+		}
+
+		config.MaxPoints = maxPoints
+	}
+
+	return config, nil
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import "github.com/cirruslabs/cirrus-ci-agent/api"
+
+// Downsample halves the number of points in the chart by averaging the adjacent
+// points until it fits into maxPoints, which allows to sample at a constant rate
+// without the memory usage growing indefinitely for the long-running tasks.
+func Downsample(chart []*api.ChartPoint, maxPoints int) []*api.ChartPoint {
+	if maxPoints < 2 {
+		return chart
+	}
+
+	for len(chart) > maxPoints {
+		result := make([]*api.ChartPoint, 0, (len(chart)+1)/2)
+
+		for i := 0; i < len(chart); i += 2 {
+			if i+1 == len(chart) {
+				result = append(result, chart[i])
+
+				break
+			}
+
+			result = append(result, &api.ChartPoint{
+				SecondsFromStart: chart[i].SecondsFromStart,
+				Value:            (chart[i].Value + chart[i+1].Value) / 2,
+			})
+		}
+
+		chart = result
+	}
+
+	return chart
+}
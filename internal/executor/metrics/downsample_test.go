@@ -0,0 +1,41 @@
+package metrics_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestDownsample(t *testing.T) {
+	var chart []*api.ChartPoint
+	for i := 0; i < 5; i++ {
+		chart = append(chart, &api.ChartPoint{SecondsFromStart: uint32(i), Value: float64(i)})
+	}
+
+	require.Len(t, metrics.Downsample(chart, 0), 5)
+	require.Len(t, metrics.Downsample(chart, 5), 5)
+
+	downsampled := metrics.Downsample(chart, 3)
+	require.Len(t, downsampled, 3)
+	require.EqualValues(t, 0, downsampled[0].SecondsFromStart)
+	require.EqualValues(t, 0.5, downsampled[0].Value)
+	require.EqualValues(t, 2, downsampled[1].SecondsFromStart)
+	require.EqualValues(t, 2.5, downsampled[1].Value)
+	require.EqualValues(t, 4, downsampled[2].SecondsFromStart)
+	require.EqualValues(t, 4, downsampled[2].Value)
+}
+
+func TestConfigFromEnvironment(t *testing.T) {
+	t.Setenv(metrics.EnvCirrusMetricsInterval, "5s")
+	t.Setenv(metrics.EnvCirrusMetricsMaxPoints, "100")
+
+	config, err := metrics.ConfigFromEnvironment()
+	require.NoError(t, err)
+	require.Equal(t, metrics.Config{Interval: 5 * time.Second, MaxPoints: 100}, config)
+
+	t.Setenv(metrics.EnvCirrusMetricsInterval, "1ms")
+	_, err = metrics.ConfigFromEnvironment()
+	require.Error(t, err)
+}
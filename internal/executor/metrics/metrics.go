@@ -11,11 +11,16 @@ import (
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/cpu"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/memory"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/resolver"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/gpu"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/scheduling"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/system"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/thermal"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/throughput"
 	"github.com/dustin/go-humanize"
 	gopsutilcpu "github.com/shirou/gopsutil/cpu"
 	gopsutilmem "github.com/shirou/gopsutil/mem"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 	"log"
 	"runtime"
 	"time"
@@ -25,11 +30,25 @@ var (
 	ErrFailedToQueryTotals = errors.New("failed to query total CPU count/memory amount")
 	ErrFailedToQueryCPU    = errors.New("failed to query CPU usage")
 	ErrFailedToQueryMemory = errors.New("failed to query memory usage")
+	ErrFailedToQueryGauges = errors.New("failed to query metrics")
 )
 
 type Result struct {
 	errors              map[string]error
 	ResourceUtilization *api.ResourceUtilization
+
+	// Charts contains the metrics that have no dedicated chart in the ResourceUtilization
+	// (e.g. GPU utilization), keyed by the metric name
+	Charts map[string][]*api.ChartPoint
+}
+
+func (result *Result) downsample(maxPoints int) {
+	result.ResourceUtilization.CpuChart = Downsample(result.ResourceUtilization.CpuChart, maxPoints)
+	result.ResourceUtilization.MemoryChart = Downsample(result.ResourceUtilization.MemoryChart, maxPoints)
+
+	for name, chart := range result.Charts {
+		result.Charts[name] = Downsample(chart, maxPoints)
+	}
 }
 
 func (result Result) Errors() []error {
@@ -43,6 +62,10 @@ func (result Result) Errors() []error {
 }
 
 func Run(ctx context.Context, logger logrus.FieldLogger) chan *Result {
+	return RunWithConfig(ctx, logger, Config{})
+}
+
+func RunWithConfig(ctx context.Context, logger logrus.FieldLogger, config Config) chan *Result {
 	resultChan := make(chan *Result, 1)
 
 	var cpuSource source.CPU
@@ -76,10 +99,39 @@ func Run(ctx context.Context, logger logrus.FieldLogger) chan *Result {
 		}
 	}
 
+	var gaugesSources []source.Gauges
+
+	if gpuSource, err := gpu.New(); err == nil {
+		if logger != nil {
+			logger.Infof("collecting %s", gpuSource.Name())
+		}
+		gaugesSources = append(gaugesSources, gpuSource)
+	}
+
+	if thermalSource, err := thermal.New(); err == nil {
+		if logger != nil {
+			logger.Infof("collecting %s", thermalSource.Name())
+		}
+		gaugesSources = append(gaugesSources, thermalSource)
+	}
+
+	// The first sample only primes the counters, so it also serves as a check
+	// whether the disk and network I/O counters are supported on this platform
+	throughputSource := throughput.New()
+	if _, err := throughputSource.Gauges(ctx); err == nil {
+		gaugesSources = append(gaugesSources, throughputSource)
+	}
+
+	schedulingSource := scheduling.New()
+	if _, err := schedulingSource.Gauges(ctx); err == nil {
+		gaugesSources = append(gaugesSources, schedulingSource)
+	}
+
 	go func() {
 		result := &Result{
 			errors:              map[string]error{},
 			ResourceUtilization: &api.ResourceUtilization{},
+			Charts:              map[string][]*api.ChartPoint{},
 		}
 
 		// Totals
@@ -96,9 +148,24 @@ func Run(ctx context.Context, logger logrus.FieldLogger) chan *Result {
 		} else {
 			result.ResourceUtilization.CpuTotal = float64(numCpusTotal)
 			result.ResourceUtilization.MemoryTotal = float64(amountMemoryTotal)
+
+			// Inside of a container, the task is constrained by the cgroup limits rather than the host's totals
+			if cpuLimit, ok := cpuSource.(source.CPULimit); ok {
+				if limit, err := cpuLimit.NumCpusLimit(); err == nil {
+					result.ResourceUtilization.CpuTotal = EffectiveTotal(result.ResourceUtilization.CpuTotal, limit)
+				}
+			}
+			if memoryLimit, ok := memorySource.(source.MemoryLimit); ok {
+				if limit, err := memoryLimit.AmountMemoryLimit(); err == nil {
+					result.ResourceUtilization.MemoryTotal = EffectiveTotal(result.ResourceUtilization.MemoryTotal, limit)
+				}
+			}
 		}
 
 		pollInterval := 1 * time.Second
+		if config.Interval != 0 {
+			pollInterval = config.Interval
+		}
 		startTime := time.Now()
 
 		for {
@@ -150,6 +217,40 @@ func Run(ctx context.Context, logger logrus.FieldLogger) chan *Result {
 				})
 			}
 
+			// Additional metrics
+			for _, gaugesSource := range gaugesSources {
+				gauges, gaugesErr := gaugesSource.Gauges(ctx)
+				if gaugesErr != nil {
+					// Gauges are usually collected using external commands, which are killed on cancellation
+					if errors.Is(gaugesErr, context.Canceled) || errors.Is(gaugesErr, context.DeadlineExceeded) ||
+						ctx.Err() != nil {
+						resultChan <- result
+
+						return
+					}
+
+					err := fmt.Errorf("%w using %s: %v", ErrFailedToQueryGauges, gaugesSource.Name(), gaugesErr)
+					result.errors[err.Error()] = err
+
+					continue
+				}
+
+				for name, value := range gauges {
+					result.Charts[name] = append(result.Charts[name], &api.ChartPoint{
+						SecondsFromStart: uint32(timeSinceStart.Seconds()),
+						Value:            value,
+					})
+				}
+			}
+
+			if config.MaxPoints != 0 {
+				result.downsample(config.MaxPoints)
+			}
+
+			if config.OnSample != nil {
+				config.OnSample(proto.Clone(result.ResourceUtilization).(*api.ResourceUtilization))
+			}
+
 			// Make sure we wait the whole pollInterval
 			timeLeftToWait := pollInterval - time.Since(cycleStartTime)
 			select {
@@ -163,7 +264,7 @@ func Run(ctx context.Context, logger logrus.FieldLogger) chan *Result {
 
 			// Gradually increase the poll interval to avoid missing data for
 			// short-running tasks, but to preserve memory for long-running tasks
-			if timeSinceStart > (1 * time.Minute) {
+			if config.Interval == 0 && timeSinceStart > (1*time.Minute) {
 				pollInterval = 10 * time.Second
 			}
 		}
@@ -172,6 +273,15 @@ func Run(ctx context.Context, logger logrus.FieldLogger) chan *Result {
 	return resultChan
 }
 
+// EffectiveTotal returns the limit when it's set and lower than the system-wide total.
+func EffectiveTotal(total float64, limit float64) float64 {
+	if limit > 0 && limit < total {
+		return limit
+	}
+
+	return total
+}
+
 func Totals(ctx context.Context) (uint64, uint64, error) {
 	perCpuStat, err := gopsutilcpu.TimesWithContext(ctx, true)
 	if err != nil {
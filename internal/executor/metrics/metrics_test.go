@@ -43,3 +43,9 @@ func TestTotals(t *testing.T) {
 	assert.EqualValues(t, expectedNumCpusTotal, numCpusTotal)
 	assert.EqualValues(t, expectedAmountMemory.Total, amountMemoryTotal)
 }
+
+func TestEffectiveTotal(t *testing.T) {
+	assert.EqualValues(t, 2.5, metrics.EffectiveTotal(8, 2.5))
+	assert.EqualValues(t, 8, metrics.EffectiveTotal(8, 0))
+	assert.EqualValues(t, 16*1024*1024*1024, metrics.EffectiveTotal(16*1024*1024*1024, 9223372036854771712))
+}
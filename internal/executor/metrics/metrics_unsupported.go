@@ -10,6 +10,7 @@ import (
 
 type Result struct {
 	ResourceUtilization *api.ResourceUtilization
+	Charts              map[string][]*api.ChartPoint
 }
 
 func (Result) Errors() []error {
@@ -17,6 +18,10 @@ func (Result) Errors() []error {
 }
 
 func Run(ctx context.Context, logger logrus.FieldLogger) chan *Result {
+	return RunWithConfig(ctx, logger, Config{})
+}
+
+func RunWithConfig(ctx context.Context, logger logrus.FieldLogger, config Config) chan *Result {
 	resultChan := make(chan *Result, 1)
 
 	resultChan <- &Result{}
@@ -2,4 +2,7 @@ package cpu
 
 type CPUWithUsage interface {
 	CPUUsage() (float64, error)
+
+	// CPULimit returns the number of CPUs available to the cgroup or zero if it's unlimited
+	CPULimit() (float64, error)
 }
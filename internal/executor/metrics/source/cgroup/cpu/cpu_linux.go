@@ -94,6 +94,10 @@ func (cpu *VersionlessCPU) NumCpusUsed(ctx context.Context, pollInterval time.Du
 	return math.Min(100, math.Max(0, cgroupDelta/systemDelta)) * float64(numCpus), nil
 }
 
+func (cpu *VersionlessCPU) NumCpusLimit() (float64, error) {
+	return cpu.versionedCPU.CPULimit()
+}
+
 func (cpu *VersionlessCPU) Name() string {
 	return fmt.Sprintf("cgroup CPU resolver on %s/%s", runtime.GOOS, runtime.GOARCH)
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/parser"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 type V1CPU struct {
@@ -36,3 +38,28 @@ func (cpu *V1CPU) CPUUsage() (float64, error) {
 
 	return float64(result) / 1_000_000_000, nil
 }
+
+func (cpu *V1CPU) CPULimit() (float64, error) {
+	// The cpu controller is usually co-mounted with the cpuacct
+	quota, err := readV1Value(filepath.Join(cpu.path, "cpu.cfs_quota_us"))
+	if err != nil || quota <= 0 {
+		return 0, nil
+	}
+
+	period, err := readV1Value(filepath.Join(cpu.path, "cpu.cfs_period_us"))
+	if err != nil || period <= 0 {
+		return 0, nil
+	}
+
+	return float64(quota) / float64(period), nil
+}
+
+func readV1Value(path string) (int64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	// cpu.cfs_quota_us contains -1 when there's no limit
+	return strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64)
+}
@@ -4,6 +4,7 @@
 package cpu
 
 import (
+	"errors"
 	"fmt"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/parser"
 	"os"
@@ -43,3 +44,18 @@ func (cpu *V2CPU) CPUUsage() (float64, error) {
 
 	return float64(usageUsec) / 1_000_000, nil
 }
+
+func (cpu *V2CPU) CPULimit() (float64, error) {
+	file, err := os.Open(filepath.Join(cpu.path, "cpu.max"))
+	if err != nil {
+		// cpu.max doesn't exist in the root cgroup
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+	defer file.Close()
+
+	return parser.ParseCPUMaxFile(file)
+}
@@ -1,5 +1,13 @@
 package memory
 
+import "github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source"
+
 type MemoryWithUsage interface {
 	MemoryUsage() (float64, error)
+
+	// MemoryLimit returns the amount of memory available to the cgroup or zero if it's unlimited
+	MemoryLimit() (float64, error)
+
+	// MemoryEvents returns the OOM kill and limit hit counters of the cgroup
+	MemoryEvents() (source.MemoryEvents, error)
 }
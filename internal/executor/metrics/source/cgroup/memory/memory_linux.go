@@ -43,6 +43,14 @@ func (cpu *VersionlessMemory) Name() string {
 	return fmt.Sprintf("cgroup memory resolver on %s/%s", runtime.GOOS, runtime.GOARCH)
 }
 
+func (memory *VersionlessMemory) AmountMemoryLimit() (float64, error) {
+	return memory.versionedMemory.MemoryLimit()
+}
+
+func (memory *VersionlessMemory) MemoryEvents() (source.MemoryEvents, error) {
+	return memory.versionedMemory.MemoryEvents()
+}
+
 func (memory *VersionlessMemory) AmountMemoryUsed(ctx context.Context) (float64, error) {
 	return memory.versionedMemory.MemoryUsage()
 }
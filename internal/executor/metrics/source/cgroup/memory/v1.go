@@ -5,6 +5,7 @@ package memory
 
 import (
 	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/parser"
 	"os"
 	"path/filepath"
@@ -57,3 +58,51 @@ func (memory *V1Memory) MemoryUsage() (float64, error) {
 
 	return float64(total - totalInactiveFile), nil
 }
+
+func (memory *V1Memory) MemoryLimit() (float64, error) {
+	file, err := os.Open(filepath.Join(memory.path, "memory.limit_in_bytes"))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	// When there's no limit, this is a huge page-aligned number
+	// (e.g. 9223372036854771712), which is clamped to the system total
+	limit, err := parser.ParseSingleValueFile(file)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(limit), nil
+}
+
+func (memory *V1Memory) MemoryEvents() (source.MemoryEvents, error) {
+	var events source.MemoryEvents
+
+	file, err := os.Open(filepath.Join(memory.path, "memory.oom_control"))
+	if err != nil {
+		return events, err
+	}
+	defer file.Close()
+
+	kvs, err := parser.ParseKeyValueFile(file)
+	if err != nil {
+		return events, err
+	}
+
+	// The oom_kill field is only available since Linux 4.13
+	events.OOMKills = kvs["oom_kill"]
+
+	failcntFile, err := os.Open(filepath.Join(memory.path, "memory.failcnt"))
+	if err != nil {
+		return events, err
+	}
+	defer failcntFile.Close()
+
+	events.LimitHits, err = parser.ParseSingleValueFile(failcntFile)
+	if err != nil {
+		return events, err
+	}
+
+	return events, nil
+}
@@ -4,7 +4,9 @@
 package memory
 
 import (
+	"errors"
 	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/parser"
 	"os"
 	"path/filepath"
@@ -57,3 +59,43 @@ func (memory *V2Memory) MemoryUsage() (float64, error) {
 
 	return float64(total - inactiveFile), nil
 }
+
+func (memory *V2Memory) MemoryLimit() (float64, error) {
+	file, err := os.Open(filepath.Join(memory.path, "memory.max"))
+	if err != nil {
+		// memory.max doesn't exist in the root cgroup
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+	defer file.Close()
+
+	limit, err := parser.ParseLimitFile(file)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(limit), nil
+}
+
+func (memory *V2Memory) MemoryEvents() (source.MemoryEvents, error) {
+	var events source.MemoryEvents
+
+	file, err := os.Open(filepath.Join(memory.path, "memory.events"))
+	if err != nil {
+		return events, err
+	}
+	defer file.Close()
+
+	kvs, err := parser.ParseKeyValueFile(file)
+	if err != nil {
+		return events, err
+	}
+
+	events.OOMKills = kvs["oom_kill"]
+	events.LimitHits = kvs["max"]
+
+	return events, nil
+}
@@ -74,3 +74,75 @@ func ParseKeyValueFile(input io.Reader) (map[string]uint64, error) {
 
 	return result, nil
 }
+
+// ParseLimitFile parses the cgroup v2 limit files like memory.max, which contain either
+// a single value or "max" when there's no limit, in which case the zero is returned.
+func ParseLimitFile(input io.Reader) (uint64, error) {
+	fields, err := parseSingleLineFields(input)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(fields) != 1 {
+		return 0, fmt.Errorf("%w: expected a single value, got %d", ErrInvalidFormat, len(fields))
+	}
+
+	return parseLimitValue(fields[0])
+}
+
+// ParseCPUMaxFile parses the cgroup v2 cpu.max file in the "$MAX $PERIOD" format
+// and returns the number of CPUs available, which is zero when there's no limit.
+func ParseCPUMaxFile(input io.Reader) (float64, error) {
+	fields, err := parseSingleLineFields(input)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(fields) != 2 {
+		return 0, fmt.Errorf("%w: expected quota and period, got %d values", ErrInvalidFormat, len(fields))
+	}
+
+	quota, err := parseLimitValue(fields[0])
+	if err != nil {
+		return 0, err
+	}
+
+	period, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to parse period %q: %v", ErrInvalidFormat, fields[1], err)
+	}
+
+	if quota == 0 || period == 0 {
+		return 0, nil
+	}
+
+	return float64(quota) / float64(period), nil
+}
+
+func parseSingleLineFields(input io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(input)
+
+	if !scanner.Scan() {
+		err := scanner.Err()
+		if err == nil {
+			err = io.EOF
+		}
+
+		return nil, fmt.Errorf("%w: got error while reading line: %v", ErrInvalidFormat, err)
+	}
+
+	return strings.Fields(scanner.Text()), nil
+}
+
+func parseLimitValue(value string) (uint64, error) {
+	if value == "max" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to parse limit %q: %v", ErrInvalidFormat, value, err)
+	}
+
+	return parsed, nil
+}
@@ -63,3 +63,26 @@ total_unevictable 0
 	assert.True(t, ok)
 	assert.EqualValues(t, totalInactiveFile, 165294080)
 }
+
+func TestParseLimitFile(t *testing.T) {
+	result, err := ParseLimitFile(bytes.NewBufferString("536870912\n"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 536870912, result)
+
+	result, err = ParseLimitFile(bytes.NewBufferString("max\n"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, result)
+}
+
+func TestParseCPUMaxFile(t *testing.T) {
+	result, err := ParseCPUMaxFile(bytes.NewBufferString("250000 100000\n"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2.5, result)
+
+	result, err = ParseCPUMaxFile(bytes.NewBufferString("max 100000\n"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, result)
+
+	_, err = ParseCPUMaxFile(bytes.NewBufferString("100000\n"))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
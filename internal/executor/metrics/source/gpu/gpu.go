@@ -0,0 +1,140 @@
+package gpu
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	// GaugeGPUsUsed is the sum of the utilization of all GPUs, similarly to the number of CPUs used
+	GaugeGPUsUsed = "gpus_used"
+
+	// GaugeGPUMemoryUsed is the amount of the GPU memory used in bytes (NVIDIA only)
+	GaugeGPUMemoryUsed = "gpu_memory_used_bytes"
+)
+
+var ErrNoGPU = errors.New("no supported GPU found")
+
+var appleUtilizationRegexp = regexp.MustCompile(`"Device Utilization %"=(\d+)`)
+
+type GPU struct {
+	name   string
+	sample func(ctx context.Context) (map[string]float64, error)
+}
+
+// New detects NVIDIA GPUs (via nvidia-smi) and Apple Silicon GPUs (via ioreg).
+func New() (*GPU, error) {
+	if path, err := exec.LookPath("nvidia-smi"); err == nil {
+		return &GPU{
+			name: "nvidia-smi",
+			sample: func(ctx context.Context) (map[string]float64, error) {
+				output, err := exec.CommandContext(ctx, path, "--query-gpu=utilization.gpu,memory.used",
+					"--format=csv,noheader,nounits").Output()
+				if err != nil {
+					return nil, err
+				}
+
+				return ParseNvidiaSMI(output)
+			},
+		}, nil
+	}
+
+	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
+		return &GPU{
+			name: "ioreg",
+			sample: func(ctx context.Context) (map[string]float64, error) {
+				output, err := exec.CommandContext(ctx, "ioreg", "-r", "-d", "1", "-c", "IOAccelerator").Output()
+				if err != nil {
+					return nil, err
+				}
+
+				return ParseIOReg(output)
+			},
+		}, nil
+	}
+
+	return nil, ErrNoGPU
+}
+
+func (gpu *GPU) Name() string {
+	return fmt.Sprintf("GPU metrics using %s", gpu.name)
+}
+
+func (gpu *GPU) Gauges(ctx context.Context) (map[string]float64, error) {
+	return gpu.sample(ctx)
+}
+
+// ParseNvidiaSMI parses the "utilization.gpu,memory.used" CSV query output,
+// which contains one line per GPU with the utilization in percents and memory in MiB.
+func ParseNvidiaSMI(output []byte) (map[string]float64, error) {
+	var gpusUsed, memoryUsed float64
+	var numGPUs int
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("unexpected nvidia-smi output line: %q", line)
+		}
+
+		utilization, err := strconv.ParseFloat(strings.TrimSpace(fields[0]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse GPU utilization: %w", err)
+		}
+
+		memoryMiB, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse GPU memory usage: %w", err)
+		}
+
+		gpusUsed += utilization / 100
+		memoryUsed += memoryMiB * 1024 * 1024
+		numGPUs++
+	}
+
+	if numGPUs == 0 {
+		return nil, ErrNoGPU
+	}
+
+	return map[string]float64{
+		GaugeGPUsUsed:      gpusUsed,
+		GaugeGPUMemoryUsed: memoryUsed,
+	}, nil
+}
+
+// ParseIOReg parses the "Device Utilization %" from the IOAccelerator's PerformanceStatistics.
+func ParseIOReg(output []byte) (map[string]float64, error) {
+	matches := appleUtilizationRegexp.FindAllSubmatch(output, -1)
+	if len(matches) == 0 {
+		return nil, ErrNoGPU
+	}
+
+	var gpusUsed float64
+
+	for _, match := range matches {
+		utilization, err := strconv.ParseFloat(string(match[1]), 64)
+		if err != nil {
+			return nil, err
+		}
+
+		gpusUsed += utilization / 100
+	}
+
+	return map[string]float64{
+		GaugeGPUsUsed: gpusUsed,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package gpu_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/gpu"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseNvidiaSMI(t *testing.T) {
+	result, err := gpu.ParseNvidiaSMI([]byte("50, 1024\n100, 2048\n"))
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{
+		gpu.GaugeGPUsUsed:      1.5,
+		gpu.GaugeGPUMemoryUsed: 3 * 1024 * 1024 * 1024,
+	}, result)
+
+	_, err = gpu.ParseNvidiaSMI([]byte(""))
+	require.ErrorIs(t, err, gpu.ErrNoGPU)
+}
+
+func TestParseIOReg(t *testing.T) {
+	const output = `+-o AGXAcceleratorG13X  <class AGXAcceleratorG13X, id 0x1000005d1>
+    {
+      "PerformanceStatistics" = {"In use system memory"=0,"Device Utilization %"=42,"Renderer Utilization %"=40}
+    }
+`
+
+	result, err := gpu.ParseIOReg([]byte(output))
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{gpu.GaugeGPUsUsed: 0.42}, result)
+}
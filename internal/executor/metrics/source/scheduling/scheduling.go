@@ -0,0 +1,143 @@
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/load"
+	"runtime"
+	"time"
+)
+
+const (
+	// GaugeLoadAverage is the 1-minute system load average
+	GaugeLoadAverage = "load_average_1m"
+
+	// GaugeContextSwitches is the system-wide context switch rate (Linux only)
+	GaugeContextSwitches = "context_switches_per_second"
+
+	// GaugeVoluntaryContextSwitches and GaugeInvoluntaryContextSwitches are the context
+	// switch rates of the agent and the commands it has run, the latter being high means
+	// that the processes are preempted in favor of the other (e.g. neighbor's) processes
+	GaugeVoluntaryContextSwitches   = "voluntary_context_switches_per_second"
+	GaugeInvoluntaryContextSwitches = "involuntary_context_switches_per_second"
+
+	// GaugeCPUSteal is the percentage of the CPU time stolen by the hypervisor
+	// to serve the other virtual machines (Linux only)
+	GaugeCPUSteal = "cpu_steal_percent"
+)
+
+var ErrNoCounters = errors.New("no scheduling counters are available on this platform")
+
+// Counters is a snapshot of the cumulative scheduling counters,
+// the missing counters are nil.
+type Counters struct {
+	Time                       time.Time
+	ContextSwitches            *uint64
+	VoluntaryContextSwitches   *uint64
+	InvoluntaryContextSwitches *uint64
+	CPUSteal                   *float64
+	CPUTotal                   *float64
+}
+
+// Scheduling collects the metrics helping to diagnose the noisy-neighbor effects.
+type Scheduling struct {
+	previous *Counters
+}
+
+func New() *Scheduling {
+	return &Scheduling{}
+}
+
+func (scheduling *Scheduling) Name() string {
+	return "load average, context switches and CPU steal time"
+}
+
+func (scheduling *Scheduling) Gauges(ctx context.Context) (map[string]float64, error) {
+	result := map[string]float64{}
+
+	if avg, err := load.AvgWithContext(ctx); err == nil && runtime.GOOS != "windows" {
+		result[GaugeLoadAverage] = avg.Load1
+	}
+
+	current := &Counters{Time: time.Now()}
+
+	if runtime.GOOS == "linux" {
+		if misc, err := load.MiscWithContext(ctx); err == nil {
+			contextSwitches := uint64(misc.Ctxt)
+			current.ContextSwitches = &contextSwitches
+		}
+
+		if times, err := cpu.TimesWithContext(ctx, false); err == nil && len(times) == 1 {
+			steal, total := times[0].Steal, times[0].Total()
+			current.CPUSteal = &steal
+			current.CPUTotal = &total
+		}
+	}
+
+	if voluntary, involuntary, err := processContextSwitches(); err == nil {
+		current.VoluntaryContextSwitches = &voluntary
+		current.InvoluntaryContextSwitches = &involuntary
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	previous := scheduling.previous
+	scheduling.previous = current
+
+	if previous != nil {
+		for name, value := range Rates(previous, current) {
+			result[name] = value
+		}
+	}
+
+	if len(result) == 0 && current.ContextSwitches == nil && current.VoluntaryContextSwitches == nil &&
+		current.CPUSteal == nil {
+		return nil, ErrNoCounters
+	}
+
+	return result, nil
+}
+
+// Rates returns the per-second context switch rates and the CPU steal percentage
+// between the two snapshots for the counters present in both of them.
+func Rates(previous *Counters, current *Counters) map[string]float64 {
+	result := map[string]float64{}
+
+	seconds := current.Time.Sub(previous.Time).Seconds()
+	if seconds <= 0 {
+		return result
+	}
+
+	rate := func(name string, before *uint64, after *uint64) {
+		if before == nil || after == nil {
+			return
+		}
+
+		// Treat the counter resets as no activity
+		if *after < *before {
+			result[name] = 0
+
+			return
+		}
+
+		result[name] = float64(*after-*before) / seconds
+	}
+
+	rate(GaugeContextSwitches, previous.ContextSwitches, current.ContextSwitches)
+	rate(GaugeVoluntaryContextSwitches, previous.VoluntaryContextSwitches, current.VoluntaryContextSwitches)
+	rate(GaugeInvoluntaryContextSwitches, previous.InvoluntaryContextSwitches, current.InvoluntaryContextSwitches)
+
+	if previous.CPUSteal != nil && current.CPUSteal != nil && previous.CPUTotal != nil && current.CPUTotal != nil {
+		totalDelta := *current.CPUTotal - *previous.CPUTotal
+		stealDelta := *current.CPUSteal - *previous.CPUSteal
+
+		if totalDelta > 0 && stealDelta >= 0 {
+			result[GaugeCPUSteal] = stealDelta / totalDelta * 100
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,55 @@
+package scheduling
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func uint64Ptr(value uint64) *uint64 {
+	return &value
+}
+
+func float64Ptr(value float64) *float64 {
+	return &value
+}
+
+func TestRates(t *testing.T) {
+	start := time.Now()
+
+	previous := &Counters{
+		Time:                       start,
+		ContextSwitches:            uint64Ptr(1000),
+		VoluntaryContextSwitches:   uint64Ptr(100),
+		InvoluntaryContextSwitches: uint64Ptr(50),
+		CPUSteal:                   float64Ptr(10),
+		CPUTotal:                   float64Ptr(1000),
+	}
+	current := &Counters{
+		Time:                       start.Add(2 * time.Second),
+		ContextSwitches:            uint64Ptr(3000),
+		VoluntaryContextSwitches:   uint64Ptr(300),
+		InvoluntaryContextSwitches: uint64Ptr(40),
+		CPUSteal:                   float64Ptr(15),
+		CPUTotal:                   float64Ptr(1020),
+	}
+
+	require.Equal(t, map[string]float64{
+		GaugeContextSwitches:            1000,
+		GaugeVoluntaryContextSwitches:   100,
+		GaugeInvoluntaryContextSwitches: 0,
+		GaugeCPUSteal:                   25,
+	}, Rates(previous, current))
+}
+
+func TestRatesMissingCounters(t *testing.T) {
+	start := time.Now()
+
+	previous := &Counters{Time: start, VoluntaryContextSwitches: uint64Ptr(100)}
+	current := &Counters{Time: start.Add(time.Second), VoluntaryContextSwitches: uint64Ptr(150),
+		ContextSwitches: uint64Ptr(10)}
+
+	require.Equal(t, map[string]float64{
+		GaugeVoluntaryContextSwitches: 50,
+	}, Rates(previous, current))
+}
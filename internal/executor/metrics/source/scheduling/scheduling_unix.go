@@ -0,0 +1,25 @@
+//go:build !windows
+// +build !windows
+
+package scheduling
+
+import "syscall"
+
+// processContextSwitches returns the number of context switches of the agent
+// and its terminated children (i.e. the commands that have already finished).
+func processContextSwitches() (uint64, uint64, error) {
+	var voluntary, involuntary uint64
+
+	for _, who := range []int{syscall.RUSAGE_SELF, syscall.RUSAGE_CHILDREN} {
+		var rusage syscall.Rusage
+
+		if err := syscall.Getrusage(who, &rusage); err != nil {
+			return 0, 0, err
+		}
+
+		voluntary += uint64(rusage.Nvcsw)
+		involuntary += uint64(rusage.Nivcsw)
+	}
+
+	return voluntary, involuntary, nil
+}
@@ -14,3 +14,37 @@ type Memory interface {
 	Name() string
 	AmountMemoryUsed(ctx context.Context) (float64, error)
 }
+
+// Gauges is a source of the metrics that have no dedicated chart in the api.ResourceUtilization,
+// each sample is a map from the metric name to its current value.
+type Gauges interface {
+	Name() string
+	Gauges(ctx context.Context) (map[string]float64, error)
+}
+
+// CPULimit is implemented by the CPU sources aware of the CPU quota (e.g. cgroup),
+// zero means that there's no limit.
+type CPULimit interface {
+	NumCpusLimit() (float64, error)
+}
+
+// MemoryLimit is implemented by the memory sources aware of the memory limit (e.g. cgroup),
+// zero means that there's no limit.
+type MemoryLimit interface {
+	AmountMemoryLimit() (float64, error)
+}
+
+// MemoryEvents are the cumulative counters of the memory-related events.
+type MemoryEvents struct {
+	// OOMKills is the number of processes killed by the kernel's OOM killer
+	OOMKills uint64
+
+	// LimitHits is the number of times the memory usage has hit the limit,
+	// which is a sign of memory pressure even when nothing was killed
+	LimitHits uint64
+}
+
+// MemoryEventsSource is implemented by the memory sources able to count the OOM kills (e.g. cgroup).
+type MemoryEventsSource interface {
+	MemoryEvents() (MemoryEvents, error)
+}
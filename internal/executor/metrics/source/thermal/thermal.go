@@ -0,0 +1,161 @@
+package thermal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+)
+
+const (
+	// GaugeCPUSpeedLimit is the percentage of the maximum CPU speed allowed by the thermal management,
+	// only reported on Intel-based Macs
+	GaugeCPUSpeedLimit = "cpu_speed_limit_percent"
+
+	// GaugeThermalWarningLevel is non-zero when the system is under the thermal pressure
+	GaugeThermalWarningLevel = "thermal_warning_level"
+
+	// GaugePerformanceWarningLevel is non-zero when the performance is being capped
+	GaugePerformanceWarningLevel = "performance_warning_level"
+)
+
+var ErrUnsupported = errors.New("thermal throttling detection is only supported on macOS")
+
+var (
+	speedLimitRegexp         = regexp.MustCompile(`CPU_Speed_Limit\s*=\s*(\d+)`)
+	thermalWarningRegexp     = regexp.MustCompile(`(?i)thermal warning level.*?(\d+)`)
+	performanceWarningRegexp = regexp.MustCompile(`(?i)performance warning level.*?(\d+)`)
+)
+
+type Thermal struct{}
+
+// New returns a source sampling the thermal state using "pmset -g therm" on macOS.
+func New() (*Thermal, error) {
+	if runtime.GOOS != "darwin" {
+		return nil, ErrUnsupported
+	}
+
+	if _, err := exec.LookPath("pmset"); err != nil {
+		return nil, err
+	}
+
+	return &Thermal{}, nil
+}
+
+func (thermal *Thermal) Name() string {
+	return "thermal state using pmset"
+}
+
+func (thermal *Thermal) Gauges(ctx context.Context) (map[string]float64, error) {
+	output, err := exec.CommandContext(ctx, "pmset", "-g", "therm").Output()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePmsetTherm(output)
+}
+
+// ParsePmsetTherm parses the "pmset -g therm" output, in which the absence of
+// the recorded warning levels means that there's no thermal pressure.
+func ParsePmsetTherm(output []byte) (map[string]float64, error) {
+	result := map[string]float64{
+		GaugeThermalWarningLevel:     0,
+		GaugePerformanceWarningLevel: 0,
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		for gauge, re := range map[string]*regexp.Regexp{
+			GaugeCPUSpeedLimit:           speedLimitRegexp,
+			GaugeThermalWarningLevel:     thermalWarningRegexp,
+			GaugePerformanceWarningLevel: performanceWarningRegexp,
+		} {
+			matches := re.FindStringSubmatch(line)
+			if matches == nil {
+				continue
+			}
+
+			value, err := strconv.ParseFloat(matches[1], 64)
+			if err != nil {
+				return nil, err
+			}
+
+			result[gauge] = value
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Period is a time interval during which the CPU was throttled.
+type Period struct {
+	StartSecondsFromStart uint32
+	EndSecondsFromStart   uint32
+}
+
+// ThrottledPeriods finds the periods of throttling in the charts collected from this source.
+func ThrottledPeriods(charts map[string][]*api.ChartPoint) []Period {
+	throttledAt := map[uint32]bool{}
+	var timestamps []uint32
+
+	mark := func(points []*api.ChartPoint, isThrottled func(value float64) bool) {
+		for _, point := range points {
+			if _, ok := throttledAt[point.SecondsFromStart]; !ok {
+				timestamps = append(timestamps, point.SecondsFromStart)
+				throttledAt[point.SecondsFromStart] = false
+			}
+
+			if isThrottled(point.Value) {
+				throttledAt[point.SecondsFromStart] = true
+			}
+		}
+	}
+
+	mark(charts[GaugeCPUSpeedLimit], func(value float64) bool { return value < 100 })
+	mark(charts[GaugeThermalWarningLevel], func(value float64) bool { return value > 0 })
+	mark(charts[GaugePerformanceWarningLevel], func(value float64) bool { return value > 0 })
+
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+
+	var result []Period
+	var current *Period
+
+	for _, timestamp := range timestamps {
+		if throttledAt[timestamp] {
+			if current == nil {
+				current = &Period{StartSecondsFromStart: timestamp}
+			}
+			current.EndSecondsFromStart = timestamp
+
+			continue
+		}
+
+		if current != nil {
+			// The throttling has ended somewhere between the samples
+			current.EndSecondsFromStart = timestamp
+			result = append(result, *current)
+			current = nil
+		}
+	}
+
+	if current != nil {
+		result = append(result, *current)
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+package thermal_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/thermal"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParsePmsetThermNominal(t *testing.T) {
+	output := []byte(`Note: No thermal warning level has been recorded
+Note: No performance warning level has been recorded
+Note: No CPU power status has been recorded
+`)
+
+	gauges, err := thermal.ParsePmsetTherm(output)
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{
+		thermal.GaugeThermalWarningLevel:     0,
+		thermal.GaugePerformanceWarningLevel: 0,
+	}, gauges)
+}
+
+func TestParsePmsetThermThrottled(t *testing.T) {
+	output := []byte(`2023-03-01 12:00:00 +0000 Thermal Warning Level has been set to 2
+Note: No performance warning level has been recorded
+2023-03-01 12:00:00 +0000 CPU Power notify
+	CPU_Scheduler_Limit 	= 100
+	CPU_Available_CPUs 	= 8
+	CPU_Speed_Limit 	= 67
+`)
+
+	gauges, err := thermal.ParsePmsetTherm(output)
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{
+		thermal.GaugeCPUSpeedLimit:           67,
+		thermal.GaugeThermalWarningLevel:     2,
+		thermal.GaugePerformanceWarningLevel: 0,
+	}, gauges)
+}
+
+func TestThrottledPeriods(t *testing.T) {
+	charts := map[string][]*api.ChartPoint{
+		thermal.GaugeCPUSpeedLimit: {
+			{SecondsFromStart: 0, Value: 100},
+			{SecondsFromStart: 10, Value: 80},
+			{SecondsFromStart: 20, Value: 70},
+			{SecondsFromStart: 30, Value: 100},
+			{SecondsFromStart: 40, Value: 100},
+			{SecondsFromStart: 50, Value: 100},
+		},
+		thermal.GaugeThermalWarningLevel: {
+			{SecondsFromStart: 0, Value: 0},
+			{SecondsFromStart: 10, Value: 0},
+			{SecondsFromStart: 20, Value: 0},
+			{SecondsFromStart: 30, Value: 0},
+			{SecondsFromStart: 40, Value: 0},
+			{SecondsFromStart: 50, Value: 1},
+		},
+	}
+
+	require.Equal(t, []thermal.Period{
+		{StartSecondsFromStart: 10, EndSecondsFromStart: 30},
+		{StartSecondsFromStart: 50, EndSecondsFromStart: 50},
+	}, thermal.ThrottledPeriods(charts))
+
+	require.Empty(t, thermal.ThrottledPeriods(map[string][]*api.ChartPoint{}))
+}
@@ -0,0 +1,121 @@
+package throughput
+
+import (
+	"context"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/net"
+	"strings"
+	"time"
+)
+
+const (
+	GaugeDiskReadBytesPerSecond    = "disk_read_bytes_per_second"
+	GaugeDiskWriteBytesPerSecond   = "disk_write_bytes_per_second"
+	GaugeNetworkSentBytesPerSecond = "network_sent_bytes_per_second"
+	GaugeNetworkRecvBytesPerSecond = "network_received_bytes_per_second"
+)
+
+// Counters is a snapshot of the cumulative I/O counters.
+type Counters struct {
+	Time             time.Time
+	DiskReadBytes    uint64
+	DiskWriteBytes   uint64
+	NetworkSentBytes uint64
+	NetworkRecvBytes uint64
+}
+
+// Throughput calculates the disk and network throughput between the consecutive samples.
+type Throughput struct {
+	previous *Counters
+}
+
+func New() *Throughput {
+	return &Throughput{}
+}
+
+func (throughput *Throughput) Name() string {
+	return "disk and network I/O counters"
+}
+
+func (throughput *Throughput) Gauges(ctx context.Context) (map[string]float64, error) {
+	current := &Counters{Time: time.Now()}
+
+	diskCounters, err := disk.IOCountersWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, counters := range diskCounters {
+		if isVirtualOrPartition(name, diskCounters) {
+			continue
+		}
+
+		current.DiskReadBytes += counters.ReadBytes
+		current.DiskWriteBytes += counters.WriteBytes
+	}
+
+	netCounters, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, counters := range netCounters {
+		if strings.HasPrefix(counters.Name, "lo") {
+			continue
+		}
+
+		current.NetworkSentBytes += counters.BytesSent
+		current.NetworkRecvBytes += counters.BytesRecv
+	}
+
+	previous := throughput.previous
+	throughput.previous = current
+
+	if previous == nil {
+		return map[string]float64{}, nil
+	}
+
+	return Rates(previous, current), nil
+}
+
+// Rates returns the per-second rates between the two snapshots, treating
+// the counter resets (e.g. a removed network interface) as no activity.
+func Rates(previous *Counters, current *Counters) map[string]float64 {
+	seconds := current.Time.Sub(previous.Time).Seconds()
+	if seconds <= 0 {
+		return map[string]float64{}
+	}
+
+	rate := func(before uint64, after uint64) float64 {
+		if after < before {
+			return 0
+		}
+
+		return float64(after-before) / seconds
+	}
+
+	return map[string]float64{
+		GaugeDiskReadBytesPerSecond:    rate(previous.DiskReadBytes, current.DiskReadBytes),
+		GaugeDiskWriteBytesPerSecond:   rate(previous.DiskWriteBytes, current.DiskWriteBytes),
+		GaugeNetworkSentBytesPerSecond: rate(previous.NetworkSentBytes, current.NetworkSentBytes),
+		GaugeNetworkRecvBytesPerSecond: rate(previous.NetworkRecvBytes, current.NetworkRecvBytes),
+	}
+}
+
+// isVirtualOrPartition avoids counting the same I/O multiple times on Linux,
+// where the partitions (e.g. sda1 or nvme0n1p1) are reported along with their disks.
+func isVirtualOrPartition(name string, all map[string]disk.IOCountersStat) bool {
+	for _, prefix := range []string{"loop", "ram", "zram", "dm-", "md"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	for other := range all {
+		if other != name && strings.HasPrefix(name, other) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package throughput
+
+import (
+	"github.com/shirou/gopsutil/disk"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRates(t *testing.T) {
+	now := time.Now()
+
+	rates := Rates(&Counters{
+		Time:             now,
+		DiskReadBytes:    1000,
+		DiskWriteBytes:   2000,
+		NetworkSentBytes: 500,
+		NetworkRecvBytes: 100,
+	}, &Counters{
+		Time:             now.Add(2 * time.Second),
+		DiskReadBytes:    3000,
+		DiskWriteBytes:   2000,
+		NetworkSentBytes: 400,
+		NetworkRecvBytes: 4100,
+	})
+
+	require.Equal(t, map[string]float64{
+		GaugeDiskReadBytesPerSecond:    1000,
+		GaugeDiskWriteBytesPerSecond:   0,
+		GaugeNetworkSentBytesPerSecond: 0,
+		GaugeNetworkRecvBytesPerSecond: 2000,
+	}, rates)
+}
+
+func TestIsVirtualOrPartition(t *testing.T) {
+	all := map[string]disk.IOCountersStat{
+		"sda": {}, "sda1": {}, "nvme0n1": {}, "nvme0n1p1": {}, "loop0": {}, "disk0": {},
+	}
+
+	var physical []string
+	for name := range all {
+		if !isVirtualOrPartition(name, all) {
+			physical = append(physical, name)
+		}
+	}
+
+	require.ElementsMatch(t, []string{"sda", "nvme0n1", "disk0"}, physical)
+}
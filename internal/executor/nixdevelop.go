@@ -0,0 +1,82 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/nixenv"
+	"os"
+	"strings"
+)
+
+// PluginNixDevelop is the built-in plugin that enters a Nix development shell for the following steps
+// by applying its environment to the task's one. It's configured with the following properties:
+//
+//   - flake — the flake installable to pass to the "nix develop" ("." by default)
+//   - shell — the path to the shell.nix-style expression to use the legacy "nix-shell" instead
+const PluginNixDevelop = "nix_develop"
+
+func (executor *Executor) enterNixDevelop(
+	ctx context.Context,
+	logUploader *LogUploader,
+	command *api.Command,
+) bool {
+	flake := executor.env.ExpandText(command.Properties["flake"])
+	shell := executor.env.ExpandText(command.Properties["shell"])
+
+	var nixCommand []string
+
+	switch {
+	case flake != "" && shell != "":
+		_, _ = fmt.Fprintln(logUploader, "Only one of the \"flake\" and \"shell\" properties can be specified")
+
+		return false
+	case shell != "":
+		nixCommand = nixenv.ShellCommand(shell)
+	case flake != "":
+		nixCommand = nixenv.DevelopCommand(flake)
+	default:
+		nixCommand = nixenv.DevelopCommand(".")
+	}
+
+	env := map[string]string{}
+	for _, keyValue := range os.Environ() {
+		if parts := strings.SplitN(keyValue, "=", 2); len(parts) == 2 {
+			env[parts[0]] = parts[1]
+		}
+	}
+	for key, value := range executor.env.Items() {
+		env[key] = value
+	}
+
+	_, _ = fmt.Fprintln(logUploader, "Capturing the environment of the Nix development shell...")
+
+	changes, err := nixenv.Capture(ctx, nixCommand, executor.env.Get("CIRRUS_WORKING_DIR"), env, logUploader)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "Failed to capture the Nix development shell environment: %v\n", err)
+
+		return false
+	}
+
+	var numAdded, numChanged, numRemoved int
+
+	for _, change := range changes {
+		switch change.Kind {
+		case environment.ChangeAdded:
+			executor.env.Set(change.Key, change.Value)
+			numAdded++
+		case environment.ChangeModified:
+			executor.env.Set(change.Key, change.Value)
+			numChanged++
+		case environment.ChangeRemoved:
+			executor.env.Unset(change.Key)
+			numRemoved++
+		}
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "Entered the Nix development shell: %d variable(s) added, %d changed, %d removed\n",
+		numAdded, numChanged, numRemoved)
+
+	return true
+}
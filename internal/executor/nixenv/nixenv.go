@@ -0,0 +1,93 @@
+// Package nixenv captures the environment of a Nix development shell (either "nix develop"
+// or the legacy "nix-shell") so that it can be applied to the scripts without wrapping them.
+package nixenv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// ignoredVariables are set by the shell itself or only make sense inside of it.
+var ignoredVariables = map[string]struct{}{
+	"_":             {},
+	"OLDPWD":        {},
+	"PWD":           {},
+	"SHLVL":         {},
+	"NIX_BUILD_TOP": {},
+	"TEMP":          {},
+	"TEMPDIR":       {},
+	"TMP":           {},
+	"TMPDIR":        {},
+}
+
+// DevelopCommand prints the environment of the flake's development shell.
+func DevelopCommand(installable string) []string {
+	return []string{"nix", "--extra-experimental-features", "nix-command flakes",
+		"develop", installable, "--command", "env", "-0"}
+}
+
+// ShellCommand prints the environment of the shell.nix-style expression.
+func ShellCommand(path string) []string {
+	return []string{"nix-shell", path, "--run", "env -0"}
+}
+
+// Capture runs one of the commands above in the dir with the env, returning the changes
+// the development shell makes to the env. The output of Nix itself (e.g. the build progress)
+// is written to the stderr.
+func Capture(
+	ctx context.Context,
+	command []string,
+	dir string,
+	env map[string]string,
+	stderr io.Writer,
+) ([]environment.Change, error) {
+	var stdout bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = stderr
+
+	for key, value := range env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w", command[0], err)
+	}
+
+	return Changes(env, parseEnv(stdout.Bytes())), nil
+}
+
+// Changes returns the changes the development shell made to the environment.
+func Changes(before map[string]string, after map[string]string) []environment.Change {
+	var result []environment.Change
+
+	for _, change := range environment.Diff(before, after) {
+		if _, ok := ignoredVariables[change.Key]; ok {
+			continue
+		}
+
+		result = append(result, change)
+	}
+
+	return result
+}
+
+// parseEnv parses the "env -0" output.
+func parseEnv(output []byte) map[string]string {
+	result := map[string]string{}
+
+	for _, keyValue := range strings.Split(string(output), "\x00") {
+		if parts := strings.SplitN(keyValue, "=", 2); len(parts) == 2 && parts[0] != "" {
+			result[parts[0]] = parts[1]
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,26 @@
+package nixenv
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChanges(t *testing.T) {
+	before := map[string]string{
+		"PATH": "/usr/bin",
+		"HOME": "/home/user",
+		"PWD":  "/work",
+		"GONE": "value",
+	}
+
+	after := parseEnv([]byte("PATH=/nix/store/go/bin:/usr/bin\x00HOME=/home/user\x00PWD=/tmp\x00SHLVL=2\x00" +
+		"CFLAGS=-I/nix/store/zlib/include\x00MULTILINE=a\nb=c\x00"))
+
+	assert.Equal(t, []environment.Change{
+		{Kind: environment.ChangeAdded, Key: "CFLAGS", Value: "-I/nix/store/zlib/include"},
+		{Kind: environment.ChangeRemoved, Key: "GONE"},
+		{Kind: environment.ChangeAdded, Key: "MULTILINE", Value: "a\nb=c"},
+		{Kind: environment.ChangeModified, Key: "PATH", Value: "/nix/store/go/bin:/usr/bin"},
+	}, Changes(before, after))
+}
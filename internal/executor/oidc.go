@@ -0,0 +1,91 @@
+package executor
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	EnvCirrusOIDCToken = "CIRRUS_OIDC_TOKEN"
+
+	// Refresh the token before each step if it expires in less than that,
+	// so that the step has enough time to use it
+	oidcTokenRefreshMargin = 15 * time.Minute
+)
+
+// refreshOIDCToken requests a fresh CIRRUS_OIDC_TOKEN from the server when the current one
+// is about to expire and propagates it to the environment and the still-open Vault session.
+//
+// This is done synchronously between the steps and not in the background
+// since the environment is not safe for concurrent use.
+func (executor *Executor) refreshOIDCToken(ctx context.Context, unboxers *secretUnboxers) {
+	if executor.oidcTokenRefreshUnsupported {
+		return
+	}
+
+	currentToken, ok := executor.env.Lookup(EnvCirrusOIDCToken)
+	if !ok {
+		return
+	}
+
+	expiresAt, err := jwtExpiration(currentToken)
+	if err != nil || time.Until(expiresAt) > oidcTokenRefreshMargin {
+		return
+	}
+
+	response, err := client.CirrusClient.RefreshOIDCToken(ctx, &api.RefreshOIDCTokenRequest{
+		TaskIdentification: executor.taskIdentification,
+	})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			executor.oidcTokenRefreshUnsupported = true
+		}
+
+		log.Printf("Failed to refresh %s: %v", EnvCirrusOIDCToken, err)
+
+		return
+	}
+
+	executor.env.Set(EnvCirrusOIDCToken, response.Token)
+
+	if unboxers.vault != nil {
+		unboxers.vault.SetJWT(response.Token)
+	}
+
+	log.Printf("Refreshed %s", EnvCirrusOIDCToken)
+}
+
+func jwtExpiration(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("JWT should consist of 3 parts")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, err
+	}
+
+	if claims.Exp == 0 {
+		return time.Time{}, errors.New("JWT has no expiration time")
+	}
+
+	return time.Unix(claims.Exp, 0), nil
+}
@@ -0,0 +1,22 @@
+package executor
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestJWTExpiration(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"task","exp":1700000000}`))
+
+	expiresAt, err := jwtExpiration("header." + payload + ".signature")
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1700000000, 0), expiresAt)
+
+	_, err = jwtExpiration("not a JWT")
+	require.Error(t, err)
+
+	_, err = jwtExpiration("header." + base64.RawURLEncoding.EncodeToString([]byte(`{}`)) + ".signature")
+	require.Error(t, err)
+}
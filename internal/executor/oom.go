@@ -0,0 +1,59 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/oomwatcher"
+	"log"
+	"strings"
+)
+
+// reportMemoryEvents warns about the processes killed by the kernel's OOM killer
+// and the memory limit being hit while running the command, since otherwise
+// these only manifest themselves as a mysterious exit code 137.
+func (executor *Executor) reportMemoryEvents(ctx context.Context, watcher *oomwatcher.Watcher, commandName string) {
+	if watcher == nil {
+		return
+	}
+
+	report, err := watcher.Check()
+	if err != nil {
+		log.Printf("Failed to check for OOM kills using %s: %v", watcher.Name(), err)
+
+		return
+	}
+	if report == nil {
+		return
+	}
+
+	message := formatMemoryEventsReport(report, commandName)
+	log.Print(message)
+
+	executor.reportWarning(ctx, client.ProblemUserScript, message)
+}
+
+func formatMemoryEventsReport(report *oomwatcher.Report, commandName string) string {
+	if report.OOMKills == 0 {
+		return fmt.Sprintf("The memory limit was hit %d time(s) while running the %s command, "+
+			"consider increasing the amount of memory available to the task", report.LimitHits, commandName)
+	}
+
+	var killed string
+
+	if len(report.Processes) != 0 {
+		var processes []string
+
+		for _, process := range report.Processes {
+			processes = append(processes, fmt.Sprintf("%s (PID %d)", process.Name, process.PID))
+		}
+
+		killed = strings.Join(processes, ", ")
+	} else {
+		killed = fmt.Sprintf("%d process(es)", report.OOMKills)
+	}
+
+	return fmt.Sprintf("The kernel's OOM killer has killed %s while running the %s command "+
+		"because the task ran out of memory, consider increasing the amount of memory available to the task "+
+		"or reducing the memory usage (e.g. the number of parallel jobs)", killed, commandName)
+}
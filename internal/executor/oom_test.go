@@ -0,0 +1,24 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/oomwatcher"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFormatMemoryEventsReport(t *testing.T) {
+	message := formatMemoryEventsReport(&oomwatcher.Report{
+		OOMKills: 2,
+		Processes: []oomwatcher.Process{
+			{PID: 1234, Name: "java"},
+			{PID: 5678, Name: "node"},
+		},
+	}, "build")
+	require.Contains(t, message, "has killed java (PID 1234), node (PID 5678) while running the build command")
+
+	message = formatMemoryEventsReport(&oomwatcher.Report{OOMKills: 1}, "build")
+	require.Contains(t, message, "has killed 1 process(es) while running the build command")
+
+	message = formatMemoryEventsReport(&oomwatcher.Report{LimitHits: 5}, "test")
+	require.Contains(t, message, "The memory limit was hit 5 time(s) while running the test command")
+}
@@ -0,0 +1,127 @@
+package oomwatcher
+
+import (
+	"bufio"
+	"errors"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+var ErrUnsupported = errors.New("OOM kill detection is not supported on this platform")
+
+// killedProcessRegex matches the kernel's OOM killer messages, e.g.:
+//
+//	Out of memory: Killed process 1234 (java) total-vm:...
+//	Memory cgroup out of memory: Killed process 1234 (java) total-vm:...
+var killedProcessRegex = regexp.MustCompile(`Killed process (\d+) \(([^)]*)\)`)
+
+type Process struct {
+	PID  int
+	Name string
+}
+
+// Report describes the memory events that happened since the previous Snapshot().
+type Report struct {
+	OOMKills  uint64
+	LimitHits uint64
+
+	// Processes killed by the OOM killer, best-effort since the kernel log is not always readable
+	Processes []Process
+}
+
+// Watcher detects the OOM kills and the memory pressure by comparing the memory event counters.
+type Watcher struct {
+	name       string
+	events     func() (source.MemoryEvents, error)
+	kernelLog  func() (io.ReadCloser, error)
+	lastEvents source.MemoryEvents
+}
+
+func (watcher *Watcher) Name() string {
+	return watcher.name
+}
+
+// Snapshot remembers the current counters to be compared against in the next Check().
+func (watcher *Watcher) Snapshot() error {
+	events, err := watcher.events()
+	if err != nil {
+		return err
+	}
+
+	watcher.lastEvents = events
+
+	return nil
+}
+
+// Check returns the memory events that happened since the last Snapshot()
+// or nil when there were none.
+func (watcher *Watcher) Check() (*Report, error) {
+	events, err := watcher.events()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		OOMKills:  delta(watcher.lastEvents.OOMKills, events.OOMKills),
+		LimitHits: delta(watcher.lastEvents.LimitHits, events.LimitHits),
+	}
+	watcher.lastEvents = events
+
+	if report.OOMKills == 0 && report.LimitHits == 0 {
+		return nil, nil
+	}
+
+	if report.OOMKills != 0 && watcher.kernelLog != nil {
+		if kernelLog, err := watcher.kernelLog(); err == nil {
+			processes, _ := ParseKernelLog(kernelLog)
+			_ = kernelLog.Close()
+
+			// The kernel log contains all the kills since the boot, so only take the latest ones
+			if uint64(len(processes)) > report.OOMKills {
+				processes = processes[uint64(len(processes))-report.OOMKills:]
+			}
+
+			report.Processes = processes
+		}
+	}
+
+	return report, nil
+}
+
+func delta(before uint64, after uint64) uint64 {
+	// The counters might've been reset (e.g. the cgroup was re-created)
+	if after < before {
+		return after
+	}
+
+	return after - before
+}
+
+// ParseKernelLog extracts the processes killed by the OOM killer from the kernel log (e.g. dmesg output).
+func ParseKernelLog(input io.Reader) ([]Process, error) {
+	var result []Process
+
+	scanner := bufio.NewScanner(input)
+
+	for scanner.Scan() {
+		matches := killedProcessRegex.FindStringSubmatch(scanner.Text())
+		if matches == nil {
+			continue
+		}
+
+		pid, err := strconv.Atoi(matches[1])
+		if err != nil {
+			continue
+		}
+
+		result = append(result, Process{PID: pid, Name: matches[2]})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
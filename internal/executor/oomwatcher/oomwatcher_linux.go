@@ -0,0 +1,84 @@
+//go:build linux
+// +build linux
+
+package oomwatcher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/memory"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/parser"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/cgroup/resolver"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+func New() (*Watcher, error) {
+	watcher := &Watcher{
+		kernelLog: readKernelLog,
+	}
+
+	// Prefer the cgroup counters since they only account for the processes in the agent's cgroup
+	if resolver, err := resolver.New(); err == nil {
+		if memorySource, err := memory.NewMemory(resolver); err == nil {
+			if eventsSource, ok := memorySource.(source.MemoryEventsSource); ok {
+				if _, err := eventsSource.MemoryEvents(); err == nil {
+					watcher.name = "cgroup memory events"
+					watcher.events = eventsSource.MemoryEvents
+				}
+			}
+		}
+	}
+
+	// Fall back to the system-wide counter available since Linux 4.13
+	if watcher.events == nil {
+		if _, err := systemMemoryEvents(); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+		}
+
+		watcher.name = "/proc/vmstat"
+		watcher.events = systemMemoryEvents
+	}
+
+	if err := watcher.Snapshot(); err != nil {
+		return nil, err
+	}
+
+	return watcher, nil
+}
+
+func systemMemoryEvents() (source.MemoryEvents, error) {
+	file, err := os.Open("/proc/vmstat")
+	if err != nil {
+		return source.MemoryEvents{}, err
+	}
+	defer file.Close()
+
+	kvs, err := parser.ParseKeyValueFile(file)
+	if err != nil {
+		return source.MemoryEvents{}, err
+	}
+
+	oomKills, ok := kvs["oom_kill"]
+	if !ok {
+		return source.MemoryEvents{}, fmt.Errorf("%w: missing oom_kill field", parser.ErrInvalidFormat)
+	}
+
+	return source.MemoryEvents{OOMKills: oomKills}, nil
+}
+
+func readKernelLog() (io.ReadCloser, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, "dmesg").Output()
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(output)), nil
+}
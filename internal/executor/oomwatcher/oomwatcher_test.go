@@ -0,0 +1,62 @@
+package oomwatcher
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+const kernelLog = `[  100.000000] java invoked oom-killer: gfp_mask=0xcc0(GFP_KERNEL), order=0, oom_score_adj=0
+[  100.000001] Memory cgroup out of memory: Killed process 1234 (java) total-vm:4194304kB, anon-rss:2097152kB
+[  200.000000] Out of memory: Killed process 5678 (node) total-vm:1048576kB, anon-rss:524288kB
+[  300.000000] Killed process 91011 (gradle daemon) total-vm:1048576kB, anon-rss:524288kB
+`
+
+func TestParseKernelLog(t *testing.T) {
+	processes, err := ParseKernelLog(strings.NewReader(kernelLog))
+	require.NoError(t, err)
+	require.Equal(t, []Process{
+		{PID: 1234, Name: "java"},
+		{PID: 5678, Name: "node"},
+		{PID: 91011, Name: "gradle daemon"},
+	}, processes)
+}
+
+func TestCheck(t *testing.T) {
+	events := source.MemoryEvents{}
+
+	watcher := &Watcher{
+		events: func() (source.MemoryEvents, error) {
+			return events, nil
+		},
+		kernelLog: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(kernelLog)), nil
+		},
+	}
+	require.NoError(t, watcher.Snapshot())
+
+	// Nothing happened
+	report, err := watcher.Check()
+	require.NoError(t, err)
+	require.Nil(t, report)
+
+	// Memory pressure without kills
+	events.LimitHits = 3
+	report, err = watcher.Check()
+	require.NoError(t, err)
+	require.Equal(t, &Report{LimitHits: 3}, report)
+
+	// Only the latest kills are attributed
+	events.OOMKills = 2
+	report, err = watcher.Check()
+	require.NoError(t, err)
+	require.Equal(t, &Report{
+		OOMKills: 2,
+		Processes: []Process{
+			{PID: 5678, Name: "node"},
+			{PID: 91011, Name: "gradle daemon"},
+		},
+	}, report)
+}
@@ -0,0 +1,8 @@
+//go:build !linux
+// +build !linux
+
+package oomwatcher
+
+func New() (*Watcher, error) {
+	return nil, ErrUnsupported
+}
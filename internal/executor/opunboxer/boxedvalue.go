@@ -0,0 +1,55 @@
+package opunboxer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type BoxedValue struct {
+	vault string
+	item  string
+	field string
+}
+
+const (
+	prefix = "OP["
+	suffix = "]"
+)
+
+var (
+	ErrNotABoxedValue    = errors.New("doesn't look like a 1Password-boxed value")
+	ErrInvalidBoxedValue = errors.New("1Password-boxed value has an invalid format")
+)
+
+func NewBoxedValue(rawBoxedValue string) (*BoxedValue, error) {
+	if !strings.HasPrefix(rawBoxedValue, prefix) || !strings.HasSuffix(rawBoxedValue, suffix) {
+		return nil, ErrNotABoxedValue
+	}
+
+	rawBoxedValue = strings.TrimPrefix(rawBoxedValue, prefix)
+	rawBoxedValue = strings.TrimSuffix(rawBoxedValue, suffix)
+
+	parts := strings.Split(rawBoxedValue, "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: there should be 3 elements (vault, item and field) "+
+			"separated by slashes, found %d", ErrInvalidBoxedValue, len(parts))
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("%w: found an empty element", ErrInvalidBoxedValue)
+		}
+	}
+
+	return &BoxedValue{
+		vault: parts[0],
+		item:  parts[1],
+		field: parts[2],
+	}, nil
+}
+
+// Reference returns the secret reference understood by the 1Password CLI.
+func (boxedValue *BoxedValue) Reference() string {
+	return fmt.Sprintf("op://%s/%s/%s", boxedValue.vault, boxedValue.item, boxedValue.field)
+}
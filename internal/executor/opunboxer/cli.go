@@ -0,0 +1,37 @@
+package opunboxer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// cliResolver resolves the secrets using the 1Password CLI authenticated with a service account token.
+type cliResolver struct {
+	token string
+}
+
+func newCLIResolver(token string) *cliResolver {
+	return &cliResolver{
+		token: token,
+	}
+}
+
+func (resolver *cliResolver) Resolve(ctx context.Context, selector *BoxedValue) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "op", "read", "--no-newline", selector.Reference())
+	cmd.Env = append(os.Environ(), EnvOPServiceAccountToken+"="+resolver.token)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("1Password CLI failed to read %s: %w: %s", selector.Reference(), err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
@@ -0,0 +1,106 @@
+package opunboxer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// connectResolver resolves the secrets using the 1Password Connect server API[1].
+//
+// [1]: https://developer.1password.com/docs/connect/connect-api-reference/
+type connectResolver struct {
+	httpClient *http.Client
+	host       string
+	token      string
+}
+
+func newConnectResolver(host string, token string) *connectResolver {
+	return &connectResolver{
+		httpClient: &http.Client{Timeout: time.Minute},
+		host:       strings.TrimSuffix(host, "/"),
+		token:      token,
+	}
+}
+
+func (resolver *connectResolver) Resolve(ctx context.Context, selector *BoxedValue) (string, error) {
+	vaultID, err := resolver.lookupID(ctx, "/v1/vaults", "name", selector.vault)
+	if err != nil {
+		return "", fmt.Errorf("failed to find 1Password vault %s: %w", selector.vault, err)
+	}
+
+	itemsPath := fmt.Sprintf("/v1/vaults/%s/items", url.PathEscape(vaultID))
+
+	itemID, err := resolver.lookupID(ctx, itemsPath, "title", selector.item)
+	if err != nil {
+		return "", fmt.Errorf("failed to find 1Password item %s: %w", selector.item, err)
+	}
+
+	var item struct {
+		Fields []struct {
+			ID    string `json:"id"`
+			Label string `json:"label"`
+			Value string `json:"value"`
+		} `json:"fields"`
+	}
+
+	if err := resolver.get(ctx, itemsPath+"/"+url.PathEscape(itemID), &item); err != nil {
+		return "", fmt.Errorf("failed to retrieve 1Password item %s: %w", selector.item, err)
+	}
+
+	for _, field := range item.Fields {
+		if field.Label == selector.field || field.ID == selector.field {
+			return field.Value, nil
+		}
+	}
+
+	return "", fmt.Errorf("1Password item %s has no field %s", selector.item, selector.field)
+}
+
+// lookupID finds the object by its name and falls back to treating the name as an ID.
+func (resolver *connectResolver) lookupID(ctx context.Context, path string, attribute string, name string) (string, error) {
+	var objects []struct {
+		ID string `json:"id"`
+	}
+
+	query := url.Values{
+		"filter": {fmt.Sprintf("%s eq %q", attribute, name)},
+	}
+
+	if err := resolver.get(ctx, path+"?"+query.Encode(), &objects); err != nil {
+		return "", err
+	}
+
+	if len(objects) == 0 {
+		return name, nil
+	}
+
+	return objects[0].ID, nil
+}
+
+func (resolver *connectResolver) get(ctx context.Context, path string, result interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, resolver.host+path, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+resolver.token)
+
+	response, err := resolver.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+
+		return fmt.Errorf("bad response status from 1Password Connect %d: %s", response.StatusCode, body)
+	}
+
+	return json.NewDecoder(response.Body).Decode(result)
+}
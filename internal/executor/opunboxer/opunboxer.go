@@ -0,0 +1,72 @@
+package opunboxer
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+)
+
+const (
+	EnvOPConnectHost         = "OP_CONNECT_HOST"
+	EnvOPConnectToken        = "OP_CONNECT_TOKEN"
+	EnvOPServiceAccountToken = "OP_SERVICE_ACCOUNT_TOKEN"
+)
+
+var ErrNoCredentials = fmt.Errorf("found 1Password-protected environment variables, but neither "+
+	"%s and %s nor %s variables were provided", EnvOPConnectHost, EnvOPConnectToken, EnvOPServiceAccountToken)
+
+type resolver interface {
+	Resolve(ctx context.Context, selector *BoxedValue) (string, error)
+}
+
+type OPUnboxer struct {
+	resolver resolver
+
+	cache map[string]string
+}
+
+// NewFromEnvironment prefers the 1Password Connect server and falls back to the 1Password CLI
+// authenticated with a service account token, since the latter has no public HTTP API.
+func NewFromEnvironment(env *environment.Environment) (*OPUnboxer, error) {
+	lookup := func(key string) string {
+		if value, ok := env.Lookup(key); ok {
+			return value
+		}
+
+		return os.Getenv(key)
+	}
+
+	if host, token := lookup(EnvOPConnectHost), lookup(EnvOPConnectToken); host != "" && token != "" {
+		return newUnboxer(newConnectResolver(host, token)), nil
+	}
+
+	if token := lookup(EnvOPServiceAccountToken); token != "" {
+		return newUnboxer(newCLIResolver(token)), nil
+	}
+
+	return nil, ErrNoCredentials
+}
+
+func newUnboxer(resolver resolver) *OPUnboxer {
+	return &OPUnboxer{
+		resolver: resolver,
+		cache:    map[string]string{},
+	}
+}
+
+func (unboxer *OPUnboxer) Unbox(ctx context.Context, selector *BoxedValue) (string, error) {
+	if value, ok := unboxer.cache[selector.Reference()]; ok {
+		return value, nil
+	}
+
+	value, err := unboxer.resolver.Resolve(ctx, selector)
+	if err != nil {
+		return "", err
+	}
+
+	unboxer.cache[selector.Reference()] = value
+
+	return value, nil
+}
@@ -0,0 +1,77 @@
+package opunboxer_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/opunboxer"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBoxedValues(t *testing.T) {
+	_, err := opunboxer.NewBoxedValue("VAULT[secret/data/keys data.admin]")
+	require.ErrorIs(t, err, opunboxer.ErrNotABoxedValue)
+
+	_, err = opunboxer.NewBoxedValue("OP[vault/item]")
+	require.ErrorIs(t, err, opunboxer.ErrInvalidBoxedValue)
+
+	_, err = opunboxer.NewBoxedValue("OP[vault//field]")
+	require.ErrorIs(t, err, opunboxer.ErrInvalidBoxedValue)
+
+	boxedValue, err := opunboxer.NewBoxedValue("OP[CI/Deploy Key/password]")
+	require.NoError(t, err)
+	require.Equal(t, "op://CI/Deploy Key/password", boxedValue.Reference())
+}
+
+func TestNoCredentials(t *testing.T) {
+	t.Setenv("OP_CONNECT_HOST", "")
+	t.Setenv("OP_CONNECT_TOKEN", "")
+	t.Setenv("OP_SERVICE_ACCOUNT_TOKEN", "")
+
+	_, err := opunboxer.NewFromEnvironment(environment.New(map[string]string{}))
+	require.ErrorIs(t, err, opunboxer.ErrNoCredentials)
+}
+
+func TestConnect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+
+		switch request.URL.Path {
+		case "/v1/vaults":
+			require.Equal(t, `name eq "CI"`, request.URL.Query().Get("filter"))
+			fmt.Fprint(writer, `[{"id":"vault-id"}]`)
+		case "/v1/vaults/vault-id/items":
+			require.Equal(t, `title eq "Deploy Key"`, request.URL.Query().Get("filter"))
+			fmt.Fprint(writer, `[{"id":"item-id"}]`)
+		case "/v1/vaults/vault-id/items/item-id":
+			fmt.Fprint(writer, `{"fields":[{"id":"username","label":"username","value":"deployer"},`+
+				`{"id":"password","label":"password","value":"secret value"}]}`)
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	unboxer, err := opunboxer.NewFromEnvironment(environment.New(map[string]string{
+		"OP_CONNECT_HOST":  server.URL,
+		"OP_CONNECT_TOKEN": "token",
+	}))
+	require.NoError(t, err)
+
+	boxedValue, err := opunboxer.NewBoxedValue("OP[CI/Deploy Key/password]")
+	require.NoError(t, err)
+
+	value, err := unboxer.Unbox(context.Background(), boxedValue)
+	require.NoError(t, err)
+	require.Equal(t, "secret value", value)
+
+	// Non-existent field
+	boxedValue, err = opunboxer.NewBoxedValue("OP[CI/Deploy Key/otp]")
+	require.NoError(t, err)
+
+	_, err = unboxer.Unbox(context.Background(), boxedValue)
+	require.Error(t, err)
+}
@@ -0,0 +1,190 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/plugin"
+	"google.golang.org/protobuf/encoding/protowire"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvCirrusPluginsDir is a list of the directories (separated like in the PATH)
+	// to look for the plugins in before falling back to the PATH.
+	EnvCirrusPluginsDir = "CIRRUS_PLUGINS_DIR"
+
+	// PropertyPlugin is the command's property that names the plugin
+	// implementing the instruction, the other properties are passed
+	// to the plugin as its configuration.
+	PropertyPlugin = "plugin"
+)
+
+// builtinPlugins are the plugins that ship with the agent, they take precedence over the plugin binaries.
+var builtinPlugins = map[string]func(*Executor, context.Context, *LogUploader, *api.Command) bool{
+	PluginInstallTools:    (*Executor).installTools,
+	PluginNixDevelop:      (*Executor).enterNixDevelop,
+	PluginScreenRecording: (*Executor).configureScreenRecording,
+}
+
+// pluginInstruction returns the name of the plugin that should execute the command whose instruction
+// is unknown to this agent and the raw encoding of that instruction (if any). Unless explicitly named
+// via the PropertyPlugin, the plugin for the unknown instruction with the field number N is called
+// "instruction-N".
+func pluginInstruction(command *api.Command) (string, []byte, bool) {
+	if command.Instruction != nil {
+		return "", nil, false
+	}
+
+	number, raw := unknownInstruction(command.ProtoReflect().GetUnknown())
+
+	if name := command.Properties[PropertyPlugin]; name != "" {
+		return name, raw, true
+	}
+
+	if raw == nil {
+		return "", nil, false
+	}
+
+	return fmt.Sprintf("instruction-%d", number), raw, true
+}
+
+// unknownInstruction finds the first length-delimited (and thus potentially
+// a message) field among the unknown fields.
+func unknownInstruction(unknown []byte) (protowire.Number, []byte) {
+	for len(unknown) > 0 {
+		number, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			return 0, nil
+		}
+		unknown = unknown[n:]
+
+		if typ == protowire.BytesType {
+			value, m := protowire.ConsumeBytes(unknown)
+			if m < 0 {
+				return 0, nil
+			}
+
+			return number, value
+		}
+
+		m := protowire.ConsumeFieldValue(number, typ, unknown)
+		if m < 0 {
+			return 0, nil
+		}
+		unknown = unknown[m:]
+	}
+
+	return 0, nil
+}
+
+func (executor *Executor) runPlugin(
+	ctx context.Context,
+	logUploader *LogUploader,
+	command *api.Command,
+	name string,
+	rawInstruction []byte,
+) bool {
+	if builtinPlugin, ok := builtinPlugins[name]; ok {
+		return builtinPlugin(executor, ctx, logUploader, command)
+	}
+
+	path, err := plugin.Find(name, filepath.SplitList(executor.env.Get(EnvCirrusPluginsDir)))
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "Failed to find the plugin for the instruction: %v\n", err)
+
+		return false
+	}
+
+	log.Printf("Executing %s using the plugin %s...", command.Name, path)
+
+	config := map[string]string{}
+	for key, value := range command.Properties {
+		if key != PropertyPlugin {
+			config[key] = executor.env.ExpandText(value)
+		}
+	}
+
+	env := map[string]string{}
+	for _, keyValue := range os.Environ() {
+		if parts := strings.SplitN(keyValue, "=", 2); len(parts) == 2 {
+			env[parts[0]] = parts[1]
+		}
+	}
+	for key, value := range executor.env.Items() {
+		env[key] = value
+	}
+
+	workingDir := executor.env.Get("CIRRUS_WORKING_DIR")
+
+	host := &pluginHost{
+		executor:    executor,
+		logUploader: logUploader,
+		workingDir:  workingDir,
+	}
+
+	result, err := plugin.Run(ctx, path, &plugin.Request{
+		Command:     command.Name,
+		Plugin:      name,
+		Config:      config,
+		Instruction: rawInstruction,
+		Env:         env,
+		WorkingDir:  workingDir,
+	}, host)
+	if err != nil {
+		host.Log([]byte(fmt.Sprintf("Plugin %s failed: %v\n", name, err)))
+
+		return false
+	}
+
+	if result.Message != "" {
+		host.Log([]byte(result.Message + "\n"))
+	}
+
+	return result.Success
+}
+
+// pluginHost implements plugin.Host.
+type pluginHost struct {
+	executor    *Executor
+	logUploader *LogUploader
+	workingDir  string
+
+	// logMtx serializes the writes coming from the plugin's standard error and its log messages
+	logMtx sync.Mutex
+}
+
+func (host *pluginHost) Log(data []byte) {
+	host.logMtx.Lock()
+	defer host.logMtx.Unlock()
+
+	_, _ = host.logUploader.Write(data)
+}
+
+func (host *pluginHost) SetEnv(name string, value string, sensitive bool) {
+	host.executor.env.Set(name, value)
+
+	if sensitive {
+		host.executor.env.AddSensitiveValues(value)
+	}
+}
+
+func (host *pluginHost) DownloadCache(ctx context.Context, key string, path string) (bool, error) {
+	return host.executor.downloadCacheFile(ctx, key, host.resolvePath(path))
+}
+
+func (host *pluginHost) UploadCache(ctx context.Context, key string, path string) error {
+	return host.executor.uploadCacheFile(ctx, key, host.resolvePath(path))
+}
+
+func (host *pluginHost) resolvePath(path string) string {
+	if filepath.IsAbs(path) || host.workingDir == "" {
+		return path
+	}
+
+	return filepath.Join(host.workingDir, path)
+}
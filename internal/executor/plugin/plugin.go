@@ -0,0 +1,154 @@
+// Package plugin runs the custom instructions implemented by the external binaries,
+// which lets the teams extend the agent without forking it.
+//
+// A plugin named "foo" is an executable called "cirrus-plugin-foo" (with an ".exe" extension
+// on Windows) that's found either in one of the plugin directories or in the PATH.
+//
+// Alternatively, the plugin can be a WebAssembly (WASI) module called "cirrus-plugin-foo.wasm"
+// placed in one of the plugin directories. Such plugins are executed in the embedded runtime that
+// only gives them access to the working directory, which makes it safe to distribute them.
+//
+// The agent and the plugin communicate over the plugin's standard input and output
+// using the newline-delimited JSON messages:
+//
+//  1. agent sends the Request
+//  2. plugin sends any number of the Message's, the "cache_download" and "cache_upload"
+//     ones are answered by the agent with a Response carrying the same ID
+//  3. plugin sends the "result" Message and exits
+//
+// Anything the plugin writes to its standard error is appended to the instruction's logs.
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+)
+
+const (
+	ProtocolVersion = 1
+
+	BinaryPrefix = "cirrus-plugin-"
+)
+
+const (
+	TypeInvoke   = "invoke"
+	TypeResponse = "response"
+
+	// TypeLog appends the Message's Message to the instruction's logs
+	TypeLog = "log"
+
+	// TypeEnv sets the Name environment variable to the Value for the subsequent instructions
+	TypeEnv = "env"
+
+	// TypeCacheDownload downloads the cache entry with the Key to the Path
+	TypeCacheDownload = "cache_download"
+
+	// TypeCacheUpload uploads the file at the Path as the cache entry with the Key
+	TypeCacheUpload = "cache_upload"
+
+	// TypeResult finishes the instruction with the Success status and an optional Message
+	TypeResult = "result"
+)
+
+var (
+	ErrNotFound    = errors.New("plugin not found")
+	ErrInvalidName = errors.New("invalid plugin name")
+	ErrProtocol    = errors.New("plugin protocol violation")
+	ErrNoResult    = errors.New("plugin exited without reporting the result")
+
+	nameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+)
+
+// Request is the first (and only unsolicited) message sent to the plugin.
+type Request struct {
+	Type            string `json:"type"`
+	ProtocolVersion int    `json:"protocol_version"`
+
+	// Command is the name of the instruction being executed
+	Command string `json:"command"`
+
+	// Plugin is the name under which the plugin was invoked
+	Plugin string `json:"plugin"`
+
+	// Config is the instruction's configuration
+	Config map[string]string `json:"config,omitempty"`
+
+	// Instruction is the raw Protocol Buffers encoding of the instruction
+	// this agent doesn't know about (if that's why the plugin was invoked)
+	Instruction []byte `json:"instruction,omitempty"`
+
+	Env        map[string]string `json:"env"`
+	WorkingDir string            `json:"working_dir"`
+}
+
+// Message is sent by the plugin, see the Type* constants for the meaning of the fields.
+type Message struct {
+	Type string `json:"type"`
+	ID   int64  `json:"id,omitempty"`
+
+	Message string `json:"message,omitempty"`
+
+	Name      string `json:"name,omitempty"`
+	Value     string `json:"value,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+
+	Key  string `json:"key,omitempty"`
+	Path string `json:"path,omitempty"`
+
+	Success bool `json:"success,omitempty"`
+}
+
+// Response answers the plugin's Message with the same ID.
+type Response struct {
+	Type    string `json:"type"`
+	ID      int64  `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+
+	// Hit is only set for the TypeCacheDownload
+	Hit bool `json:"hit,omitempty"`
+}
+
+// Find locates the plugin's binary in the specified directories, falling back to the PATH.
+func Find(name string, dirs []string) (string, error) {
+	if !nameRegex.MatchString(name) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+
+	binary := BinaryPrefix + name
+	if runtime.GOOS == "windows" {
+		binary += ".exe"
+	}
+
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		path := filepath.Join(dir, binary)
+
+		info, err := os.Stat(path)
+		if err == nil && !info.IsDir() && (runtime.GOOS == "windows" || info.Mode().Perm()&0111 != 0) {
+			return path, nil
+		}
+
+		// WebAssembly plugins are portable, so there's no ".exe" extension on Windows
+		wasmPath := filepath.Join(dir, BinaryPrefix+name+WASMExtension)
+
+		if info, err := os.Stat(wasmPath); err == nil && !info.IsDir() {
+			return wasmPath, nil
+		}
+	}
+
+	if path, err := exec.LookPath(binary); err == nil {
+		return path, nil
+	}
+
+	return "", fmt.Errorf("%w: no %s (or %s%s) in the plugin directories or the PATH", ErrNotFound,
+		binary, BinaryPrefix+name, WASMExtension)
+}
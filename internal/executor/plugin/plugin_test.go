@@ -0,0 +1,220 @@
+package plugin_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testModeEnv = "CIRRUS_PLUGIN_TEST_MODE"
+
+// TestMain lets the test binary act as a plugin when invoked by plugin.Run.
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(testModeEnv); mode != "" {
+		os.Exit(fakePlugin(mode))
+	}
+
+	os.Exit(m.Run())
+}
+
+func fakePlugin(mode string) int {
+	reader := bufio.NewReader(os.Stdin)
+	encoder := json.NewEncoder(os.Stdout)
+
+	var request plugin.Request
+	line, _ := reader.ReadBytes('\n')
+	if err := json.Unmarshal(line, &request); err != nil {
+		return 1
+	}
+
+	switch mode {
+	case "success":
+		fmt.Fprintln(os.Stderr, "some diagnostics")
+		_ = encoder.Encode(&plugin.Message{Type: plugin.TypeLog, Message: "Hello from " + request.Config["greeting"]})
+		_ = encoder.Encode(&plugin.Message{Type: plugin.TypeEnv, Name: "PLUGIN_VAR", Value: "42"})
+		_ = encoder.Encode(&plugin.Message{Type: plugin.TypeCacheDownload, ID: 1, Key: "deps", Path: "deps.tar"})
+
+		var response plugin.Response
+		line, _ := reader.ReadBytes('\n')
+		if err := json.Unmarshal(line, &response); err != nil || response.ID != 1 || !response.Hit {
+			_ = encoder.Encode(&plugin.Message{Type: plugin.TypeResult, Message: "unexpected response"})
+			return 0
+		}
+
+		_ = encoder.Encode(&plugin.Message{Type: plugin.TypeResult, Success: true})
+	case "garbage":
+		fmt.Println("not a JSON")
+	case "silent":
+		// exit without reporting the result
+	}
+
+	return 0
+}
+
+type fakeHost struct {
+	// The plugin's standard error is logged concurrently with its messages
+	logsMtx sync.Mutex
+	logs    strings.Builder
+
+	env map[string]string
+}
+
+func (host *fakeHost) Log(data []byte) {
+	host.logsMtx.Lock()
+	defer host.logsMtx.Unlock()
+
+	host.logs.Write(data)
+}
+
+func (host *fakeHost) SetEnv(name string, value string, sensitive bool) {
+	host.env[name] = value
+}
+
+func (host *fakeHost) DownloadCache(ctx context.Context, key string, path string) (bool, error) {
+	return key == "deps", nil
+}
+
+func (host *fakeHost) UploadCache(ctx context.Context, key string, path string) error {
+	return nil
+}
+
+func runFakePlugin(t *testing.T, mode string) (*plugin.Result, *fakeHost, error) {
+	host := &fakeHost{env: map[string]string{}}
+
+	result, err := plugin.Run(context.Background(), os.Args[0], &plugin.Request{
+		Command:    "main",
+		Plugin:     "fake",
+		Config:     map[string]string{"greeting": "the plugin"},
+		Env:        map[string]string{testModeEnv: mode},
+		WorkingDir: t.TempDir(),
+	}, host)
+
+	return result, host, err
+}
+
+func TestRun(t *testing.T) {
+	result, host, err := runFakePlugin(t, "success")
+	require.NoError(t, err)
+
+	assert.True(t, result.Success, result.Message)
+	assert.Contains(t, host.logs.String(), "Hello from the plugin\n")
+	assert.Contains(t, host.logs.String(), "some diagnostics\n")
+	assert.Equal(t, map[string]string{"PLUGIN_VAR": "42"}, host.env)
+}
+
+func TestRunProtocolViolation(t *testing.T) {
+	_, _, err := runFakePlugin(t, "garbage")
+	assert.ErrorIs(t, err, plugin.ErrProtocol)
+}
+
+func TestRunNoResult(t *testing.T) {
+	_, _, err := runFakePlugin(t, "silent")
+	assert.ErrorIs(t, err, plugin.ErrNoResult)
+}
+
+// wasmModule assembles a WASI module that writes the output to its standard output and returns.
+func wasmModule(output string) []byte {
+	uleb128 := func(value int) []byte {
+		var result []byte
+
+		for {
+			b := byte(value & 0x7f)
+			value >>= 7
+
+			if value == 0 {
+				return append(result, b)
+			}
+
+			result = append(result, b|0x80)
+		}
+	}
+
+	section := func(id byte, content ...byte) []byte {
+		return append(append([]byte{id}, uleb128(len(content))...), content...)
+	}
+
+	name := func(s string) []byte {
+		return append(uleb128(len(s)), s...)
+	}
+
+	// The iovec pointing to the output at the offset 16, followed by the nwritten
+	data := []byte{16, 0, 0, 0, byte(len(output)), byte(len(output) >> 8), 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	data = append(data, output...)
+
+	var module []byte
+
+	module = append(module, 0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00)
+	// fd_write's (i32, i32, i32, i32) -> i32 and _start's () -> ()
+	module = append(module, section(1, 0x02, 0x60, 0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7f, 0x60, 0x00, 0x00)...)
+	module = append(module, section(2, append(append(append([]byte{0x01}, name("wasi_snapshot_preview1")...),
+		name("fd_write")...), 0x00, 0x00)...)...)
+	module = append(module, section(3, 0x01, 0x01)...)
+	module = append(module, section(5, 0x01, 0x00, 0x01)...)
+	module = append(module, section(7, append(append(append([]byte{0x02}, name("_start")...), 0x00, 0x01),
+		append(name("memory"), 0x02, 0x00)...)...)...)
+	// fd_write(1, 0, 1, 8) and drop the errno
+	body := []byte{0x00, 0x41, 0x01, 0x41, 0x00, 0x41, 0x01, 0x41, 0x08, 0x10, 0x00, 0x1a, 0x0b}
+	module = append(module, section(10, append(append([]byte{0x01}, uleb128(len(body))...), body...)...)...)
+	module = append(module, section(11, append(append([]byte{0x01, 0x00, 0x41, 0x00, 0x0b},
+		uleb128(len(data))...), data...)...)...)
+
+	return module
+}
+
+func TestRunWASM(t *testing.T) {
+	path := filepath.Join(t.TempDir(), plugin.BinaryPrefix+"hello"+plugin.WASMExtension)
+	require.NoError(t, os.WriteFile(path, wasmModule(`{"type":"log","message":"Hello from WebAssembly!"}`+"\n"+
+		`{"type":"result","success":true}`+"\n"), 0600))
+
+	host := &fakeHost{env: map[string]string{}}
+
+	result, err := plugin.Run(context.Background(), path, &plugin.Request{
+		Command:    "main",
+		Plugin:     "hello",
+		Env:        map[string]string{"SECRET": "not passed to the plugin's environment"},
+		WorkingDir: t.TempDir(),
+	}, host)
+	require.NoError(t, err)
+
+	assert.True(t, result.Success, result.Message)
+	assert.Equal(t, "Hello from WebAssembly!\n", host.logs.String())
+}
+
+func TestRunWASMInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), plugin.BinaryPrefix+"invalid"+plugin.WASMExtension)
+	require.NoError(t, os.WriteFile(path, []byte("\x00asm"), 0600))
+
+	_, err := plugin.Run(context.Background(), path, &plugin.Request{WorkingDir: t.TempDir()},
+		&fakeHost{env: map[string]string{}})
+	assert.Error(t, err)
+}
+
+func TestFind(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a Unix-style executable")
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, plugin.BinaryPrefix+"terraform")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"), 0700))
+
+	found, err := plugin.Find("terraform", []string{"", dir})
+	require.NoError(t, err)
+	assert.Equal(t, path, found)
+
+	_, err = plugin.Find("nonexistent", []string{dir})
+	assert.ErrorIs(t, err, plugin.ErrNotFound)
+
+	_, err = plugin.Find("../escape", []string{dir})
+	assert.ErrorIs(t, err, plugin.ErrInvalidName)
+}
@@ -0,0 +1,263 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+)
+
+// maxMessageSize limits the size of a single message sent by the plugin
+const maxMessageSize = 16 * 1024 * 1024
+
+// Host provides the agent's facilities to the plugin.
+type Host interface {
+	// Log appends the data to the instruction's logs
+	Log(data []byte)
+
+	// SetEnv sets the environment variable for the subsequent instructions
+	SetEnv(name string, value string, sensitive bool)
+
+	// DownloadCache downloads the cache entry to the path, returning false on a cache miss
+	DownloadCache(ctx context.Context, key string, path string) (bool, error)
+
+	// UploadCache uploads the file at the path as a cache entry
+	UploadCache(ctx context.Context, key string, path string) error
+}
+
+// Result is the outcome of the plugin's execution.
+type Result struct {
+	Success bool
+	Message string
+}
+
+// process is the running plugin, either a native executable or a WebAssembly module.
+type process interface {
+	Wait() error
+	Kill()
+}
+
+// Run executes the plugin at the path and serves its messages until it exits.
+func Run(ctx context.Context, path string, request *Request, host Host) (*Result, error) {
+	request.Type = TypeInvoke
+	request.ProtocolVersion = ProtocolVersion
+
+	var proc process
+	var stdin io.WriteCloser
+	var stdout io.Reader
+	var hostPath func(path string) (string, error)
+	var err error
+
+	if IsWASM(path) {
+		hostWorkingDir := request.WorkingDir
+		if hostWorkingDir == "" {
+			hostWorkingDir, err = os.Getwd()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		proc, stdin, stdout, err = startWASM(ctx, path, hostWorkingDir, logWriter{host: host})
+		if err != nil {
+			return nil, err
+		}
+
+		// The plugin only sees the working directory, mounted at a fixed location
+		request.WorkingDir = WASMWorkingDir
+		hostPath = func(path string) (string, error) {
+			return wasmHostPath(path, hostWorkingDir)
+		}
+	} else {
+		proc, stdin, stdout, err = startNative(ctx, path, request, logWriter{host: host})
+		if err != nil {
+			return nil, err
+		}
+
+		hostPath = func(path string) (string, error) {
+			return path, nil
+		}
+	}
+
+	result, err := serve(ctx, stdin, stdout, request, host, hostPath)
+	if err != nil {
+		proc.Kill()
+		_ = proc.Wait()
+
+		return nil, err
+	}
+
+	// Let the plugin know that no more responses will follow and drain
+	// the rest of its output so that it doesn't block on the pipe
+	_ = stdin.Close()
+	_, _ = io.Copy(io.Discard, stdout)
+
+	waitErr := proc.Wait()
+
+	if result == nil {
+		if waitErr != nil {
+			return nil, fmt.Errorf("%w: %v", ErrNoResult, waitErr)
+		}
+
+		return nil, ErrNoResult
+	}
+
+	// The plugin reported a success, but crashed afterwards
+	if waitErr != nil && result.Success {
+		return &Result{
+			Success: false,
+			Message: fmt.Sprintf("plugin reported a success, but then exited with an error: %v", waitErr),
+		}, nil
+	}
+
+	return result, nil
+}
+
+// nativeProcess is the plugin executable running as a child process.
+type nativeProcess struct {
+	cmd *exec.Cmd
+}
+
+func startNative(
+	ctx context.Context,
+	path string,
+	request *Request,
+	stderr io.Writer,
+) (*nativeProcess, io.WriteCloser, io.Reader, error) {
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Dir = request.WorkingDir
+	cmd.Env = envList(request.Env)
+	cmd.Stderr = stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return &nativeProcess{cmd: cmd}, stdin, stdout, nil
+}
+
+func (process *nativeProcess) Wait() error {
+	return process.cmd.Wait()
+}
+
+func (process *nativeProcess) Kill() {
+	_ = process.cmd.Process.Kill()
+}
+
+func serve(
+	ctx context.Context,
+	stdin io.Writer,
+	stdout io.Reader,
+	request *Request,
+	host Host,
+	hostPath func(path string) (string, error),
+) (*Result, error) {
+	encoder := json.NewEncoder(stdin)
+
+	if err := encoder.Encode(request); err != nil {
+		return nil, fmt.Errorf("failed to send the request to the plugin: %w", err)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageSize)
+
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var message Message
+
+		if err := json.Unmarshal(scanner.Bytes(), &message); err != nil {
+			return nil, fmt.Errorf("%w: failed to parse the message %q: %v", ErrProtocol, scanner.Text(), err)
+		}
+
+		switch message.Type {
+		case TypeLog:
+			host.Log([]byte(message.Message + "\n"))
+		case TypeEnv:
+			if message.Name == "" {
+				return nil, fmt.Errorf("%w: no variable name in the %q message", ErrProtocol, TypeEnv)
+			}
+
+			host.SetEnv(message.Name, message.Value, message.Sensitive)
+		case TypeCacheDownload, TypeCacheUpload:
+			path, err := hostPath(message.Path)
+			if err != nil {
+				return nil, err
+			}
+
+			var hit bool
+
+			if message.Type == TypeCacheDownload {
+				hit, err = host.DownloadCache(ctx, message.Key, path)
+			} else {
+				err = host.UploadCache(ctx, message.Key, path)
+			}
+
+			if err := encoder.Encode(newResponse(message.ID, err, hit)); err != nil {
+				return nil, err
+			}
+		case TypeResult:
+			return &Result{
+				Success: message.Success,
+				Message: message.Message,
+			}, nil
+		default:
+			return nil, fmt.Errorf("%w: unsupported message type %q", ErrProtocol, message.Type)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the plugin's output: %w", err)
+	}
+
+	return nil, nil
+}
+
+func newResponse(id int64, err error, hit bool) *Response {
+	response := &Response{
+		Type:    TypeResponse,
+		ID:      id,
+		Success: err == nil,
+		Hit:     hit,
+	}
+
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	return response
+}
+
+func envList(env map[string]string) []string {
+	var result []string
+
+	for key, value := range env {
+		result = append(result, key+"="+value)
+	}
+
+	return result
+}
+
+type logWriter struct {
+	host Host
+}
+
+func (writer logWriter) Write(p []byte) (int, error) {
+	writer.host.Log(p)
+
+	return len(p), nil
+}
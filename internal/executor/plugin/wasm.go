@@ -0,0 +1,173 @@
+package plugin
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// WASMExtension marks the plugins compiled to WebAssembly (WASI), which are executed
+	// in the embedded sandboxed runtime instead of being executed directly
+	WASMExtension = ".wasm"
+
+	// WASMWorkingDir is where the working directory is mounted in the WebAssembly
+	// plugin's filesystem, which is the only directory the plugin can access
+	WASMWorkingDir = "/work"
+)
+
+// IsWASM returns true if the plugin at the path should be executed in a WebAssembly runtime.
+func IsWASM(path string) bool {
+	return strings.HasSuffix(path, WASMExtension)
+}
+
+// wasmProcess is the WebAssembly plugin running in the embedded runtime.
+//
+// Its standard input and output are the OS pipes rather than the io.Pipe's, so that, just like
+// with the native plugins, the host and the plugin don't block each other on the unread messages.
+type wasmProcess struct {
+	cancel context.CancelFunc
+	pipes  []*os.File
+	done   chan struct{}
+	err    error
+}
+
+// startWASM runs the WebAssembly plugin in the embedded WASI runtime, returning the pipes
+// to its standard input and output.
+//
+// Everything the plugin can reach is configured explicitly: the only preopened directory is the
+// working directory mounted at WASMWorkingDir, the only argument is the plugin's name and, unlike
+// the native plugins, there are no environment variables besides the ones in the Request.
+// WASI has no sockets, so there's no network access either.
+func startWASM(
+	ctx context.Context,
+	pluginPath string,
+	hostWorkingDir string,
+	stderr io.Writer,
+) (*wasmProcess, io.WriteCloser, io.Reader, error) {
+	binary, err := os.ReadFile(pluginPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Make the cancellation interrupt the plugin's loops, not only the host calls
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().WithCloseOnContextDone(true))
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		_ = runtime.Close(ctx)
+		cancel()
+
+		return nil, nil, nil, err
+	}
+
+	compiled, err := runtime.CompileModule(ctx, binary)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		cancel()
+
+		return nil, nil, nil, fmt.Errorf("failed to compile the WebAssembly plugin: %w", err)
+	}
+
+	stdinReader, stdinWriter, err := os.Pipe()
+	if err != nil {
+		_ = runtime.Close(ctx)
+		cancel()
+
+		return nil, nil, nil, err
+	}
+
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		_ = stdinReader.Close()
+		_ = stdinWriter.Close()
+		_ = runtime.Close(ctx)
+		cancel()
+
+		return nil, nil, nil, err
+	}
+
+	config := wazero.NewModuleConfig().
+		WithName(filepath.Base(pluginPath)).
+		WithArgs(filepath.Base(pluginPath)).
+		WithStdin(stdinReader).
+		WithStdout(stdoutWriter).
+		WithStderr(stderr).
+		WithFSConfig(wazero.NewFSConfig().WithDirMount(hostWorkingDir, WASMWorkingDir)).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithRandSource(rand.Reader)
+
+	process := &wasmProcess{
+		cancel: cancel,
+		pipes:  []*os.File{stdinReader, stdinWriter, stdoutReader, stdoutWriter},
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(process.done)
+
+		_, err := runtime.InstantiateModule(ctx, compiled, config)
+
+		// The plugins written in the languages that always call proc_exit
+		var exitErr *sys.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 0 {
+			err = nil
+		}
+
+		process.err = err
+
+		// Let the host know that no more messages will follow
+		_ = stdoutWriter.Close()
+		_ = stdinReader.Close()
+		_ = runtime.Close(context.Background())
+		cancel()
+	}()
+
+	return process, stdinWriter, stdoutReader, nil
+}
+
+func (process *wasmProcess) Wait() error {
+	<-process.done
+
+	for _, pipe := range process.pipes {
+		_ = pipe.Close()
+	}
+
+	return process.err
+}
+
+// Kill stops the plugin, including the one blocked on reading
+// its standard input or writing its standard output.
+func (process *wasmProcess) Kill() {
+	process.cancel()
+
+	for _, pipe := range process.pipes {
+		_ = pipe.Close()
+	}
+}
+
+// wasmHostPath maps the path in the WebAssembly plugin's filesystem to the host's filesystem.
+func wasmHostPath(guestPath string, hostWorkingDir string) (string, error) {
+	if !path.IsAbs(guestPath) {
+		guestPath = path.Join(WASMWorkingDir, guestPath)
+	}
+
+	guestPath = path.Clean(guestPath)
+
+	if guestPath != WASMWorkingDir && !strings.HasPrefix(guestPath, WASMWorkingDir+"/") {
+		return "", fmt.Errorf("%w: path %q is outside of the %s", ErrProtocol, guestPath, WASMWorkingDir)
+	}
+
+	return filepath.Join(hostWorkingDir, filepath.FromSlash(strings.TrimPrefix(guestPath, WASMWorkingDir))), nil
+}
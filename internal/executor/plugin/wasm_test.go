@@ -0,0 +1,38 @@
+package plugin
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWASMHostPath(t *testing.T) {
+	hostWorkingDir := filepath.Join("/tmp", "cirrus-ci-build")
+
+	hostPath, err := wasmHostPath("/work/node_modules.tar", hostWorkingDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(hostWorkingDir, "node_modules.tar"), hostPath)
+
+	hostPath, err = wasmHostPath("deps/cache.tar", hostWorkingDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(hostWorkingDir, "deps", "cache.tar"), hostPath)
+
+	_, err = wasmHostPath("/work/../etc/passwd", hostWorkingDir)
+	assert.ErrorIs(t, err, ErrProtocol)
+
+	_, err = wasmHostPath("/workspace/file", hostWorkingDir)
+	assert.ErrorIs(t, err, ErrProtocol)
+}
+
+func TestFindWASM(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, BinaryPrefix+"lint"+WASMExtension)
+	require.NoError(t, os.WriteFile(path, []byte("\x00asm"), 0600))
+
+	found, err := Find("lint", []string{dir})
+	require.NoError(t, err)
+	assert.Equal(t, path, found)
+	assert.True(t, IsWASM(found))
+}
@@ -0,0 +1,49 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestPluginInstruction(t *testing.T) {
+	// Known instructions are never dispatched to the plugins
+	_, _, ok := pluginInstruction(&api.Command{
+		Name:        "main",
+		Instruction: &api.Command_ScriptInstruction{ScriptInstruction: &api.ScriptInstruction{}},
+		Properties:  map[string]string{PropertyPlugin: "terraform"},
+	})
+	require.False(t, ok)
+
+	// Explicitly named plugin
+	name, raw, ok := pluginInstruction(&api.Command{
+		Name:       "deploy",
+		Properties: map[string]string{PropertyPlugin: "terraform"},
+	})
+	require.True(t, ok)
+	require.Equal(t, "terraform", name)
+	require.Nil(t, raw)
+
+	// Instruction from the future, as seen by this agent
+	var encoded []byte
+	encoded = protowire.AppendTag(encoded, 1, protowire.BytesType)
+	encoded = protowire.AppendString(encoded, "deploy")
+	encoded = protowire.AppendTag(encoded, 10, protowire.VarintType)
+	encoded = protowire.AppendVarint(encoded, 2)
+	encoded = protowire.AppendTag(encoded, 42, protowire.BytesType)
+	encoded = protowire.AppendBytes(encoded, []byte{0x0a, 0x01, 0x78})
+
+	var command api.Command
+	require.NoError(t, proto.Unmarshal(encoded, &command))
+
+	name, raw, ok = pluginInstruction(&command)
+	require.True(t, ok)
+	require.Equal(t, "instruction-42", name)
+	require.Equal(t, []byte{0x0a, 0x01, 0x78}, raw)
+
+	// Nothing to dispatch
+	_, _, ok = pluginInstruction(&api.Command{Name: "empty"})
+	require.False(t, ok)
+}
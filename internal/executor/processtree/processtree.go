@@ -0,0 +1,172 @@
+package processtree
+
+import (
+	"context"
+	"github.com/shirou/gopsutil/process"
+	"sort"
+	"sync"
+)
+
+// Consumer is a process from the tree along with the resources it has consumed.
+type Consumer struct {
+	PID  int32
+	Name string
+
+	// MaxRSS is the peak resident set size observed in bytes
+	MaxRSS uint64
+
+	// CPUSeconds is the CPU time (user and system) consumed by the process
+	CPUSeconds float64
+}
+
+// Sampler periodically walks the process tree rooted at the command's shell
+// to attribute the CPU and memory usage to the individual processes.
+type Sampler struct {
+	root int32
+
+	mtx       sync.Mutex
+	consumers map[int32]*Consumer
+}
+
+func New(root int32) *Sampler {
+	return &Sampler{
+		root:      root,
+		consumers: map[int32]*Consumer{},
+	}
+}
+
+// Sample takes a snapshot of the process tree, processes that have
+// already exited keep the resource usage recorded in previous samples.
+func (sampler *Sampler) Sample(ctx context.Context) error {
+	processes, err := process.ProcessesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	byPID := map[int32]*process.Process{}
+	parents := map[int32]int32{}
+
+	for _, p := range processes {
+		ppid, err := p.PpidWithContext(ctx)
+		if err != nil {
+			continue
+		}
+
+		byPID[p.Pid] = p
+		parents[p.Pid] = ppid
+	}
+
+	for _, pid := range Descendants(sampler.root, parents) {
+		p, ok := byPID[pid]
+		if !ok {
+			continue
+		}
+
+		name, err := p.NameWithContext(ctx)
+		if err != nil {
+			// The process has probably exited
+			continue
+		}
+
+		var rss uint64
+		if memoryInfo, err := p.MemoryInfoWithContext(ctx); err == nil {
+			rss = memoryInfo.RSS
+		}
+
+		var cpuSeconds float64
+		if times, err := p.TimesWithContext(ctx); err == nil {
+			cpuSeconds = times.User + times.System
+		}
+
+		sampler.record(Consumer{PID: pid, Name: name, MaxRSS: rss, CPUSeconds: cpuSeconds})
+	}
+
+	return nil
+}
+
+func (sampler *Sampler) record(sample Consumer) {
+	sampler.mtx.Lock()
+	defer sampler.mtx.Unlock()
+
+	consumer, ok := sampler.consumers[sample.PID]
+	if !ok || consumer.Name != sample.Name {
+		sampler.consumers[sample.PID] = &sample
+
+		return
+	}
+
+	if sample.MaxRSS > consumer.MaxRSS {
+		consumer.MaxRSS = sample.MaxRSS
+	}
+	if sample.CPUSeconds > consumer.CPUSeconds {
+		consumer.CPUSeconds = sample.CPUSeconds
+	}
+}
+
+// TopByMemory returns up to n processes with the highest peak memory usage.
+func (sampler *Sampler) TopByMemory(n int) []Consumer {
+	return sampler.top(n, func(a, b Consumer) bool {
+		return a.MaxRSS > b.MaxRSS
+	})
+}
+
+// TopByCPU returns up to n processes that have consumed the most CPU time.
+func (sampler *Sampler) TopByCPU(n int) []Consumer {
+	return sampler.top(n, func(a, b Consumer) bool {
+		return a.CPUSeconds > b.CPUSeconds
+	})
+}
+
+func (sampler *Sampler) top(n int, less func(a, b Consumer) bool) []Consumer {
+	sampler.mtx.Lock()
+	defer sampler.mtx.Unlock()
+
+	result := make([]Consumer, 0, len(sampler.consumers))
+
+	for _, consumer := range sampler.consumers {
+		result = append(result, *consumer)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if less(result[i], result[j]) == less(result[j], result[i]) {
+			return result[i].PID < result[j].PID
+		}
+
+		return less(result[i], result[j])
+	})
+
+	if len(result) > n {
+		result = result[:n]
+	}
+
+	return result
+}
+
+// Descendants returns the root and all of its descendants given the PID to parent PID mapping.
+func Descendants(root int32, parents map[int32]int32) []int32 {
+	children := map[int32][]int32{}
+
+	for pid, ppid := range parents {
+		if pid == ppid {
+			continue
+		}
+
+		children[ppid] = append(children[ppid], pid)
+	}
+
+	result := []int32{root}
+	visited := map[int32]bool{root: true}
+
+	for i := 0; i < len(result); i++ {
+		for _, child := range children[result[i]] {
+			if visited[child] {
+				continue
+			}
+
+			visited[child] = true
+			result = append(result, child)
+		}
+	}
+
+	return result
+}
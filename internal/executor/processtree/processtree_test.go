@@ -0,0 +1,41 @@
+package processtree
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDescendants(t *testing.T) {
+	parents := map[int32]int32{
+		1:  0,
+		10: 1,
+		11: 10,
+		12: 10,
+		13: 12,
+		20: 1,
+		21: 20,
+	}
+
+	require.ElementsMatch(t, []int32{10, 11, 12, 13}, Descendants(10, parents))
+	require.Equal(t, []int32{42}, Descendants(42, parents))
+}
+
+func TestTop(t *testing.T) {
+	sampler := New(1)
+
+	sampler.record(Consumer{PID: 1, Name: "bash", MaxRSS: 4 << 20, CPUSeconds: 0.1})
+	sampler.record(Consumer{PID: 2, Name: "java", MaxRSS: 2 << 30, CPUSeconds: 10})
+	sampler.record(Consumer{PID: 3, Name: "node", MaxRSS: 512 << 20, CPUSeconds: 30})
+
+	// Peaks are preserved across the samples
+	sampler.record(Consumer{PID: 2, Name: "java", MaxRSS: 1 << 30, CPUSeconds: 20})
+
+	require.Equal(t, []Consumer{
+		{PID: 2, Name: "java", MaxRSS: 2 << 30, CPUSeconds: 20},
+		{PID: 3, Name: "node", MaxRSS: 512 << 20, CPUSeconds: 30},
+	}, sampler.TopByMemory(2))
+
+	require.Equal(t, []Consumer{
+		{PID: 3, Name: "node", MaxRSS: 512 << 20, CPUSeconds: 30},
+	}, sampler.TopByCPU(1))
+}
@@ -0,0 +1,44 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/cirrusenv"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/updatebatcher"
+	"time"
+)
+
+const progressInterval = 10 * time.Second
+
+// watchProgress forwards the progress reported by the script via the CIRRUS_ENV file
+// (e.g. "echo CIRRUS_PROGRESS=42 >> $CIRRUS_ENV") as intermediate command updates.
+func (executor *Executor) watchProgress(
+	ctx context.Context,
+	commandName string,
+	cirrusEnv *cirrusenv.CirrusEnv,
+	start time.Time,
+	interval time.Duration,
+) {
+	if executor.updateBatcher == nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastProgress := -1
+
+	for {
+		select {
+		case <-ticker.C:
+			progress, found, err := cirrusEnv.Progress()
+			if err != nil || !found || progress == lastProgress {
+				continue
+			}
+			lastProgress = progress
+
+			executor.updateBatcher.Queue(updatebatcher.NewProgressUpdate(commandName, time.Since(start), progress))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
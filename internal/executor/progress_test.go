@@ -0,0 +1,43 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/cirrusenv"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/updatebatcher"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWatchProgress(t *testing.T) {
+	cirrusEnv, err := cirrusenv.New(42)
+	require.NoError(t, err)
+	defer cirrusEnv.Close()
+
+	executor := &Executor{updateBatcher: updatebatcher.New()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		executor.watchProgress(ctx, "build", cirrusEnv, time.Now(), 10*time.Millisecond)
+		close(done)
+	}()
+
+	require.NoError(t, os.WriteFile(cirrusEnv.Path(), []byte("CIRRUS_PROGRESS=42\n"), 0600))
+
+	require.Eventually(t, func() bool {
+		return len(executor.updateBatcher.History()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Unchanged progress is not re-reported
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+
+	history := executor.updateBatcher.History()
+	require.Len(t, history, 1)
+
+	require.EqualValues(t, 42, history[0].Progress)
+}
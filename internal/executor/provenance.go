@@ -0,0 +1,227 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/provenance"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvCirrusProvenance set to "true" generates the SLSA provenance of the uploaded artifacts
+	// at the end of the task and uploads it as the "provenance" artifact, while "sign" also signs
+	// it with the task's CIRRUS_OIDC_TOKEN via the Sigstore keyless signing.
+	EnvCirrusProvenance = "CIRRUS_PROVENANCE"
+
+	EnvCirrusProvenanceBuilderID = "CIRRUS_PROVENANCE_BUILDER_ID"
+
+	provenanceModeSign = "sign"
+
+	// provenanceDir is relative to the CIRRUS_WORKING_DIR since the artifacts can only be uploaded from there.
+	provenanceDir = ".cirrus-provenance"
+)
+
+// provenanceEnvironment lists the non-sensitive variables that parametrize the task
+var provenanceEnvironment = []string{
+	"CIRRUS_BRANCH",
+	"CIRRUS_BUILD_ID",
+	"CIRRUS_PR",
+	"CIRRUS_TAG",
+	"CIRRUS_TASK_NAME",
+	"CIRRUS_OS",
+	"CIRRUS_ARCH",
+}
+
+func (executor *Executor) provenanceEnabled() bool {
+	mode := executor.env.Get(EnvCirrusProvenance)
+
+	return mode == "true" || mode == provenanceModeSign
+}
+
+// recordProvenanceSubjects remembers the digests of the uploaded artifact files to describe them in the provenance.
+func (executor *Executor) recordProvenanceSubjects(artifacts *Artifacts) {
+	if !executor.provenanceEnabled() {
+		return
+	}
+
+	for _, pattern := range artifacts.patterns {
+		for _, path := range pattern.Paths {
+			if path.info.IsDir() {
+				continue
+			}
+
+			digest := path.digest
+			if digest == "" {
+				var err error
+
+				digest, err = fileDigest(path.absolutePath)
+				if err != nil {
+					log.Printf("Failed to record the provenance of %s: %v", path.relativePath, err)
+
+					continue
+				}
+			}
+
+			executor.provenanceSubjects = append(executor.provenanceSubjects, provenance.Subject{
+				Name:   path.relativePath,
+				Digest: map[string]string{"sha256": digest},
+			})
+		}
+	}
+}
+
+// uploadProvenance generates the provenance of the artifacts uploaded by the executed commands,
+// signs it (if requested) and uploads it as the artifact of its own.
+func (executor *Executor) uploadProvenance(
+	ctx context.Context,
+	commands []*api.Command,
+	results []*api.CommandResult,
+	startedOn time.Time,
+) {
+	if !executor.provenanceEnabled() {
+		return
+	}
+
+	if err := executor.writeAndUploadProvenance(ctx, commands, results, startedOn); err != nil {
+		message := fmt.Sprintf("Failed to upload the provenance: %v", err)
+		log.Print(message)
+		executor.reportWarning(ctx, client.ProblemStorage, message)
+	}
+}
+
+func (executor *Executor) writeAndUploadProvenance(
+	ctx context.Context,
+	commands []*api.Command,
+	results []*api.CommandResult,
+	startedOn time.Time,
+) error {
+	statement := provenance.NewStatement(executor.provenanceBuild(commands, results, startedOn),
+		executor.provenanceSubjects)
+
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return err
+	}
+
+	outputDir := filepath.Join(executor.env.Get("CIRRUS_WORKING_DIR"), provenanceDir)
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return err
+	}
+
+	envelope := provenance.NewEnvelope(provenance.PayloadType, payload)
+
+	if executor.env.Get(EnvCirrusProvenance) == provenanceModeSign {
+		bundle, err := executor.signProvenance(ctx, payload)
+		if err != nil {
+			return err
+		}
+
+		if err := writeJSON(filepath.Join(outputDir, "provenance.sigstore.json"), bundle); err != nil {
+			return err
+		}
+
+		envelope = bundle.Envelope
+	}
+
+	if err := writeJSON(filepath.Join(outputDir, "provenance.intoto.jsonl"), envelope); err != nil {
+		return err
+	}
+
+	artifacts, err := NewArtifacts("provenance", &api.ArtifactsInstruction{
+		Paths: []string{provenanceDir + "/*"},
+	}, executor.env)
+	if err != nil {
+		return err
+	}
+
+	if err := executor.uploadArtifactFiles(ctx, log.Writer(), artifacts); err != nil {
+		return err
+	}
+
+	log.Printf("Uploaded the provenance of %d artifact files", len(statement.Subject))
+
+	return nil
+}
+
+func (executor *Executor) signProvenance(ctx context.Context, payload []byte) (*provenance.Bundle, error) {
+	identityToken, ok := executor.env.Lookup(EnvCirrusOIDCToken)
+	if !ok {
+		return nil, fmt.Errorf("%s is required to sign the provenance", EnvCirrusOIDCToken)
+	}
+
+	signer := &provenance.Signer{Sigstore: executor.sigstoreClient()}
+
+	return signer.Sign(ctx, provenance.PayloadType, payload, identityToken)
+}
+
+func (executor *Executor) provenanceBuild(
+	commands []*api.Command,
+	results []*api.CommandResult,
+	startedOn time.Time,
+) *provenance.Build {
+	statuses := map[string]api.Status{}
+	for _, result := range results {
+		statuses[result.Name] = result.Status
+	}
+
+	var steps []provenance.Step
+
+	for _, command := range commands {
+		status, ok := statuses[command.Name]
+		if !ok || status == api.Status_SKIPPED {
+			continue
+		}
+
+		step := provenance.Step{
+			Name:        command.Name,
+			Instruction: strings.TrimPrefix(fmt.Sprintf("%T", command.Instruction), "*api.Command_"),
+			Status:      strings.ToLower(status.String()),
+		}
+
+		switch instruction := command.Instruction.(type) {
+		case *api.Command_ScriptInstruction:
+			step.Scripts = instruction.ScriptInstruction.Scripts
+		case *api.Command_BackgroundScriptInstruction:
+			step.Scripts = instruction.BackgroundScriptInstruction.Scripts
+		}
+
+		steps = append(steps, step)
+	}
+
+	environment := map[string]string{}
+	for _, key := range provenanceEnvironment {
+		if value, ok := executor.env.Lookup(key); ok {
+			environment[key] = value
+		}
+	}
+
+	return &provenance.Build{
+		BuilderID:     executor.env.Get(EnvCirrusProvenanceBuilderID),
+		InvocationID:  "https://cirrus-ci.com/task/" + strconv.FormatInt(executor.taskIdentification.TaskId, 10),
+		RepositoryURL: executor.env.Get("CIRRUS_REPO_CLONE_URL"),
+		Commit:        executor.env.Get("CIRRUS_CHANGE_IN_REPO"),
+		ConfigPath:    ".cirrus.yml",
+		Steps:         steps,
+		StartedOn:     startedOn,
+		FinishedOn:    clockskew.Now(),
+		Environment:   environment,
+	}
+}
+
+func writeJSON(path string, value interface{}) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(content, '\n'), 0600)
+}
@@ -0,0 +1,161 @@
+// Package provenance generates the SLSA provenance (https://slsa.dev/provenance/v0.2) of the task's
+// artifacts in the form of an in-toto statement, optionally signed via the Sigstore keyless signing.
+package provenance
+
+import (
+	"time"
+)
+
+const (
+	StatementType = "https://in-toto.io/Statement/v0.1"
+	PredicateType = "https://slsa.dev/provenance/v0.2"
+	PayloadType   = "application/vnd.in-toto+json"
+
+	DefaultBuilderID = "https://cirrus-ci.com/agent"
+	BuildType        = "https://cirrus-ci.com/task/v1"
+)
+
+// Subject is an artifact file described by the provenance.
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Step is a command executed by the task.
+type Step struct {
+	Name        string   `json:"name"`
+	Instruction string   `json:"instruction"`
+	Scripts     []string `json:"scripts,omitempty"`
+	Status      string   `json:"status,omitempty"`
+}
+
+// Build describes the task that produced the artifacts.
+type Build struct {
+	BuilderID    string
+	InvocationID string
+
+	// RepositoryURL and Commit identify the source the task was run for
+	RepositoryURL string
+	Commit        string
+	ConfigPath    string
+
+	Steps      []Step
+	StartedOn  time.Time
+	FinishedOn time.Time
+
+	// Environment contains the non-sensitive parameters of the task (e.g. CIRRUS_BRANCH)
+	Environment map[string]string
+}
+
+type Statement struct {
+	Type          string     `json:"_type"`
+	PredicateType string     `json:"predicateType"`
+	Subject       []Subject  `json:"subject"`
+	Predicate     *Predicate `json:"predicate"`
+}
+
+type Predicate struct {
+	Builder     Builder     `json:"builder"`
+	BuildType   string      `json:"buildType"`
+	Invocation  Invocation  `json:"invocation"`
+	BuildConfig BuildConfig `json:"buildConfig"`
+	Metadata    Metadata    `json:"metadata"`
+	Materials   []Material  `json:"materials,omitempty"`
+}
+
+type Builder struct {
+	ID string `json:"id"`
+}
+
+type Invocation struct {
+	ConfigSource ConfigSource      `json:"configSource"`
+	Environment  map[string]string `json:"environment,omitempty"`
+}
+
+type ConfigSource struct {
+	URI        string            `json:"uri,omitempty"`
+	Digest     map[string]string `json:"digest,omitempty"`
+	EntryPoint string            `json:"entryPoint,omitempty"`
+}
+
+type BuildConfig struct {
+	Steps []Step `json:"steps"`
+}
+
+type Metadata struct {
+	BuildInvocationID string       `json:"buildInvocationId,omitempty"`
+	BuildStartedOn    string       `json:"buildStartedOn,omitempty"`
+	BuildFinishedOn   string       `json:"buildFinishedOn,omitempty"`
+	Completeness      Completeness `json:"completeness"`
+	Reproducible      bool         `json:"reproducible"`
+}
+
+type Completeness struct {
+	Parameters  bool `json:"parameters"`
+	Environment bool `json:"environment"`
+	Materials   bool `json:"materials"`
+}
+
+type Material struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// NewStatement returns the in-toto statement with the SLSA provenance of the subjects.
+func NewStatement(build *Build, subjects []Subject) *Statement {
+	builderID := build.BuilderID
+	if builderID == "" {
+		builderID = DefaultBuilderID
+	}
+
+	predicate := &Predicate{
+		Builder:   Builder{ID: builderID},
+		BuildType: BuildType,
+		Invocation: Invocation{
+			Environment: build.Environment,
+		},
+		BuildConfig: BuildConfig{
+			Steps: build.Steps,
+		},
+		Metadata: Metadata{
+			BuildInvocationID: build.InvocationID,
+			BuildStartedOn:    formatTime(build.StartedOn),
+			BuildFinishedOn:   formatTime(build.FinishedOn),
+		},
+	}
+
+	if build.RepositoryURL != "" {
+		var digest map[string]string
+		if build.Commit != "" {
+			digest = map[string]string{"sha1": build.Commit}
+		}
+
+		uri := "git+" + build.RepositoryURL
+
+		predicate.Invocation.ConfigSource = ConfigSource{
+			URI:        uri,
+			Digest:     digest,
+			EntryPoint: build.ConfigPath,
+		}
+		predicate.Materials = []Material{{URI: uri, Digest: digest}}
+	}
+
+	if subjects == nil {
+		subjects = []Subject{}
+	}
+
+	return &Statement{
+		Type:          StatementType,
+		PredicateType: PredicateType,
+		Subject:       subjects,
+		Predicate:     predicate,
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,157 @@
+package provenance_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/provenance"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sigstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewStatement(t *testing.T) {
+	statement := provenance.NewStatement(&provenance.Build{
+		InvocationID:  "https://cirrus-ci.com/task/42",
+		RepositoryURL: "https://github.com/acme/app.git",
+		Commit:        "abc123",
+		Steps: []provenance.Step{
+			{Name: "build", Instruction: "ScriptInstruction", Scripts: []string{"make"}, Status: "completed"},
+		},
+		StartedOn:  time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		FinishedOn: time.Date(2023, 1, 1, 0, 5, 0, 0, time.UTC),
+	}, []provenance.Subject{
+		{Name: "dist/app", Digest: map[string]string{"sha256": "deadbeef"}},
+	})
+
+	assert.Equal(t, provenance.StatementType, statement.Type)
+	assert.Equal(t, provenance.PredicateType, statement.PredicateType)
+	assert.Equal(t, provenance.DefaultBuilderID, statement.Predicate.Builder.ID)
+	assert.Equal(t, "git+https://github.com/acme/app.git", statement.Predicate.Invocation.ConfigSource.URI)
+	assert.Equal(t, []provenance.Material{
+		{URI: "git+https://github.com/acme/app.git", Digest: map[string]string{"sha1": "abc123"}},
+	}, statement.Predicate.Materials)
+	assert.Equal(t, "2023-01-01T00:05:00Z", statement.Predicate.Metadata.BuildFinishedOn)
+	assert.Len(t, statement.Subject, 1)
+}
+
+func TestPAE(t *testing.T) {
+	// Test vector from the DSSE specification
+	assert.Equal(t, "DSSEv1 29 http://example.com/HelloWorld 11 hello world",
+		string(provenance.PAE("http://example.com/HelloWorld", []byte("hello world"))))
+}
+
+func TestSign(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake Fulcio"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	fulcio := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "/api/v2/signingCert", request.URL.Path)
+
+		var certificateRequest struct {
+			Credentials struct {
+				OIDCIdentityToken string `json:"oidcIdentityToken"`
+			} `json:"credentials"`
+			PublicKeyRequest struct {
+				PublicKey struct {
+					Content string `json:"content"`
+				} `json:"publicKey"`
+				ProofOfPossession string `json:"proofOfPossession"`
+			} `json:"publicKeyRequest"`
+		}
+		require.NoError(t, json.NewDecoder(request.Body).Decode(&certificateRequest))
+
+		block, _ := pem.Decode([]byte(certificateRequest.PublicKeyRequest.PublicKey.Content))
+		require.NotNil(t, block)
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		require.NoError(t, err)
+
+		proof, err := base64.StdEncoding.DecodeString(certificateRequest.PublicKeyRequest.ProofOfPossession)
+		require.NoError(t, err)
+		subjectDigest := sha256.Sum256([]byte("task@cirrus-ci.com"))
+		require.True(t, ecdsa.VerifyASN1(publicKey.(*ecdsa.PublicKey), subjectDigest[:], proof))
+
+		leafDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(2),
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(10 * time.Minute),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+		}, caTemplate, publicKey, caKey)
+		require.NoError(t, err)
+
+		_ = json.NewEncoder(writer).Encode(map[string]interface{}{
+			"signedCertificateEmbeddedSct": map[string]interface{}{
+				"chain": map[string]interface{}{
+					"certificates": []string{
+						string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})),
+						string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})),
+					},
+				},
+			},
+		})
+	}))
+	defer fulcio.Close()
+
+	rekor := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "/api/v1/log/entries", request.URL.Path)
+
+		writer.WriteHeader(http.StatusCreated)
+		_, _ = writer.Write([]byte(`{"24296fb24b8ad77a": {"logIndex": 1234, "integratedTime": 1672531200}}`))
+	}))
+	defer rekor.Close()
+
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"sub": "task/42", "email": "task@cirrus-ci.com"}`))
+	token := "eyJhbGciOiJub25lIn0." + claims + ".signature"
+
+	signer := &provenance.Signer{Sigstore: &sigstore.Client{FulcioURL: fulcio.URL, RekorURL: rekor.URL}}
+
+	payload := []byte(`{"_type": "https://in-toto.io/Statement/v0.1"}`)
+
+	bundle, err := signer.Sign(context.Background(), provenance.PayloadType, payload, token)
+	require.NoError(t, err)
+	require.Len(t, bundle.CertificateChain, 2)
+	assert.JSONEq(t, `{"logIndex": 1234, "integratedTime": 1672531200}`, string(bundle.TransparencyLogEntry))
+
+	block, _ := pem.Decode([]byte(bundle.CertificateChain[0]))
+	require.NotNil(t, block)
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	require.Len(t, bundle.Envelope.Signatures, 1)
+	signature, err := base64.StdEncoding.DecodeString(bundle.Envelope.Signatures[0].Sig)
+	require.NoError(t, err)
+
+	digest := sha256.Sum256(provenance.PAE(provenance.PayloadType, payload))
+	assert.True(t, ecdsa.VerifyASN1(certificate.PublicKey.(*ecdsa.PublicKey), digest[:], signature))
+}
+
+func TestSignInvalidToken(t *testing.T) {
+	signer := &provenance.Signer{Sigstore: &sigstore.Client{FulcioURL: "http://127.0.0.1:1"}}
+
+	_, err := signer.Sign(context.Background(), provenance.PayloadType, []byte("{}"), "not-a-jwt")
+	assert.ErrorIs(t, err, provenance.ErrSigning)
+}
@@ -0,0 +1,78 @@
+package provenance
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sigstore"
+)
+
+var ErrSigning = errors.New("failed to sign the provenance")
+
+// Signer performs the Sigstore keyless signing: an ephemeral key is certified by Fulcio
+// for the identity from the OIDC token and the signature is recorded in the Rekor
+// transparency log, so that it can be verified after the certificate expires.
+type Signer struct {
+	Sigstore *sigstore.Client
+}
+
+// Bundle contains everything that's needed to verify the signed envelope.
+type Bundle struct {
+	Envelope *Envelope `json:"dsseEnvelope"`
+
+	// CertificateChain contains the PEM-encoded certificates, starting with the signing one
+	CertificateChain []string `json:"certificateChain"`
+
+	// TransparencyLogEntry is the Rekor's log entry, if the signature was recorded there
+	TransparencyLogEntry json.RawMessage `json:"tlogEntry,omitempty"`
+}
+
+// Sign signs the payload using the identity from the OIDC token (which should have the "sigstore" audience).
+func (signer *Signer) Sign(ctx context.Context, payloadType string, payload []byte, identityToken string) (*Bundle, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSigning, err)
+	}
+
+	certificateChain, err := signer.Sigstore.RequestCertificate(ctx, key, identityToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSigning, err)
+	}
+
+	digest := sha256.Sum256(PAE(payloadType, payload))
+
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSigning, err)
+	}
+
+	envelope := NewEnvelope(payloadType, payload)
+	envelope.Signatures = []Signature{{Sig: base64.StdEncoding.EncodeToString(signature)}}
+
+	bundle := &Bundle{
+		Envelope:         envelope,
+		CertificateChain: certificateChain,
+	}
+
+	if signer.Sigstore.RekorURL != "" {
+		envelopeJSON, err := json.Marshal(envelope)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSigning, err)
+		}
+
+		entry, err := signer.Sigstore.UploadDSSE(ctx, envelopeJSON, certificateChain[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrSigning, err)
+		}
+
+		bundle.TransparencyLogEntry = entry.Raw
+	}
+
+	return bundle, nil
+}
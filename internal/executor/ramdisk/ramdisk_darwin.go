@@ -0,0 +1,74 @@
+package ramdisk
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// sectorSize is the unit of the "ram://" device size.
+const sectorSize = 512
+
+func (ramDisk *RAMDisk) mount(ctx context.Context) error {
+	sectors := (ramDisk.Size + sectorSize - 1) / sectorSize
+
+	output, err := run(ctx, "hdiutil", "attach", "-nomount", fmt.Sprintf("ram://%d", sectors))
+	if err != nil {
+		return err
+	}
+
+	device, err := parseAttachOutput(output)
+	if err != nil {
+		return err
+	}
+	ramDisk.device = device
+
+	if _, err := run(ctx, "newfs_hfs", "-v", "cirrus-ramdisk", device); err != nil {
+		_, _ = run(context.Background(), "hdiutil", "detach", "-force", device)
+
+		return err
+	}
+
+	if _, err := run(ctx, "diskutil", "mount", "-mountPoint", ramDisk.Dir, device); err != nil {
+		_, _ = run(context.Background(), "hdiutil", "detach", "-force", device)
+
+		return err
+	}
+
+	return nil
+}
+
+func (ramDisk *RAMDisk) unmount(ctx context.Context) error {
+	// Detaching the device also unmounts its filesystem, forcibly
+	// in case the background scripts are still using it
+	_, err := run(ctx, "hdiutil", "detach", "-force", ramDisk.device)
+
+	return err
+}
+
+// parseAttachOutput extracts the device (e.g. "/dev/disk4") from the "hdiutil attach" output.
+func parseAttachOutput(output string) (string, error) {
+	fields := strings.Fields(output)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/dev/") {
+		return "", fmt.Errorf("unexpected \"hdiutil attach\" output: %q", output)
+	}
+
+	return fields[0], nil
+}
+
+func run(ctx context.Context, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%q failed: %w: %s", strings.Join(cmd.Args, " "), err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
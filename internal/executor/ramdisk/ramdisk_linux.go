@@ -0,0 +1,26 @@
+package ramdisk
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+)
+
+func (ramDisk *RAMDisk) mount(ctx context.Context) error {
+	options := fmt.Sprintf("size=%d,mode=0755", ramDisk.Size)
+
+	if err := syscall.Mount("tmpfs", ramDisk.Dir, "tmpfs", syscall.MS_NOSUID|syscall.MS_NODEV, options); err != nil {
+		return fmt.Errorf("failed to mount a tmpfs at %s (this requires root): %w", ramDisk.Dir, err)
+	}
+
+	return nil
+}
+
+func (ramDisk *RAMDisk) unmount(ctx context.Context) error {
+	// Detach lazily since the background scripts might still be using the working directory
+	if err := syscall.Unmount(ramDisk.Dir, syscall.MNT_DETACH); err != nil {
+		return fmt.Errorf("failed to unmount the tmpfs at %s: %w", ramDisk.Dir, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package ramdisk
+
+import "context"
+
+func (ramDisk *RAMDisk) mount(ctx context.Context) error {
+	return ErrUnsupported
+}
+
+func (ramDisk *RAMDisk) unmount(ctx context.Context) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,22 @@
+package ramdisk
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseSize(t *testing.T) {
+	size, err := ParseSize("512MiB")
+	require.NoError(t, err)
+	assert.EqualValues(t, 512*1024*1024, size)
+
+	size, err = ParseSize("4G")
+	require.NoError(t, err)
+	assert.EqualValues(t, 4_000_000_000, size)
+
+	for _, invalid := range []string{"", "0", "lots"} {
+		_, err := ParseSize(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
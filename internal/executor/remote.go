@@ -0,0 +1,142 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/remote"
+	"log"
+)
+
+const (
+	// EnvCirrusRemoteSSH makes the script instructions execute on the specified
+	// host ("user@host[:port]") over SSH instead of the agent's host. The background
+	// scripts and the other instructions are still executed locally.
+	EnvCirrusRemoteSSH = "CIRRUS_REMOTE_SSH"
+
+	// EnvCirrusRemoteSSHKey is the path to the private key to authenticate to the remote host with,
+	// defaults to the ~/.ssh/id_* keys.
+	EnvCirrusRemoteSSHKey = "CIRRUS_REMOTE_SSH_KEY"
+
+	// EnvCirrusRemoteSSHKnownHosts is the path to the known hosts file to verify the remote host's key with,
+	// defaults to the ~/.ssh/known_hosts.
+	EnvCirrusRemoteSSHKnownHosts = "CIRRUS_REMOTE_SSH_KNOWN_HOSTS"
+
+	// EnvCirrusRemoteWinRM makes the script instructions execute with PowerShell on the specified
+	// Windows host over WinRM instead of the agent's host. The value is either a "host[:port]"
+	// (HTTPS on port 5986 is assumed) or a full endpoint URL (e.g. "http://host:5985/wsman").
+	EnvCirrusRemoteWinRM = "CIRRUS_REMOTE_WINRM"
+
+	// EnvCirrusRemoteWinRMUser and EnvCirrusRemoteWinRMPassword are the credentials
+	// for the WinRM's Basic authentication.
+	EnvCirrusRemoteWinRMUser     = "CIRRUS_REMOTE_WINRM_USER"
+	EnvCirrusRemoteWinRMPassword = "CIRRUS_REMOTE_WINRM_PASSWORD"
+
+	// EnvCirrusRemoteWinRMInsecure disables the verification of the WinRM's
+	// TLS certificate, which is usually self-signed.
+	EnvCirrusRemoteWinRMInsecure = "CIRRUS_REMOTE_WINRM_INSECURE"
+
+	// EnvCirrusRemoteWorkingDir is the working directory on the remote host, which is replaced
+	// on each upload. Defaults to a temporary directory created for the task (and removed afterwards).
+	EnvCirrusRemoteWorkingDir = "CIRRUS_REMOTE_WORKING_DIR"
+
+	// EnvCirrusRemoteSync controls when the working directory is synchronized with the remote host:
+	// "both" (upload before and download after each script, default), "up", "down" or "none".
+	EnvCirrusRemoteSync = "CIRRUS_REMOTE_SYNC"
+)
+
+// connectRemoteTarget connects to the remote host (if requested) that will execute the scripts,
+// returning a function that disconnects from it.
+func (executor *Executor) connectRemoteTarget(ctx context.Context) (func(), error) {
+	syncMode, err := executor.remoteSyncMode()
+	if err != nil {
+		return nil, err
+	}
+
+	var target remote.Target
+
+	if executor.env.Get(EnvCirrusRemoteSSH) != "" && executor.env.Get(EnvCirrusRemoteWinRM) != "" {
+		return nil, fmt.Errorf("only one of %s and %s can be specified", EnvCirrusRemoteSSH, EnvCirrusRemoteWinRM)
+	}
+
+	if spec := executor.env.Get(EnvCirrusRemoteSSH); spec != "" {
+		target, err = remote.NewSSH(ctx, spec, executor.env.Get(EnvCirrusRemoteSSHKey),
+			executor.env.Get(EnvCirrusRemoteSSHKnownHosts), executor.env.Get(EnvCirrusRemoteWorkingDir))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the remote host specified in %s: %w",
+				EnvCirrusRemoteSSH, err)
+		}
+
+		log.Printf("Scripts will be executed on %s in %s", spec, target.WorkingDir())
+	}
+
+	if endpoint := executor.env.Get(EnvCirrusRemoteWinRM); endpoint != "" {
+		target, err = remote.NewWinRM(ctx, endpoint, executor.env.Get(EnvCirrusRemoteWinRMUser),
+			executor.env.Get(EnvCirrusRemoteWinRMPassword), executor.env.Get(EnvCirrusRemoteWinRMInsecure) == "true",
+			executor.env.Get(EnvCirrusRemoteWorkingDir))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the remote host specified in %s: %w",
+				EnvCirrusRemoteWinRM, err)
+		}
+
+		log.Printf("Scripts will be executed on %s in %s", endpoint, target.WorkingDir())
+	}
+
+	if target == nil {
+		return func() {}, nil
+	}
+
+	executor.remoteTarget = target
+	executor.remoteSync = syncMode
+
+	return func() {
+		if err := target.Close(); err != nil {
+			log.Printf("Failed to disconnect from the remote host: %v", err)
+		}
+	}, nil
+}
+
+// executeScriptsRemotely executes the scripts on the remote target,
+// synchronizing the working directory as requested.
+func (executor *Executor) executeScriptsRemotely(
+	ctx context.Context,
+	logUploader *LogUploader,
+	scripts []string,
+) (int, error) {
+	localWorkingDir := executor.env.Get("CIRRUS_WORKING_DIR")
+	target := executor.remoteTarget
+
+	if executor.remoteSync.Up() && localWorkingDir != "" {
+		if err := target.Upload(ctx, localWorkingDir); err != nil {
+			return 0, fmt.Errorf("failed to upload the working directory to the remote host: %w", err)
+		}
+	}
+
+	env := remote.TranslateEnv(executor.env.Items(), localWorkingDir, target.WorkingDir())
+
+	exitCode, err := target.Run(ctx, scripts, env, logUploader)
+	if err != nil {
+		return 0, err
+	}
+
+	if executor.remoteSync.Down() && localWorkingDir != "" {
+		if err := target.Download(ctx, localWorkingDir); err != nil {
+			return 0, fmt.Errorf("failed to download the working directory from the remote host: %w", err)
+		}
+	}
+
+	return exitCode, nil
+}
+
+func (executor *Executor) remoteSyncMode() (remote.SyncMode, error) {
+	mode := remote.SyncMode(executor.env.Get(EnvCirrusRemoteSync))
+
+	switch mode {
+	case "":
+		return remote.SyncBoth, nil
+	case remote.SyncBoth, remote.SyncUp, remote.SyncDown, remote.SyncNone:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid %s value %q, expected %q, %q, %q or %q", EnvCirrusRemoteSync, mode,
+			remote.SyncBoth, remote.SyncUp, remote.SyncDown, remote.SyncNone)
+	}
+}
@@ -0,0 +1,79 @@
+// Package remote executes the scripts on a remote host instead of the one the agent runs on,
+// which lets the agent orchestrate the builds on the hardware it can't run on directly
+// (e.g. BSDs, embedded boards or Windows machines that can't host the agent).
+package remote
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+)
+
+var ErrNoExitStatus = errors.New("remote command exited without reporting the exit status")
+
+// Target is a remote host the scripts are executed on.
+type Target interface {
+	// WorkingDir is the working directory on the remote host
+	WorkingDir() string
+
+	// Run executes the scripts with the specified environment in the remote working directory,
+	// streaming their output to the output writer, and returns the exit code.
+	Run(ctx context.Context, scripts []string, env map[string]string, output io.Writer) (int, error)
+
+	// Upload replaces the contents of the remote working directory with the contents of the localDir.
+	Upload(ctx context.Context, localDir string) error
+
+	// Download replaces the contents of the localDir with the contents of the remote working directory.
+	Download(ctx context.Context, localDir string) error
+
+	Close() error
+}
+
+// SyncMode controls when the working directory is synchronized with the remote host.
+type SyncMode string
+
+const (
+	SyncBoth SyncMode = "both"
+	SyncUp   SyncMode = "up"
+	SyncDown SyncMode = "down"
+	SyncNone SyncMode = "none"
+)
+
+func (mode SyncMode) Up() bool {
+	return mode == SyncBoth || mode == SyncUp
+}
+
+func (mode SyncMode) Down() bool {
+	return mode == SyncBoth || mode == SyncDown
+}
+
+// TranslateEnv rewrites the local paths to the working directory in the variable values
+// to point to the remote working directory instead.
+func TranslateEnv(env map[string]string, localWorkingDir string, remoteWorkingDir string) map[string]string {
+	result := make(map[string]string, len(env))
+
+	for key, value := range env {
+		if localWorkingDir != "" {
+			value = strings.ReplaceAll(value, localWorkingDir, remoteWorkingDir)
+		}
+
+		result[key] = value
+	}
+
+	return result
+}
+
+// sortedKeys makes the generated scripts deterministic.
+func sortedKeys(env map[string]string) []string {
+	keys := make([]string, 0, len(env))
+
+	for key := range env {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,160 @@
+package remote
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestPosixScript(t *testing.T) {
+	script := posixScript([]string{"make", "make test"}, map[string]string{
+		"CIRRUS_WORKING_DIR": "/home/ci/build",
+		"QUOTED":             "it's",
+		"NOT-A-SHELL-NAME":   "skipped",
+	}, "/home/ci/build")
+
+	assert.Equal(t, "set -e\n"+
+		"export CIRRUS_WORKING_DIR='/home/ci/build'\n"+
+		"export QUOTED='it'\\''s'\n"+
+		"mkdir -p '/home/ci/build'\n"+
+		"cd '/home/ci/build'\n"+
+		"make\n"+
+		"make test\n", script)
+}
+
+func TestPosixScriptRuns(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	workingDir := filepath.Join(t.TempDir(), "build")
+
+	output, err := exec.Command("/bin/sh", "-c", posixScript([]string{"echo \"$GREETING\" > greeting.txt"},
+		map[string]string{"GREETING": "it's $HOME"}, workingDir)).CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	greeting, err := os.ReadFile(filepath.Join(workingDir, "greeting.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "it's $HOME\n", string(greeting))
+}
+
+func TestTranslateEnv(t *testing.T) {
+	env := TranslateEnv(map[string]string{
+		"CIRRUS_WORKING_DIR": "/tmp/cirrus-ci-build",
+		"GOPATH":             "/tmp/cirrus-ci-build/go",
+		"UNRELATED":          "value",
+	}, "/tmp/cirrus-ci-build", "/home/ci/build")
+
+	assert.Equal(t, map[string]string{
+		"CIRRUS_WORKING_DIR": "/home/ci/build",
+		"GOPATH":             "/home/ci/build/go",
+		"UNRELATED":          "value",
+	}, env)
+}
+
+func TestTarRoundTrip(t *testing.T) {
+	source := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "nested"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "nested", "script.sh"), []byte("#!/bin/sh\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "README.md"), []byte("Hello!"), 0644))
+
+	var buf bytes.Buffer
+	require.NoError(t, writeTar(&buf, source))
+
+	destination := filepath.Join(t.TempDir(), "build")
+	require.NoError(t, os.MkdirAll(destination, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(destination, "stale.txt"), nil, 0644))
+	require.NoError(t, replaceDir(destination, func(tmpDir string) error {
+		return extractTar(&buf, tmpDir)
+	}))
+
+	assert.NoFileExists(t, filepath.Join(destination, "stale.txt"))
+
+	readme, err := os.ReadFile(filepath.Join(destination, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "Hello!", string(readme))
+
+	info, err := os.Stat(filepath.Join(destination, "nested", "script.sh"))
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	}
+}
+
+func TestReplaceDirFailure(t *testing.T) {
+	parent := t.TempDir()
+	destination := filepath.Join(parent, "build")
+	require.NoError(t, os.MkdirAll(destination, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(destination, "kept.txt"), nil, 0644))
+
+	err := replaceDir(destination, func(tmpDir string) error {
+		return extractTar(bytes.NewReader([]byte("not a tarball")), tmpDir)
+	})
+	require.Error(t, err)
+
+	assert.FileExists(t, filepath.Join(destination, "kept.txt"))
+
+	entries, err := os.ReadDir(parent)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestPosixReplaceScriptRuns(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	source := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(source, "README.md"), []byte("Hello!"), 0644))
+
+	var buf bytes.Buffer
+	require.NoError(t, writeTar(&buf, source))
+
+	parent := t.TempDir()
+	destination := filepath.Join(parent, "it's a build")
+	require.NoError(t, os.MkdirAll(destination, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(destination, "stale.txt"), nil, 0644))
+
+	// A failed transfer leaves the directory as it was
+	cmd := exec.Command("/bin/sh", "-c", posixReplaceScript(destination))
+	cmd.Stdin = bytes.NewReader([]byte("not a tarball"))
+	require.Error(t, cmd.Run())
+	assert.FileExists(t, filepath.Join(destination, "stale.txt"))
+
+	cmd = exec.Command("/bin/sh", "-c", posixReplaceScript(destination))
+	cmd.Stdin = &buf
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	assert.NoFileExists(t, filepath.Join(destination, "stale.txt"))
+	assert.FileExists(t, filepath.Join(destination, "README.md"))
+
+	entries, err := os.ReadDir(parent)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestValidatePosixDir(t *testing.T) {
+	assert.NoError(t, validatePosixDir("/home/ci/build"))
+
+	for _, dir := range []string{"build", "/", "//", "/."} {
+		assert.Error(t, validatePosixDir(dir), dir)
+	}
+}
+
+func TestIsInside(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.True(t, isInside(dir, filepath.Join(dir, "file")))
+	assert.True(t, isInside(dir, filepath.Join(dir, "nested", "file")))
+	assert.False(t, isInside(dir, filepath.Join(dir, "..", "file")))
+
+	if runtime.GOOS != "windows" {
+		require.NoError(t, os.Symlink("/etc", filepath.Join(dir, "link")))
+		assert.False(t, isInside(dir, filepath.Join(dir, "link", "passwd")))
+	}
+}
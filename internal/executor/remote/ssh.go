@@ -0,0 +1,317 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/sshtunnel"
+	"golang.org/x/crypto/ssh"
+	"io"
+	"net"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+var envNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SSH executes the scripts on a POSIX host over SSH.
+type SSH struct {
+	client     *ssh.Client
+	workingDir string
+
+	// The temporary working directory created for the task is removed on Close
+	ownsWorkingDir bool
+}
+
+// NewSSH connects to the host described by the spec ("user@host[:port]"), see sshtunnel.NewClientConfig
+// for the meaning of the keyPath and knownHostsPath.
+//
+// When no workingDir is specified, a temporary one is created on the remote host.
+func NewSSH(ctx context.Context, spec string, keyPath string, knownHostsPath string, workingDir string) (*SSH, error) {
+	if workingDir != "" {
+		if err := validatePosixDir(workingDir); err != nil {
+			return nil, err
+		}
+	}
+
+	user, addr, err := sshtunnel.ParseSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := sshtunnel.NewClientConfig(user, keyPath, knownHostsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := net.Dialer{Timeout: config.Timeout}
+
+	netConn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", addr, err)
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(netConn, addr, config)
+	if err != nil {
+		_ = netConn.Close()
+
+		return nil, fmt.Errorf("failed to establish an SSH connection to %s: %w", addr, err)
+	}
+
+	target := &SSH{
+		client:     ssh.NewClient(sshConn, chans, reqs),
+		workingDir: workingDir,
+	}
+
+	if workingDir == "" {
+		if err := target.createWorkingDir(ctx); err != nil {
+			_ = target.client.Close()
+
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+func (target *SSH) createWorkingDir(ctx context.Context) error {
+	var output strings.Builder
+
+	exitCode, err := target.run(ctx, `mktemp -d "${TMPDIR:-/tmp}/cirrus-ci-build.XXXXXX"`, nil, &output)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("failed to create a temporary working directory (exit code %d): %s",
+			exitCode, strings.TrimSpace(output.String()))
+	}
+
+	workingDir := strings.TrimSpace(output.String())
+	if err := validatePosixDir(workingDir); err != nil {
+		return err
+	}
+
+	target.workingDir = workingDir
+	target.ownsWorkingDir = true
+
+	return nil
+}
+
+func (target *SSH) WorkingDir() string {
+	return target.workingDir
+}
+
+func (target *SSH) Run(ctx context.Context, scripts []string, env map[string]string, output io.Writer) (int, error) {
+	return target.run(ctx, "/bin/sh -c "+shellQuote(posixScript(scripts, env, target.workingDir)), nil, output)
+}
+
+func (target *SSH) Upload(ctx context.Context, localDir string) error {
+	command := "/bin/sh -c " + shellQuote(posixReplaceScript(target.workingDir))
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		_ = writer.CloseWithError(writeTar(writer, localDir))
+	}()
+
+	var stderr strings.Builder
+
+	exitCode, err := target.run(ctx, command, reader, &stderr)
+	_ = reader.Close()
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("failed to upload the working directory (exit code %d): %s",
+			exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+func (target *SSH) Download(ctx context.Context, localDir string) error {
+	return replaceDir(localDir, func(tmpDir string) error {
+		return target.download(tmpDir)
+	})
+}
+
+func (target *SSH) download(localDir string) error {
+	session, err := target.client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	var stderr strings.Builder
+	session.Stderr = &stderr
+
+	if err := session.Start("tar -cf - -C " + shellQuote(target.workingDir) + " ."); err != nil {
+		return err
+	}
+
+	extractErr := extractTar(stdout, localDir)
+
+	// Unblock the remote tar in case the extraction has failed
+	_, _ = io.Copy(io.Discard, stdout)
+
+	if err := session.Wait(); err != nil {
+		return fmt.Errorf("failed to download the working directory: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return extractErr
+}
+
+func (target *SSH) Close() error {
+	if target.ownsWorkingDir {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		_, _ = target.run(ctx, "rm -rf "+shellQuote(target.workingDir), nil, io.Discard)
+	}
+
+	return target.client.Close()
+}
+
+func (target *SSH) run(ctx context.Context, command string, stdin io.Reader, output io.Writer) (int, error) {
+	session, err := target.client.NewSession()
+	if err != nil {
+		return 0, err
+	}
+	defer session.Close()
+
+	// Stdout and stderr are copied concurrently
+	syncOutput := &syncWriter{writer: output}
+
+	session.Stdin = stdin
+	session.Stdout = syncOutput
+	session.Stderr = syncOutput
+
+	if err := session.Start(command); err != nil {
+		return 0, err
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- session.Wait()
+	}()
+
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		_ = session.Signal(ssh.SIGKILL)
+		_ = session.Close()
+
+		return 0, ctx.Err()
+	}
+
+	if err == nil {
+		return 0, nil
+	}
+
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		if exitErr.Signal() != "" {
+			return 128 + signalNumber(exitErr.Signal()), nil
+		}
+
+		return exitErr.ExitStatus(), nil
+	}
+
+	var exitMissingErr *ssh.ExitMissingError
+	if errors.As(err, &exitMissingErr) {
+		return 0, ErrNoExitStatus
+	}
+
+	return 0, err
+}
+
+// posixScript generates the POSIX shell script that runs the scripts
+// with the specified environment in the working directory.
+func posixScript(scripts []string, env map[string]string, workingDir string) string {
+	var sb strings.Builder
+
+	sb.WriteString("set -e\n")
+
+	for _, key := range sortedKeys(env) {
+		// Not all the variables can be represented in the shell
+		if !envNameRegex.MatchString(key) {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "export %s=%s\n", key, shellQuote(env[key]))
+	}
+
+	fmt.Fprintf(&sb, "mkdir -p %s\ncd %s\n", shellQuote(workingDir), shellQuote(workingDir))
+
+	for _, script := range scripts {
+		sb.WriteString(script)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// posixReplaceScript generates the POSIX shell script that extracts the tarball from the standard
+// input into a temporary directory next to the dir and only then swaps it in, so that a failed
+// transfer leaves the dir as it was.
+func posixReplaceScript(dir string) string {
+	var sb strings.Builder
+
+	sb.WriteString("set -e\n")
+	fmt.Fprintf(&sb, "dir=%s\n", shellQuote(dir))
+	sb.WriteString("mkdir -p \"$(dirname \"$dir\")\"\n")
+	sb.WriteString("tmp=$(mktemp -d \"$dir.upload.XXXXXX\")\n")
+	sb.WriteString("trap 'rm -rf \"$tmp\"' EXIT\n")
+	sb.WriteString("tar -xf - -C \"$tmp\"\n")
+	sb.WriteString("chmod 755 \"$tmp\"\n")
+	sb.WriteString("rm -rf \"$dir\"\n")
+	sb.WriteString("mv \"$tmp\" \"$dir\"\n")
+
+	return sb.String()
+}
+
+// validatePosixDir rejects the remote working directories that are
+// not absolute or would replace the whole filesystem.
+func validatePosixDir(dir string) error {
+	if !strings.HasPrefix(dir, "/") || path.Clean(dir) == "/" {
+		return fmt.Errorf("the remote working directory %q should be an absolute path other than /", dir)
+	}
+
+	return nil
+}
+
+// shellQuote quotes the string for the POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// signalNumber maps the SSH signal names (RFC 4254) to the common POSIX signal numbers.
+func signalNumber(signal string) int {
+	numbers := map[string]int{
+		"HUP": 1, "INT": 2, "QUIT": 3, "ILL": 4, "ABRT": 6, "FPE": 8,
+		"KILL": 9, "SEGV": 11, "PIPE": 13, "ALRM": 14, "TERM": 15,
+	}
+
+	return numbers[signal]
+}
+
+type syncWriter struct {
+	mtx    sync.Mutex
+	writer io.Writer
+}
+
+func (writer *syncWriter) Write(p []byte) (int, error) {
+	writer.mtx.Lock()
+	defer writer.mtx.Unlock()
+
+	return writer.writer.Write(p)
+}
@@ -0,0 +1,206 @@
+package remote
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// writeTar writes an uncompressed tarball with the contents of the dir
+// (without the dir itself), which is understood by any tar implementation.
+func writeTar(w io.Writer, dir string) error {
+	tarWriter := tar.NewWriter(w)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+
+		var link string
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		// Don't leak the local user and group names
+		header.Uname = ""
+		header.Gname = ""
+
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tarWriter, file)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tarWriter.Close()
+}
+
+// extractTar extracts the tarball into the dir, refusing to write outside of it.
+func extractTar(r io.Reader, dir string) error {
+	tarReader := tar.NewReader(r)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !isInside(dir, target) {
+			return fmt.Errorf("refusing to extract %q outside of the working directory", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.FileMode(header.Mode).Perm()|0700); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+
+			if _, err := io.Copy(file, tarReader); err != nil {
+				_ = file.Close()
+
+				return err
+			}
+
+			if err := file.Close(); err != nil {
+				return err
+			}
+		default:
+			// Devices, FIFOs and hard links are not expected in a working directory
+			continue
+		}
+	}
+}
+
+// replaceDir populates a new temporary directory next to the dir and only swaps it in
+// once it's complete, so that a failed transfer leaves the dir as it was.
+func replaceDir(dir string, populate func(tmpDir string) error) error {
+	dir = filepath.Clean(dir)
+
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp(filepath.Dir(dir), "."+filepath.Base(dir)+".download-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := populate(tmpDir); err != nil {
+		return err
+	}
+
+	mode := os.FileMode(0755)
+
+	info, err := os.Stat(dir)
+	switch {
+	case err == nil:
+		mode = info.Mode().Perm()
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	if err := os.Chmod(tmpDir, mode); err != nil {
+		return err
+	}
+
+	oldDir := tmpDir + ".old"
+
+	if info != nil {
+		if err := os.Rename(dir, oldDir); err != nil {
+			return err
+		}
+	}
+
+	if err := os.Rename(tmpDir, dir); err != nil {
+		if info != nil {
+			_ = os.Rename(oldDir, dir)
+		}
+
+		return err
+	}
+
+	return os.RemoveAll(oldDir)
+}
+
+// isInside returns true if the target is lexically inside the dir and is not reached
+// through a symbolic link (which might point outside of the dir).
+func isInside(dir string, target string) bool {
+	dir = filepath.Clean(dir)
+
+	relPath, err := filepath.Rel(dir, target)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return false
+	}
+
+	current := dir
+	components := strings.Split(relPath, string(filepath.Separator))
+
+	for _, component := range components[:len(components)-1] {
+		current = filepath.Join(current, component)
+
+		if info, err := os.Lstat(current); err == nil && info.Mode()&os.ModeSymlink != 0 {
+			return false
+		}
+	}
+
+	return true
+}
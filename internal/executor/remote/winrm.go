@@ -0,0 +1,414 @@
+package remote
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const defaultWinRMPort = "5986"
+
+// The absolute local (e.g. C:\build) or UNC (e.g. \\server\share\build) paths, but not the roots.
+var windowsDirRegex = regexp.MustCompile(`^([A-Za-z]:|\\\\[^\\]+\\[^\\]+)\\.*[^\\]`)
+
+var ErrInvalidWinRMEndpoint = errors.New("invalid WinRM endpoint")
+
+// Variables that describe the agent's host and would break the remote Windows environment.
+var localOnlyEnv = map[string]struct{}{
+	"HOME":   {},
+	"PATH":   {},
+	"PWD":    {},
+	"SHELL":  {},
+	"TEMP":   {},
+	"TMP":    {},
+	"TMPDIR": {},
+	"USER":   {},
+}
+
+// WinRM executes the scripts on a Windows host with PowerShell over WinRM.
+//
+// Only the Basic authentication is supported, so the WinRM service should
+// either listen on HTTPS (the default) or allow the unencrypted traffic.
+type WinRM struct {
+	client     *wsmanClient
+	shellID    string
+	workingDir string
+
+	// The temporary working directory created for the task is removed on Close
+	ownsWorkingDir bool
+}
+
+// NewWinRM connects to the WinRM endpoint, which is either a "host[:port]"
+// (HTTPS on port 5986 is assumed) or a full URL (e.g. "http://host:5985/wsman").
+//
+// When no workingDir is specified, a temporary one is created on the remote host.
+func NewWinRM(
+	ctx context.Context,
+	endpoint string,
+	user string,
+	password string,
+	insecure bool,
+	workingDir string,
+) (*WinRM, error) {
+	endpointURL, err := parseWinRMEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if workingDir != "" {
+		if err := validateWindowsDir(workingDir); err != nil {
+			return nil, err
+		}
+	}
+
+	client := &wsmanClient{
+		endpoint: endpointURL,
+		user:     user,
+		password: password,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				DialContext: (&net.Dialer{
+					Timeout: 30 * time.Second,
+				}).DialContext,
+				// Explicitly requested for the self-signed WinRM certificates
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+			},
+			// Receive requests are held by the server for up to the OperationTimeout
+			Timeout: 60 * time.Second,
+		},
+	}
+
+	shellID, err := client.createShell(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a remote shell on %s: %w", endpointURL, err)
+	}
+
+	target := &WinRM{
+		client:     client,
+		shellID:    shellID,
+		workingDir: workingDir,
+	}
+
+	if workingDir == "" {
+		if err := target.createWorkingDir(ctx); err != nil {
+			_ = target.Close()
+
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+func (target *WinRM) createWorkingDir(ctx context.Context) error {
+	id, err := newUUID()
+	if err != nil {
+		return err
+	}
+
+	var stdout, stderr strings.Builder
+
+	exitCode, err := target.run(ctx, fmt.Sprintf(`mkdir "%%TEMP%%\cirrus-ci-build-%s" && `+
+		`echo %%TEMP%%\cirrus-ci-build-%s`, id, id), nil, &stdout, &stderr)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("failed to create a temporary working directory (exit code %d): %s",
+			exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	workingDir := strings.TrimSpace(stdout.String())
+	if err := validateWindowsDir(workingDir); err != nil {
+		return err
+	}
+
+	target.workingDir = workingDir
+	target.ownsWorkingDir = true
+
+	return nil
+}
+
+func (target *WinRM) WorkingDir() string {
+	return target.workingDir
+}
+
+func (target *WinRM) Run(ctx context.Context, scripts []string, env map[string]string, output io.Writer) (int, error) {
+	id, err := newUUID()
+	if err != nil {
+		return 0, err
+	}
+
+	// The script is passed as a file since the command line is limited to 8191 characters
+	scriptPath := fmt.Sprintf(`%%TEMP%%\cirrus-script-%s.ps1`, id)
+
+	// The UTF-8 BOM makes the Windows PowerShell read the script as UTF-8
+	script := "\uFEFF" + powershellScript(scripts, env, target.workingDir)
+
+	var stderr strings.Builder
+
+	writeCommand := fmt.Sprintf(`powershell.exe -NoProfile -NonInteractive -Command "[IO.File]::WriteAllBytes('%s', `+
+		`[Convert]::FromBase64String([Console]::In.ReadToEnd()))"`, scriptPath)
+	stdin := strings.NewReader(base64.StdEncoding.EncodeToString([]byte(script)))
+
+	exitCode, err := target.run(ctx, writeCommand, stdin, io.Discard, &stderr)
+	if err != nil {
+		return 0, err
+	}
+	if exitCode != 0 {
+		return 0, fmt.Errorf("failed to write the script on the remote host (exit code %d): %s",
+			exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	defer func() {
+		_, _ = target.run(context.Background(), fmt.Sprintf(`del /q "%s"`, scriptPath), nil, io.Discard, io.Discard)
+	}()
+
+	return target.run(ctx, fmt.Sprintf(`powershell.exe -NoProfile -NonInteractive -ExecutionPolicy Bypass -File "%s"`,
+		scriptPath), nil, output, output)
+}
+
+func (target *WinRM) Upload(ctx context.Context, localDir string) error {
+	id, err := newUUID()
+	if err != nil {
+		return err
+	}
+
+	// Extract into a temporary directory next to the working directory and only then swap it in,
+	// so that a failed transfer leaves the working directory as it was
+	//
+	// tar.exe ships with Windows since Windows 10 1803 and Windows Server 2019
+	tmpDir := fmt.Sprintf("%s.upload-%s", target.workingDir, id)
+	command := fmt.Sprintf(`mkdir %s && (tar -xf - -C %s || (rmdir /s /q %s & exit 1)) && `+
+		`(if exist %s rmdir /s /q %s) && move %s %s`,
+		cmdQuote(tmpDir), cmdQuote(tmpDir), cmdQuote(tmpDir),
+		cmdQuote(target.workingDir), cmdQuote(target.workingDir), cmdQuote(tmpDir), cmdQuote(target.workingDir))
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		_ = writer.CloseWithError(writeTar(writer, localDir))
+	}()
+
+	var stderr strings.Builder
+
+	exitCode, err := target.run(ctx, command, reader, io.Discard, &stderr)
+	_ = reader.Close()
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("failed to upload the working directory (exit code %d): %s",
+			exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+func (target *WinRM) Download(ctx context.Context, localDir string) error {
+	return replaceDir(localDir, func(tmpDir string) error {
+		return target.download(ctx, tmpDir)
+	})
+}
+
+func (target *WinRM) download(ctx context.Context, localDir string) error {
+	reader, writer := io.Pipe()
+	extractErrCh := make(chan error, 1)
+
+	go func() {
+		err := extractTar(reader, localDir)
+
+		// Unblock the output processing in case the extraction has failed
+		_, _ = io.Copy(io.Discard, reader)
+
+		extractErrCh <- err
+	}()
+
+	var stderr strings.Builder
+
+	exitCode, err := target.run(ctx, fmt.Sprintf(`tar -cf - -C %s .`, cmdQuote(target.workingDir)), nil,
+		writer, &stderr)
+	_ = writer.Close()
+	extractErr := <-extractErrCh
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("failed to download the working directory (exit code %d): %s",
+			exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	return extractErr
+}
+
+func (target *WinRM) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if target.ownsWorkingDir {
+		_, _ = target.run(ctx, "rmdir /s /q "+cmdQuote(target.workingDir), nil, io.Discard, io.Discard)
+	}
+
+	return target.client.deleteShell(ctx, target.shellID)
+}
+
+func (target *WinRM) run(
+	ctx context.Context,
+	command string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+) (int, error) {
+	commandID, err := target.client.startCommand(ctx, target.shellID, command)
+	if err != nil {
+		return 0, err
+	}
+
+	// Release the command's resources on the remote host when done
+	defer func() {
+		terminateCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		_ = target.client.terminate(terminateCtx, target.shellID, commandID)
+	}()
+
+	if stdin != nil {
+		if err := target.client.send(ctx, target.shellID, commandID, stdin); err != nil {
+			return 0, err
+		}
+	}
+
+	for {
+		output, err := target.client.receive(ctx, target.shellID, commandID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return 0, ctx.Err()
+			}
+
+			return 0, err
+		}
+
+		if _, err := stdout.Write(output.stdout); err != nil {
+			return 0, err
+		}
+		if _, err := stderr.Write(output.stderr); err != nil {
+			return 0, err
+		}
+
+		if output.done {
+			return output.exitCode, nil
+		}
+	}
+}
+
+// powershellScript generates the PowerShell script that runs the scripts
+// with the specified environment in the working directory, stopping
+// at the first script that fails.
+func powershellScript(scripts []string, env map[string]string, workingDir string) string {
+	var sb strings.Builder
+
+	sb.WriteString("$ErrorActionPreference = 'Stop'\n")
+	sb.WriteString("$ProgressPreference = 'SilentlyContinue'\n")
+
+	for _, key := range sortedKeys(env) {
+		if _, ok := localOnlyEnv[key]; ok {
+			continue
+		}
+
+		// Not all the variables can be represented with the ${env:NAME} syntax
+		if key == "" || strings.ContainsAny(key, "{}`") {
+			continue
+		}
+
+		value := env[key]
+
+		// Paths inside of the working directory are translated by TranslateEnv,
+		// but the rest of them still uses the local separators
+		if strings.HasPrefix(value, workingDir) {
+			value = strings.ReplaceAll(value, "/", `\`)
+		}
+
+		fmt.Fprintf(&sb, "${env:%s} = %s\n", key, powershellQuote(value))
+	}
+
+	fmt.Fprintf(&sb, "New-Item -ItemType Directory -Force -Path %s | Out-Null\n", powershellQuote(workingDir))
+	fmt.Fprintf(&sb, "Set-Location -LiteralPath %s\n", powershellQuote(workingDir))
+
+	for _, script := range scripts {
+		sb.WriteString(script)
+		sb.WriteString("\n")
+		sb.WriteString("if ($LASTEXITCODE) { exit $LASTEXITCODE }\n")
+	}
+
+	sb.WriteString("exit 0\n")
+
+	return sb.String()
+}
+
+// validateWindowsDir rejects the remote working directories that are not absolute,
+// would replace the whole drive or share, or can't be safely quoted for the cmd.exe.
+func validateWindowsDir(dir string) error {
+	if strings.ContainsAny(dir, "\"%!^\r\n") {
+		return fmt.Errorf("the remote working directory %q can't contain quotes, %%, !, ^ or line breaks", dir)
+	}
+
+	if !windowsDirRegex.MatchString(dir) {
+		return fmt.Errorf("the remote working directory %q should be an absolute path other than the root "+
+			"of a drive or a share", dir)
+	}
+
+	return nil
+}
+
+// cmdQuote quotes the path validated by validateWindowsDir for the cmd.exe.
+func cmdQuote(s string) string {
+	return `"` + s + `"`
+}
+
+// powershellQuote quotes the string as a verbatim PowerShell string.
+func powershellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func parseWinRMEndpoint(endpoint string) (string, error) {
+	if endpoint == "" {
+		return "", fmt.Errorf("%w: empty endpoint", ErrInvalidWinRMEndpoint)
+	}
+
+	if !strings.Contains(endpoint, "://") {
+		if _, _, err := net.SplitHostPort(endpoint); err != nil {
+			endpoint = net.JoinHostPort(strings.Trim(endpoint, "[]"), defaultWinRMPort)
+		}
+
+		return "https://" + endpoint + "/wsman", nil
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidWinRMEndpoint, err)
+	}
+
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return "", fmt.Errorf("%w: unsupported scheme %q", ErrInvalidWinRMEndpoint, endpointURL.Scheme)
+	}
+
+	if endpointURL.Host == "" {
+		return "", fmt.Errorf("%w: no host in %q", ErrInvalidWinRMEndpoint, endpoint)
+	}
+
+	if endpointURL.Path == "" || endpointURL.Path == "/" {
+		endpointURL.Path = "/wsman"
+	}
+
+	return endpointURL.String(), nil
+}
@@ -0,0 +1,239 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeWinRM is a WS-Management endpoint that completes every command right away
+// with the output and the exit code returned by the handler.
+type fakeWinRM struct {
+	mtx      sync.Mutex
+	commands []string
+	stdin    map[string]*bytes.Buffer
+	handler  func(command string, stdin []byte) (string, int)
+	shells   int
+}
+
+func (fake *fakeWinRM) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	fake.mtx.Lock()
+	defer fake.mtx.Unlock()
+
+	if user, password, ok := request.BasicAuth(); !ok || user != "ci" || password != "secret" {
+		writer.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	body, _ := io.ReadAll(request.Body)
+	action := findElementText(body, "Action")
+
+	var response string
+
+	switch action {
+	case wsmanActionCreate:
+		fake.shells++
+		response = "<rsp:Shell><rsp:ShellId>shell-1</rsp:ShellId></rsp:Shell>"
+	case wsmanActionDelete:
+		fake.shells--
+	case wsmanActionCommand:
+		fake.commands = append(fake.commands, findElementText(body, "Command"))
+		response = fmt.Sprintf("<rsp:CommandResponse><rsp:CommandId>cmd-%d</rsp:CommandId></rsp:CommandResponse>",
+			len(fake.commands))
+	case wsmanActionSend:
+		data, _ := base64.StdEncoding.DecodeString(findElementText(body, "Stream"))
+		fake.stdin[fmt.Sprintf("cmd-%d", len(fake.commands))].Write(data)
+	case wsmanActionReceive:
+		command := fake.commands[len(fake.commands)-1]
+		output, exitCode := fake.handler(command, fake.stdin[fmt.Sprintf("cmd-%d", len(fake.commands))].Bytes())
+		response = fmt.Sprintf(`<rsp:ReceiveResponse>`+
+			`<rsp:Stream Name="stdout" CommandId="cmd-%d">%s</rsp:Stream>`+
+			`<rsp:CommandState CommandId="cmd-%d" State="%s"><rsp:ExitCode>%d</rsp:ExitCode></rsp:CommandState>`+
+			`</rsp:ReceiveResponse>`, len(fake.commands), base64.StdEncoding.EncodeToString([]byte(output)),
+			len(fake.commands), wsmanCommandDone, exitCode)
+	case wsmanActionSignal:
+	default:
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	_, _ = fmt.Fprintf(writer, `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" `+
+		`xmlns:rsp="http://schemas.microsoft.com/wbem/wsman/1/windows/shell"><s:Body>%s</s:Body></s:Envelope>`, response)
+}
+
+func newFakeWinRM(handler func(command string, stdin []byte) (string, int)) *fakeWinRM {
+	fake := &fakeWinRM{stdin: map[string]*bytes.Buffer{}, handler: handler}
+
+	for i := 1; i <= 10; i++ {
+		fake.stdin[fmt.Sprintf("cmd-%d", i)] = &bytes.Buffer{}
+	}
+
+	return fake
+}
+
+func TestWinRMRun(t *testing.T) {
+	var script string
+
+	fake := newFakeWinRM(func(command string, stdin []byte) (string, int) {
+		switch {
+		case strings.Contains(command, "WriteAllBytes"):
+			decoded, err := base64.StdEncoding.DecodeString(string(stdin))
+			if err != nil {
+				return "", 1
+			}
+			script = string(decoded)
+
+			return "", 0
+		case strings.Contains(command, "-File"):
+			return "Hello from Windows!\r\n", 3
+		default:
+			return "", 0
+		}
+	})
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	target, err := NewWinRM(context.Background(), server.URL, "ci", "secret", false, `C:\build`)
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+
+	exitCode, err := target.Run(context.Background(), []string{"dir"}, map[string]string{"GREETING": "hi"}, &output)
+	require.NoError(t, err)
+	assert.Equal(t, 3, exitCode)
+	assert.Equal(t, "Hello from Windows!\r\n", output.String())
+
+	assert.True(t, strings.HasPrefix(script, "\uFEFF$ErrorActionPreference = 'Stop'\n"))
+	assert.Contains(t, script, "${env:GREETING} = 'hi'\n")
+	assert.Contains(t, script, "Set-Location -LiteralPath 'C:\\build'\n")
+
+	// The script file is removed afterwards
+	require.Len(t, fake.commands, 3)
+	assert.True(t, strings.HasPrefix(fake.commands[2], "del /q"))
+
+	require.NoError(t, target.Close())
+	assert.Equal(t, 0, fake.shells)
+}
+
+func TestWinRMTemporaryWorkingDir(t *testing.T) {
+	fake := newFakeWinRM(func(command string, stdin []byte) (string, int) {
+		if strings.HasPrefix(command, "mkdir") {
+			return "C:\\Users\\ci\\AppData\\Local\\Temp\\cirrus-ci-build-1\r\n", 0
+		}
+
+		return "", 0
+	})
+
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	target, err := NewWinRM(context.Background(), server.URL, "ci", "secret", false, "")
+	require.NoError(t, err)
+	assert.Equal(t, `C:\Users\ci\AppData\Local\Temp\cirrus-ci-build-1`, target.WorkingDir())
+
+	// The temporary working directory is removed afterwards
+	require.NoError(t, target.Close())
+	assert.Equal(t, `rmdir /s /q "C:\Users\ci\AppData\Local\Temp\cirrus-ci-build-1"`,
+		fake.commands[len(fake.commands)-1])
+	assert.Equal(t, 0, fake.shells)
+}
+
+func TestValidateWindowsDir(t *testing.T) {
+	for _, dir := range []string{`C:\build`, `D:\builds\cirrus`, `\\server\share\build`} {
+		assert.NoError(t, validateWindowsDir(dir), dir)
+	}
+
+	for _, dir := range []string{`C:\`, `C:`, `build`, `\\server\share`, `\\server\share\`,
+		`C:\"quoted"`, `C:\%TEMP%`, "C:\\build\r\ndel C:\\"} {
+		assert.Error(t, validateWindowsDir(dir), dir)
+	}
+}
+
+func TestWinRMAuthenticationFailure(t *testing.T) {
+	server := httptest.NewServer(newFakeWinRM(nil))
+	defer server.Close()
+
+	_, err := NewWinRM(context.Background(), server.URL, "ci", "wrong", false, `C:\build`)
+	require.ErrorIs(t, err, ErrWSManFault)
+}
+
+func TestPowershellScript(t *testing.T) {
+	script := powershellScript([]string{"go build", "go test"}, map[string]string{
+		"CIRRUS_WORKING_DIR": `C:\build`,
+		"GOPATH":             `C:\build/go/bin`,
+		"QUOTED":             "it's",
+		"PATH":               "/usr/bin:/bin",
+	}, `C:\build`)
+
+	assert.Equal(t, "$ErrorActionPreference = 'Stop'\n"+
+		"$ProgressPreference = 'SilentlyContinue'\n"+
+		"${env:CIRRUS_WORKING_DIR} = 'C:\\build'\n"+
+		"${env:GOPATH} = 'C:\\build\\go\\bin'\n"+
+		"${env:QUOTED} = 'it''s'\n"+
+		"New-Item -ItemType Directory -Force -Path 'C:\\build' | Out-Null\n"+
+		"Set-Location -LiteralPath 'C:\\build'\n"+
+		"go build\n"+
+		"if ($LASTEXITCODE) { exit $LASTEXITCODE }\n"+
+		"go test\n"+
+		"if ($LASTEXITCODE) { exit $LASTEXITCODE }\n"+
+		"exit 0\n", script)
+}
+
+func TestParseWinRMEndpoint(t *testing.T) {
+	for endpoint, expected := range map[string]string{
+		"windows.local":                     "https://windows.local:5986/wsman",
+		"windows.local:443":                 "https://windows.local:443/wsman",
+		"[::1]":                             "https://[::1]:5986/wsman",
+		"http://windows.local:5985":         "http://windows.local:5985/wsman",
+		"https://windows.local/custom/path": "https://windows.local/custom/path",
+	} {
+		actual, err := parseWinRMEndpoint(endpoint)
+		require.NoError(t, err, endpoint)
+		assert.Equal(t, expected, actual, endpoint)
+	}
+
+	for _, endpoint := range []string{"", "ftp://windows.local", "https://"} {
+		_, err := parseWinRMEndpoint(endpoint)
+		assert.ErrorIs(t, err, ErrInvalidWinRMEndpoint, endpoint)
+	}
+}
+
+func TestParseReceiveResponse(t *testing.T) {
+	output, err := parseReceiveResponse([]byte(`<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" ` +
+		`xmlns:rsp="http://schemas.microsoft.com/wbem/wsman/1/windows/shell"><s:Body><rsp:ReceiveResponse>` +
+		`<rsp:Stream Name="stdout" CommandId="1">aGVsbG8=</rsp:Stream>` +
+		`<rsp:Stream Name="stderr" CommandId="1">b29wcw==</rsp:Stream>` +
+		`<rsp:Stream Name="stdout" CommandId="1" End="true"></rsp:Stream>` +
+		`<rsp:CommandState CommandId="1" State="` + wsmanCommandDone + `">` +
+		`<rsp:ExitCode>4294967295</rsp:ExitCode></rsp:CommandState>` +
+		`</rsp:ReceiveResponse></s:Body></s:Envelope>`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "hello", string(output.stdout))
+	assert.Equal(t, "oops", string(output.stderr))
+	assert.True(t, output.done)
+	assert.Equal(t, -1, output.exitCode)
+}
+
+func TestParseFault(t *testing.T) {
+	fault := parseFault([]byte(`<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" ` +
+		`xmlns:f="http://schemas.microsoft.com/wbem/wsman/1/wsmanfault"><s:Body><s:Fault>` +
+		`<s:Reason><s:Text xml:lang="en-US">The WS-Management service cannot complete the operation ` +
+		`within the time specified in OperationTimeout.</s:Text></s:Reason>` +
+		`<s:Detail><f:WSManFault Code="2150858793" Machine="windows.local"/></s:Detail>` +
+		`</s:Fault></s:Body></s:Envelope>`))
+	require.NotNil(t, fault)
+
+	assert.Equal(t, wsmanTimedOut, fault.code)
+	assert.Contains(t, fault.reason, "OperationTimeout")
+}
@@ -0,0 +1,441 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// The subset of the WS-Management protocol (MS-WSMV) needed to run the commands
+// in a remote shell (MS-WSMV 3.1.4.1.30), see https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-wsmv.
+const (
+	wsmanResourceURI = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/cmd"
+
+	wsmanActionCreate  = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Create"
+	wsmanActionDelete  = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete"
+	wsmanActionCommand = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/Command"
+	wsmanActionSend    = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/Send"
+	wsmanActionReceive = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/Receive"
+	wsmanActionSignal  = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/Signal"
+
+	wsmanSignalTerminate = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/signal/terminate"
+	wsmanCommandDone     = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/CommandState/Done"
+
+	// wsmanTimedOut is the WS-Management fault returned when the Receive's
+	// OperationTimeout has elapsed without any output
+	wsmanTimedOut = "2150858793"
+
+	wsmanMaxEnvelopeSize = 512000
+	wsmanSendChunkSize   = 128 * 1024
+)
+
+var ErrWSManFault = errors.New("WS-Management fault")
+
+// wsmanClient talks to a single WS-Management endpoint (e.g. https://host:5986/wsman).
+type wsmanClient struct {
+	endpoint   string
+	user       string
+	password   string
+	httpClient *http.Client
+}
+
+type wsmanOption struct {
+	Name  string
+	Value string
+}
+
+// commandOutput is the result of a single Receive.
+type commandOutput struct {
+	stdout   []byte
+	stderr   []byte
+	done     bool
+	exitCode int
+}
+
+func (client *wsmanClient) createShell(ctx context.Context) (string, error) {
+	body := "<rsp:Shell><rsp:InputStreams>stdin</rsp:InputStreams>" +
+		"<rsp:OutputStreams>stdout stderr</rsp:OutputStreams></rsp:Shell>"
+
+	response, err := client.call(ctx, wsmanActionCreate, "", []wsmanOption{
+		{Name: "WINRS_NOPROFILE", Value: "FALSE"},
+		{Name: "WINRS_CODEPAGE", Value: "65001"},
+	}, body)
+	if err != nil {
+		return "", err
+	}
+
+	shellID := findElementText(response, "ShellId")
+	if shellID == "" {
+		// Older servers only report the shell ID in the selector
+		shellID = findSelector(response, "ShellId")
+	}
+	if shellID == "" {
+		return "", fmt.Errorf("%w: no shell ID in the response", ErrWSManFault)
+	}
+
+	return shellID, nil
+}
+
+func (client *wsmanClient) deleteShell(ctx context.Context, shellID string) error {
+	_, err := client.call(ctx, wsmanActionDelete, shellID, nil, "")
+
+	return err
+}
+
+func (client *wsmanClient) startCommand(ctx context.Context, shellID string, command string, args ...string) (string, error) {
+	var body strings.Builder
+
+	body.WriteString("<rsp:CommandLine><rsp:Command>")
+	_ = xml.EscapeText(&body, []byte(command))
+	body.WriteString("</rsp:Command>")
+	for _, arg := range args {
+		body.WriteString("<rsp:Arguments>")
+		_ = xml.EscapeText(&body, []byte(arg))
+		body.WriteString("</rsp:Arguments>")
+	}
+	body.WriteString("</rsp:CommandLine>")
+
+	response, err := client.call(ctx, wsmanActionCommand, shellID, []wsmanOption{
+		{Name: "WINRS_CONSOLEMODE_STDIN", Value: "FALSE"},
+		{Name: "WINRS_SKIP_CMD_SHELL", Value: "FALSE"},
+	}, body.String())
+	if err != nil {
+		return "", err
+	}
+
+	commandID := findElementText(response, "CommandId")
+	if commandID == "" {
+		return "", fmt.Errorf("%w: no command ID in the response", ErrWSManFault)
+	}
+
+	return commandID, nil
+}
+
+// send feeds the data to the command's standard input, closing it at the end.
+func (client *wsmanClient) send(ctx context.Context, shellID string, commandID string, data io.Reader) error {
+	buf := make([]byte, wsmanSendChunkSize)
+
+	for {
+		n, readErr := io.ReadFull(data, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return readErr
+		}
+
+		end := readErr != nil
+
+		var endAttr string
+		if end {
+			endAttr = ` End="true"`
+		}
+
+		body := fmt.Sprintf(`<rsp:Send><rsp:Stream Name="stdin" CommandId="%s"%s>%s</rsp:Stream></rsp:Send>`,
+			xmlAttr(commandID), endAttr, base64.StdEncoding.EncodeToString(buf[:n]))
+
+		if _, err := client.call(ctx, wsmanActionSend, shellID, nil, body); err != nil {
+			return err
+		}
+
+		if end {
+			return nil
+		}
+	}
+}
+
+// receive returns the output produced by the command since the last call.
+func (client *wsmanClient) receive(ctx context.Context, shellID string, commandID string) (*commandOutput, error) {
+	body := fmt.Sprintf(`<rsp:Receive><rsp:DesiredStream CommandId="%s">stdout stderr</rsp:DesiredStream></rsp:Receive>`,
+		xmlAttr(commandID))
+
+	response, err := client.call(ctx, wsmanActionReceive, shellID, nil, body)
+	if err != nil {
+		var fault *wsmanFault
+		if errors.As(err, &fault) && fault.code == wsmanTimedOut {
+			return &commandOutput{}, nil
+		}
+
+		return nil, err
+	}
+
+	return parseReceiveResponse(response)
+}
+
+func (client *wsmanClient) terminate(ctx context.Context, shellID string, commandID string) error {
+	body := fmt.Sprintf(`<rsp:Signal CommandId="%s"><rsp:Code>%s</rsp:Code></rsp:Signal>`,
+		xmlAttr(commandID), wsmanSignalTerminate)
+
+	_, err := client.call(ctx, wsmanActionSignal, shellID, nil, body)
+
+	return err
+}
+
+func (client *wsmanClient) call(
+	ctx context.Context,
+	action string,
+	shellID string,
+	options []wsmanOption,
+	body string,
+) ([]byte, error) {
+	envelope, err := client.envelope(action, shellID, options, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, client.endpoint, bytes.NewReader(envelope))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/soap+xml;charset=UTF-8")
+	request.SetBasicAuth(client.user, client.password)
+
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("%w: authentication failed, make sure that the Basic authentication "+
+			"is enabled for the WinRM service", ErrWSManFault)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		if fault := parseFault(responseBody); fault != nil {
+			return nil, fault
+		}
+
+		return nil, fmt.Errorf("%w: unexpected HTTP status %s", ErrWSManFault, response.Status)
+	}
+
+	return responseBody, nil
+}
+
+func (client *wsmanClient) envelope(action string, shellID string, options []wsmanOption, body string) ([]byte, error) {
+	messageID, err := newUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(`<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope" ` +
+		`xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing" ` +
+		`xmlns:w="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd" ` +
+		`xmlns:rsp="http://schemas.microsoft.com/wbem/wsman/1/windows/shell">`)
+	sb.WriteString("<env:Header>")
+	fmt.Fprintf(&sb, "<a:To>%s</a:To>", xmlAttr(client.endpoint))
+	sb.WriteString(`<a:ReplyTo><a:Address env:mustUnderstand="true">` +
+		`http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo>`)
+	fmt.Fprintf(&sb, `<w:MaxEnvelopeSize env:mustUnderstand="true">%d</w:MaxEnvelopeSize>`, wsmanMaxEnvelopeSize)
+	fmt.Fprintf(&sb, "<a:MessageID>uuid:%s</a:MessageID>", messageID)
+	sb.WriteString(`<w:Locale xml:lang="en-US" env:mustUnderstand="false"/>`)
+	sb.WriteString("<w:OperationTimeout>PT20S</w:OperationTimeout>")
+	fmt.Fprintf(&sb, `<w:ResourceURI env:mustUnderstand="true">%s</w:ResourceURI>`, wsmanResourceURI)
+	fmt.Fprintf(&sb, `<a:Action env:mustUnderstand="true">%s</a:Action>`, action)
+	if shellID != "" {
+		fmt.Fprintf(&sb, `<w:SelectorSet><w:Selector Name="ShellId">%s</w:Selector></w:SelectorSet>`,
+			xmlAttr(shellID))
+	}
+	if len(options) != 0 {
+		sb.WriteString("<w:OptionSet>")
+		for _, option := range options {
+			fmt.Fprintf(&sb, `<w:Option Name="%s">%s</w:Option>`, xmlAttr(option.Name), xmlAttr(option.Value))
+		}
+		sb.WriteString("</w:OptionSet>")
+	}
+	sb.WriteString("</env:Header>")
+	sb.WriteString("<env:Body>")
+	sb.WriteString(body)
+	sb.WriteString("</env:Body></env:Envelope>")
+
+	return []byte(sb.String()), nil
+}
+
+type wsmanFault struct {
+	code   string
+	reason string
+}
+
+func (fault *wsmanFault) Error() string {
+	return fmt.Sprintf("%v %s: %s", ErrWSManFault, fault.code, fault.reason)
+}
+
+func (fault *wsmanFault) Unwrap() error {
+	return ErrWSManFault
+}
+
+// parseFault extracts the WS-Management fault code (e.g. wsmanTimedOut) and the reason.
+func parseFault(body []byte) *wsmanFault {
+	var result *wsmanFault
+
+	walkElements(body, func(name string, attrs []xml.Attr, text string) {
+		switch name {
+		case "WSManFault":
+			if result == nil {
+				result = &wsmanFault{}
+			}
+			for _, attr := range attrs {
+				if attr.Name.Local == "Code" {
+					result.code = attr.Value
+				}
+			}
+		case "Text", "Message":
+			if result == nil {
+				result = &wsmanFault{}
+			}
+			if result.reason == "" {
+				result.reason = strings.TrimSpace(text)
+			}
+		}
+	})
+
+	return result
+}
+
+func parseReceiveResponse(body []byte) (*commandOutput, error) {
+	output := &commandOutput{}
+
+	var decodeErr error
+
+	walkElements(body, func(name string, attrs []xml.Attr, text string) {
+		switch name {
+		case "Stream":
+			data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(text))
+			if err != nil {
+				decodeErr = err
+				return
+			}
+
+			for _, attr := range attrs {
+				if attr.Name.Local == "Name" && attr.Value == "stdout" {
+					output.stdout = append(output.stdout, data...)
+				} else if attr.Name.Local == "Name" && attr.Value == "stderr" {
+					output.stderr = append(output.stderr, data...)
+				}
+			}
+		case "CommandState":
+			for _, attr := range attrs {
+				if attr.Name.Local == "State" && attr.Value == wsmanCommandDone {
+					output.done = true
+				}
+			}
+		case "ExitCode":
+			exitCode, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+			if err != nil {
+				decodeErr = err
+				return
+			}
+
+			// Windows exit codes are unsigned 32-bit integers
+			output.exitCode = int(int32(uint32(exitCode)))
+		}
+	})
+
+	if decodeErr != nil {
+		return nil, fmt.Errorf("%w: failed to parse the command output: %v", ErrWSManFault, decodeErr)
+	}
+
+	return output, nil
+}
+
+func findElementText(body []byte, localName string) string {
+	var result string
+
+	walkElements(body, func(name string, attrs []xml.Attr, text string) {
+		if name == localName && result == "" {
+			result = strings.TrimSpace(text)
+		}
+	})
+
+	return result
+}
+
+func findSelector(body []byte, selectorName string) string {
+	var result string
+
+	walkElements(body, func(name string, attrs []xml.Attr, text string) {
+		if name != "Selector" || result != "" {
+			return
+		}
+
+		for _, attr := range attrs {
+			if attr.Name.Local == "Name" && attr.Value == selectorName {
+				result = strings.TrimSpace(text)
+			}
+		}
+	})
+
+	return result
+}
+
+// walkElements calls the fn for each element with its local name, attributes and character data,
+// ignoring the namespaces, which are used inconsistently across the WinRM implementations.
+func walkElements(body []byte, fn func(name string, attrs []xml.Attr, text string)) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+
+	type element struct {
+		name  string
+		attrs []xml.Attr
+		text  strings.Builder
+	}
+
+	var stack []*element
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, &element{name: token.Name.Local, attrs: token.Copy().Attr})
+		case xml.CharData:
+			if len(stack) != 0 {
+				stack[len(stack)-1].text.Write(token)
+			}
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return
+			}
+
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			fn(current.name, current.attrs, current.text.String())
+		}
+	}
+}
+
+func xmlAttr(s string) string {
+	var sb strings.Builder
+
+	_ = xml.EscapeText(&sb, []byte(s))
+
+	return sb.String()
+}
+
+func newUUID() (string, error) {
+	var b [16]byte
+
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%X-%X-%X-%X-%X", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
@@ -0,0 +1,58 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"regexp"
+	"strings"
+)
+
+// EnvCirrusRequiredEnv is a comma- or newline-separated list of the variables
+// that must be set (and non-empty) before running any commands.
+const EnvCirrusRequiredEnv = "CIRRUS_REQUIRED_ENV"
+
+// encryptedRegexp matches the values that the server failed to decrypt, which
+// happens when the value was encrypted for another repository or organization.
+var encryptedRegexp = regexp.MustCompile(`^ENCRYPTED\[.+\]$`)
+
+// validateRequiredEnvironment makes sure that the variables listed in CIRRUS_REQUIRED_ENV are set
+// and that none of them contain a value that was left encrypted. The rest of the variables are
+// not checked, since e.g. the forked PRs legitimately run with the secrets that can't be decrypted.
+func validateRequiredEnvironment(env *environment.Environment) error {
+	var missing []string
+	var encrypted []string
+
+	for _, key := range strings.FieldsFunc(env.Get(EnvCirrusRequiredEnv), func(r rune) bool {
+		return r == ',' || r == '\n'
+	}) {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+
+		value, ok := env.Lookup(key)
+		if !ok || value == "" {
+			missing = append(missing, key)
+		} else if encryptedRegexp.MatchString(value) {
+			encrypted = append(encrypted, key)
+		}
+	}
+
+	if len(missing) == 0 && len(encrypted) == 0 {
+		return nil
+	}
+
+	var problems []string
+
+	if len(missing) != 0 {
+		problems = append(problems, fmt.Sprintf("the following required environment variables "+
+			"are not set or empty: %s", strings.Join(missing, ", ")))
+	}
+
+	if len(encrypted) != 0 {
+		problems = append(problems, fmt.Sprintf("the following environment variables weren't decrypted: %s "+
+			"(make sure they were encrypted for this repository or organization)", strings.Join(encrypted, ", ")))
+	}
+
+	return fmt.Errorf("environment validation failed: %s", strings.Join(problems, "; "))
+}
@@ -0,0 +1,25 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidateRequiredEnvironment(t *testing.T) {
+	require.NoError(t, validateRequiredEnvironment(environment.New(map[string]string{
+		EnvCirrusRequiredEnv: "AWS_ROLE, SIGNING_KEY",
+		"AWS_ROLE":           "role",
+		"SIGNING_KEY":        "key",
+		"OPTIONAL_TOKEN":     "ENCRYPTED[abcdef]",
+	})))
+
+	err := validateRequiredEnvironment(environment.New(map[string]string{
+		EnvCirrusRequiredEnv: "AWS_ROLE,SIGNING_KEY,EMPTY",
+		"AWS_ROLE":           "ENCRYPTED[abcdef]",
+		"EMPTY":              "",
+	}))
+	require.EqualError(t, err, "environment validation failed: the following required environment "+
+		"variables are not set or empty: SIGNING_KEY, EMPTY; the following environment variables "+
+		"weren't decrypted: AWS_ROLE (make sure they were encrypted for this repository or organization)")
+}
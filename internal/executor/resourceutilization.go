@@ -0,0 +1,105 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/thermal"
+	"github.com/dustin/go-humanize"
+	"sort"
+	"strings"
+	"time"
+)
+
+// reportAdditionalMetrics reports the metrics that don't fit into the api.ResourceUtilization
+// (e.g. GPU utilization) as an annotation summarizing each of them.
+//
+// Periods of thermal throttling are additionally flagged with a warning, since these
+// produce misleading timing regressions.
+func (executor *Executor) reportAdditionalMetrics(ctx context.Context, charts map[string][]*api.ChartPoint) {
+	var annotations []*api.Annotation
+
+	if summary := summarizeCharts(charts); summary != "" {
+		annotations = append(annotations, &api.Annotation{
+			Type:       api.Annotation_GENERIC,
+			Level:      api.Annotation_NOTICE,
+			Message:    "Additional resource utilization metrics",
+			RawDetails: summary,
+		})
+	}
+
+	if periods := thermal.ThrottledPeriods(charts); len(periods) != 0 {
+		annotations = append(annotations, &api.Annotation{
+			Type:       api.Annotation_GENERIC,
+			Level:      api.Annotation_WARNING,
+			Message:    "CPU was thermally throttled, timings of this task might be misleading",
+			RawDetails: formatThrottledPeriods(periods),
+		})
+	}
+
+	if len(annotations) == 0 {
+		return
+	}
+
+	_, _ = client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: executor.taskIdentification,
+		Annotations:        annotations,
+	})
+}
+
+func formatThrottledPeriods(periods []thermal.Period) string {
+	var sb strings.Builder
+
+	for _, period := range periods {
+		start := time.Duration(period.StartSecondsFromStart) * time.Second
+		end := time.Duration(period.EndSecondsFromStart) * time.Second
+
+		fmt.Fprintf(&sb, "throttled from %s to %s since the start of the task\n", start, end)
+	}
+
+	return sb.String()
+}
+
+func summarizeCharts(charts map[string][]*api.ChartPoint) string {
+	var names []string
+
+	for name, points := range charts {
+		if len(points) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var sb strings.Builder
+
+	for _, name := range names {
+		points := charts[name]
+
+		var sum, max float64
+		for _, point := range points {
+			sum += point.Value
+
+			if point.Value > max {
+				max = point.Value
+			}
+		}
+
+		fmt.Fprintf(&sb, "%s: average %s, maximum %s (%d samples)\n", name,
+			formatMetricValue(name, sum/float64(len(points))), formatMetricValue(name, max), len(points))
+	}
+
+	return sb.String()
+}
+
+func formatMetricValue(name string, value float64) string {
+	switch {
+	case strings.HasSuffix(name, "_bytes"):
+		return humanize.Bytes(uint64(value))
+	case strings.HasSuffix(name, "_bytes_per_second"):
+		return humanize.Bytes(uint64(value)) + "/s"
+	default:
+		return fmt.Sprintf("%.2f", value)
+	}
+}
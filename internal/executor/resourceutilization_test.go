@@ -0,0 +1,24 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/metrics/source/thermal"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSummarizeCharts(t *testing.T) {
+	summary := summarizeCharts(map[string][]*api.ChartPoint{
+		"gpus_used":             {{Value: 0.5}, {Value: 1.5}},
+		"gpu_memory_used_bytes": {{Value: 1000}, {Value: 3000}},
+		"empty":                 {},
+	})
+
+	require.Equal(t, "gpu_memory_used_bytes: average 2.0 kB, maximum 3.0 kB (2 samples)\n"+
+		"gpus_used: average 1.00, maximum 1.50 (2 samples)\n", summary)
+}
+
+func TestFormatThrottledPeriods(t *testing.T) {
+	require.Equal(t, "throttled from 10s to 1m30s since the start of the task\n",
+		formatThrottledPeriods([]thermal.Period{{StartSecondsFromStart: 10, EndSecondsFromStart: 90}}))
+}
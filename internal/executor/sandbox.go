@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sandbox"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// sandboxSettings are configured by the worker rather than taken from the task's
+// environment, otherwise the untrusted code the sandbox protects against could simply
+// turn it off or make the rest of the filesystem writable.
+var sandboxSettings struct {
+	enabled  bool
+	writable []string
+}
+
+// ConfigureSandbox makes the scripts run in a sandbox that only allows them to modify
+// the working directory, a private temporary directory and the specified comma-separated
+// writable paths (e.g. the cache folders outside of the working directory), and hides all
+// devices except the harmless ones (e.g. /dev/null). Uses the user namespaces on Linux
+// and sandbox-exec on macOS.
+func ConfigureSandbox(enabled bool, writable string) {
+	sandboxSettings.enabled = enabled
+	sandboxSettings.writable = nil
+
+	for _, path := range strings.Split(writable, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		sandboxSettings.writable = append(sandboxSettings.writable, path)
+	}
+}
+
+// sandboxCommand wraps the command into a sandbox (if configured).
+func sandboxCommand(cmd *exec.Cmd, scriptFile *os.File) error {
+	if !sandboxSettings.enabled {
+		return nil
+	}
+
+	config := sandbox.Config{
+		WorkingDir: cmd.Dir,
+	}
+
+	if cmd.Dir != "" {
+		config.Writable = append(config.Writable, cmd.Dir)
+	}
+
+	for _, path := range sandboxSettings.writable {
+		// The sandbox only makes the existing paths writable
+		EnsureFolderExists(path)
+
+		config.Writable = append(config.Writable, path)
+	}
+
+	if scriptFile != nil {
+		config.ReadOnly = append(config.ReadOnly, scriptFile.Name())
+	}
+
+	return sandbox.Wrap(cmd, config)
+}
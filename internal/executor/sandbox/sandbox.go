@@ -0,0 +1,101 @@
+// Package sandbox restricts the filesystem and device access of the user scripts, which
+// is useful for the community clusters that run the untrusted pull request code.
+//
+// On Linux the agent re-executes itself (see Run) in the new user, mount and PID namespaces
+// where the whole filesystem is re-mounted read-only except for the working directory and the
+// explicitly writable paths, /tmp is private and /dev only contains the harmless devices.
+// On macOS the command is wrapped with sandbox-exec using an equivalent profile.
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Flag is the agent's command-line flag that runs the command in a sandbox (see Run).
+const Flag = "sandbox"
+
+var ErrUnsupported = errors.New("sandboxing is only supported on Linux and macOS")
+
+type Config struct {
+	// WorkingDir is where the command is started
+	WorkingDir string `json:"working_dir"`
+
+	// Writable are the paths (including the working directory) that the command may modify
+	Writable []string `json:"writable,omitempty"`
+
+	// ReadOnly are the paths that should remain visible even if they're
+	// hidden by the sandbox (e.g. a script file in the /tmp)
+	ReadOnly []string `json:"read_only,omitempty"`
+
+	// Chrooted is set once the root filesystem is prepared (Linux-only)
+	Chrooted bool `json:"chrooted,omitempty"`
+}
+
+// Wrap modifies the command so that it's executed in a sandbox.
+func Wrap(cmd *exec.Cmd, config Config) error {
+	return wrap(cmd, config)
+}
+
+func encodeConfig(config *Config) (string, error) {
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func decodeConfig(encoded string) (*Config, error) {
+	var config Config
+
+	if err := json.Unmarshal([]byte(encoded), &config); err != nil {
+		return nil, fmt.Errorf("failed to parse the sandbox configuration: %w", err)
+	}
+
+	return &config, nil
+}
+
+// seatbeltProfile renders the sandbox-exec profile that denies the writes outside
+// of the writable paths and the access to all devices except the harmless ones.
+func seatbeltProfile(config Config, tempDir string) string {
+	var sb strings.Builder
+
+	sb.WriteString("(version 1)\n")
+	sb.WriteString("(allow default)\n")
+	sb.WriteString("(deny file-write*)\n")
+	sb.WriteString("(deny file-read* file-write* (subpath \"/dev\"))\n")
+
+	sb.WriteString("(allow file-read* (literal \"/dev\"))\n")
+	sb.WriteString("(allow file-read* file-write*\n")
+	for _, device := range []string{"null", "zero", "random", "urandom", "tty", "dtracehelper"} {
+		fmt.Fprintf(&sb, "  (literal %s)\n", seatbeltString("/dev/"+device))
+	}
+	sb.WriteString("  (regex #\"^/dev/fd(/|$)\")\n")
+	sb.WriteString("  (regex #\"^/dev/ttys[0-9]+$\"))\n")
+
+	writable := append([]string{}, config.Writable...)
+	if tempDir != "" {
+		writable = append(writable, tempDir)
+	}
+
+	if len(writable) != 0 {
+		sb.WriteString("(allow file-write*\n")
+		for i, path := range writable {
+			fmt.Fprintf(&sb, "  (subpath %s)", seatbeltString(path))
+			if i == len(writable)-1 {
+				sb.WriteString(")")
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func seatbeltString(s string) string {
+	return "\"" + strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s) + "\""
+}
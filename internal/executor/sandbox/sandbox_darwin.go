@@ -0,0 +1,39 @@
+package sandbox
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+const sandboxExec = "/usr/bin/sandbox-exec"
+
+func wrap(cmd *exec.Cmd, config Config) error {
+	// Seatbelt matches the resolved paths (e.g. /private/tmp instead of /tmp)
+	resolved := config
+	resolved.Writable = nil
+
+	for _, path := range config.Writable {
+		resolved.Writable = append(resolved.Writable, resolvePath(path))
+	}
+
+	profile := seatbeltProfile(resolved, resolvePath(os.TempDir()))
+
+	cmd.Args = append([]string{sandboxExec, "-p", profile, cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = sandboxExec
+
+	return nil
+}
+
+func resolvePath(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+
+	return path
+}
+
+// Run is only used on Linux.
+func Run(encodedConfig string, args []string) (int, error) {
+	return 0, ErrUnsupported
+}
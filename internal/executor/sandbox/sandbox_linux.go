@@ -0,0 +1,428 @@
+package sandbox
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"golang.org/x/sys/unix"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// maxCapability is big enough to cover the capabilities of the future kernels,
+// the non-existent ones are simply reported as invalid
+const maxCapability = 63
+
+// devices are the device nodes that remain available in the sandbox
+var devices = []string{"null", "zero", "full", "random", "urandom", "tty"}
+
+// preservedFlags are the statfs(2) flags that have to be kept when re-mounting,
+// because the unprivileged users are not allowed to clear them
+var preservedFlags = map[int64]uintptr{
+	unix.ST_NOSUID:     unix.MS_NOSUID,
+	unix.ST_NODEV:      unix.MS_NODEV,
+	unix.ST_NOEXEC:     unix.MS_NOEXEC,
+	unix.ST_NOATIME:    unix.MS_NOATIME,
+	unix.ST_NODIRATIME: unix.MS_NODIRATIME,
+	unix.ST_RELATIME:   unix.MS_RELATIME,
+}
+
+func wrap(cmd *exec.Cmd, config Config) error {
+	agent, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	encodedConfig, err := encodeConfig(&config)
+	if err != nil {
+		return err
+	}
+
+	cmd.Args = append([]string{agent, "-" + Flag, encodedConfig, "--", cmd.Path}, cmd.Args[1:]...)
+	cmd.Path = agent
+
+	// The agent becomes root in the new user namespace, which allows it to set up
+	// the mounts, and the PID 1 in the new PID namespace, which makes sure that no
+	// processes outlive the sandbox
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Setsid: true,
+		Cloneflags: syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWPID |
+			syscall.CLONE_NEWIPC,
+		UidMappings: []syscall.SysProcIDMap{
+			{ContainerID: 0, HostID: os.Getuid(), Size: 1},
+		},
+		GidMappings: []syscall.SysProcIDMap{
+			{ContainerID: 0, HostID: os.Getgid(), Size: 1},
+		},
+		GidMappingsEnableSetgroups: false,
+	}
+
+	return nil
+}
+
+// Run is invoked from within the namespaces created by Wrap, it sets up the sandbox's
+// root filesystem and runs the command in it, returning the command's exit code.
+func Run(encodedConfig string, args []string) (int, error) {
+	config, err := decodeConfig(encodedConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(args) == 0 {
+		return 0, errors.New("no command to run in the sandbox")
+	}
+
+	if config.Chrooted {
+		return 0, dropCapabilitiesAndExec(args)
+	}
+
+	// We'll re-execute ourselves in the new root to drop the capabilities before running
+	// the command, so make sure that the agent's binary is visible there
+	agent, err := os.Executable()
+	if err != nil {
+		return 0, err
+	}
+
+	config.ReadOnly = append(config.ReadOnly, agent)
+
+	newRoot, err := os.MkdirTemp("", "cirrus-sandbox-")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = unix.Unmount(newRoot, unix.MNT_DETACH)
+		_ = os.Remove(newRoot)
+	}()
+
+	if err := setupRoot(newRoot, config); err != nil {
+		return 0, err
+	}
+
+	config.Chrooted = true
+
+	encodedConfig, err = encodeConfig(config)
+	if err != nil {
+		return 0, err
+	}
+
+	cmd := exec.Command(agent, append([]string{"-" + Flag, encodedConfig, "--"}, args...)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Dir = config.WorkingDir
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Chroot: newRoot,
+	}
+
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	return reap(cmd.Process.Pid)
+}
+
+func setupRoot(newRoot string, config *Config) error {
+	// Make sure that none of the changes below propagate to the host
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make the mounts private: %w", err)
+	}
+
+	if err := unix.Mount("/", newRoot, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return fmt.Errorf("failed to bind-mount the root filesystem: %w", err)
+	}
+
+	if err := unix.Mount("tmpfs", filepath.Join(newRoot, "tmp"), "tmpfs",
+		unix.MS_NOSUID|unix.MS_NODEV, "mode=1777"); err != nil {
+		return fmt.Errorf("failed to mount /tmp: %w", err)
+	}
+
+	if err := setupDev(filepath.Join(newRoot, "dev")); err != nil {
+		return err
+	}
+
+	// Mounting a new procfs only works when nothing is masked in the /proc
+	// (e.g. when running in a Docker container), otherwise keep the host's one
+	_ = unix.Mount("proc", filepath.Join(newRoot, "proc"), "proc",
+		unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, "")
+
+	writable := []string{
+		filepath.Join(newRoot, "tmp"),
+		filepath.Join(newRoot, "dev"),
+	}
+
+	for _, path := range config.Writable {
+		if err := bindMount(path, filepath.Join(newRoot, path)); err != nil {
+			return err
+		}
+
+		writable = append(writable, filepath.Join(newRoot, path))
+	}
+
+	if err := remountReadOnly(newRoot, writable); err != nil {
+		return err
+	}
+
+	// The read-only paths might reside in the writable ones (e.g. in the /tmp),
+	// so they're bind-mounted and re-mounted individually
+	for _, path := range config.ReadOnly {
+		target := filepath.Join(newRoot, path)
+
+		if err := bindMount(path, target); err != nil {
+			return err
+		}
+
+		if err := remountMountPoint(target); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setupDev(dev string) error {
+	if err := unix.Mount("tmpfs", dev, "tmpfs", unix.MS_NOSUID|unix.MS_NOEXEC, "mode=0755"); err != nil {
+		return fmt.Errorf("failed to mount /dev: %w", err)
+	}
+
+	for _, device := range devices {
+		source := filepath.Join("/dev", device)
+
+		if _, err := os.Stat(source); err != nil {
+			continue
+		}
+
+		if err := bindMount(source, filepath.Join(dev, device)); err != nil {
+			return err
+		}
+	}
+
+	symlinks := map[string]string{
+		"fd":     "/proc/self/fd",
+		"stdin":  "/proc/self/fd/0",
+		"stdout": "/proc/self/fd/1",
+		"stderr": "/proc/self/fd/2",
+		"ptmx":   "pts/ptmx",
+	}
+
+	for name, target := range symlinks {
+		if err := os.Symlink(target, filepath.Join(dev, name)); err != nil {
+			return err
+		}
+	}
+
+	for _, dir := range []string{"pts", "shm"} {
+		if err := os.Mkdir(filepath.Join(dev, dir), 0755); err != nil {
+			return err
+		}
+	}
+
+	// Pseudo-terminals are nice to have, but not essential
+	_ = unix.Mount("devpts", filepath.Join(dev, "pts"), "devpts", unix.MS_NOSUID|unix.MS_NOEXEC,
+		"newinstance,ptmxmode=0666,mode=0620")
+
+	if err := unix.Mount("tmpfs", filepath.Join(dev, "shm"), "tmpfs",
+		unix.MS_NOSUID|unix.MS_NODEV, "mode=1777"); err != nil {
+		return fmt.Errorf("failed to mount /dev/shm: %w", err)
+	}
+
+	return nil
+}
+
+func bindMount(source string, target string) error {
+	info, err := os.Stat(source)
+	if err != nil {
+		return err
+	}
+
+	// Create the mount point, unless it already exists
+	if info.IsDir() {
+		if err := os.MkdirAll(target, 0755); err != nil {
+			return err
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+
+		if _, err := os.Stat(target); errors.Is(err, os.ErrNotExist) {
+			if err := os.WriteFile(target, nil, 0600); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := unix.Mount(source, target, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+		return fmt.Errorf("failed to bind-mount %s: %w", source, err)
+	}
+
+	return nil
+}
+
+// remountReadOnly re-mounts all the mounts under the root as read-only,
+// except for the writable paths and the mounts nested in them.
+func remountReadOnly(root string, writable []string) error {
+	file, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	mountPoints, err := parseMountInfo(file)
+	if err != nil {
+		return err
+	}
+
+	for _, mountPoint := range mountPoints {
+		if !isUnder(mountPoint, root) || isUnderAny(mountPoint, writable) {
+			continue
+		}
+
+		if err := remountMountPoint(mountPoint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// remountMountPoint re-mounts a single mount point as read-only.
+func remountMountPoint(mountPoint string) error {
+	var stat unix.Statfs_t
+
+	if err := unix.Statfs(mountPoint, &stat); err != nil {
+		// The mount point is shadowed by another mount or is not accessible to us
+		if errors.Is(err, unix.ENOENT) || errors.Is(err, unix.EACCES) {
+			return nil
+		}
+
+		return err
+	}
+
+	if int64(stat.Flags)&unix.ST_RDONLY != 0 {
+		return nil
+	}
+
+	flags := uintptr(unix.MS_BIND | unix.MS_REMOUNT | unix.MS_RDONLY)
+
+	for statFlag, mountFlag := range preservedFlags {
+		if int64(stat.Flags)&statFlag != 0 {
+			flags |= mountFlag
+		}
+	}
+
+	if err := unix.Mount("", mountPoint, "", flags, ""); err != nil {
+		return fmt.Errorf("failed to re-mount %s as read-only: %w", mountPoint, err)
+	}
+
+	return nil
+}
+
+// parseMountInfo returns the mount points listed in the /proc/self/mountinfo.
+func parseMountInfo(r io.Reader) ([]string, error) {
+	var result []string
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+
+		result = append(result, unescapeMountInfo(fields[4]))
+	}
+
+	return result, scanner.Err()
+}
+
+// unescapeMountInfo decodes the octal escapes (e.g. "\040" for a space) used in the mountinfo.
+func unescapeMountInfo(s string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if value, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(value))
+				i += 3
+
+				continue
+			}
+		}
+
+		sb.WriteByte(s[i])
+	}
+
+	return sb.String()
+}
+
+func isUnder(path string, parent string) bool {
+	return path == parent || strings.HasPrefix(path, strings.TrimSuffix(parent, "/")+"/")
+}
+
+func isUnderAny(path string, parents []string) bool {
+	for _, parent := range parents {
+		if isUnder(path, parent) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dropCapabilitiesAndExec replaces the current process with the command, making sure that
+// the command won't have any capabilities (e.g. to re-mount the filesystem as writable or
+// to escape the chroot) despite running as root in the sandbox's user namespace.
+func dropCapabilitiesAndExec(args []string) error {
+	// The capabilities are per-thread, so drop them on the thread that calls execve(2)
+	runtime.LockOSThread()
+
+	for capability := 0; capability <= maxCapability; capability++ {
+		err := unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(capability), 0, 0, 0)
+		if err != nil && !errors.Is(err, unix.EINVAL) {
+			return fmt.Errorf("failed to drop the capability %d: %w", capability, err)
+		}
+	}
+
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("failed to set the no_new_privs: %w", err)
+	}
+
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return err
+	}
+
+	return syscall.Exec(path, args, os.Environ())
+}
+
+// reap waits for the command to exit while reaping the orphaned processes re-parented to us as the PID 1.
+func reap(pid int) (int, error) {
+	for {
+		var status unix.WaitStatus
+
+		wpid, err := unix.Wait4(-1, &status, 0, nil)
+		if err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+
+			return 0, err
+		}
+
+		if wpid != pid {
+			continue
+		}
+
+		if status.Signaled() {
+			return 128 + int(status.Signal()), nil
+		}
+
+		return status.ExitStatus(), nil
+	}
+}
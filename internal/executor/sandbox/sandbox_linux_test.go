@@ -0,0 +1,75 @@
+package sandbox
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+// TestMain lets the test binary act as the agent when re-executed by Wrap.
+func TestMain(m *testing.M) {
+	if len(os.Args) > 3 && os.Args[1] == "-"+Flag && os.Args[3] == "--" {
+		exitCode, err := Run(os.Args[2], os.Args[4:])
+		if err != nil {
+			_, _ = os.Stderr.WriteString(err.Error() + "\n")
+			os.Exit(1)
+		}
+		os.Exit(exitCode)
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestParseMountInfo(t *testing.T) {
+	mountInfo := "22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw\n" +
+		"35 22 0:30 / /mnt/with\\040space rw,nosuid shared:15 - tmpfs tmpfs rw\n"
+
+	mountPoints, err := parseMountInfo(strings.NewReader(mountInfo))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/", "/mnt/with space"}, mountPoints)
+}
+
+func TestIsUnder(t *testing.T) {
+	assert.True(t, isUnder("/tmp/root", "/tmp/root"))
+	assert.True(t, isUnder("/tmp/root/proc", "/tmp/root"))
+	assert.False(t, isUnder("/tmp/rootfs", "/tmp/root"))
+	assert.True(t, isUnder("/tmp/root/anything", "/"))
+}
+
+func TestWrap(t *testing.T) {
+	workingDir := t.TempDir()
+	outsideDir := t.TempDir()
+
+	cmd := exec.Command("/bin/sh", "-c", "echo inside > inside.txt && "+
+		"(echo outside > "+filepath.Join(outsideDir, "outside.txt")+" || true) && "+
+		"ls /dev && exit 3")
+	cmd.Dir = workingDir
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	require.NoError(t, Wrap(cmd, Config{WorkingDir: workingDir, Writable: []string{workingDir}}))
+
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 ||
+		errors.Is(err, syscall.EPERM) || errors.Is(err, syscall.EINVAL) {
+		t.Skipf("user namespaces are not available: %v\n%s", err, output.String())
+	}
+
+	require.True(t, errors.As(err, &exitErr), "%v\n%s", err, output.String())
+	require.Equal(t, 3, exitErr.ExitCode(), output.String())
+
+	assert.FileExists(t, filepath.Join(workingDir, "inside.txt"))
+	assert.NoFileExists(t, filepath.Join(outsideDir, "outside.txt"))
+	assert.NotContains(t, output.String(), "sda")
+}
@@ -0,0 +1,15 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package sandbox
+
+import "os/exec"
+
+func wrap(cmd *exec.Cmd, config Config) error {
+	return ErrUnsupported
+}
+
+// Run is only used on Linux.
+func Run(encodedConfig string, args []string) (int, error) {
+	return 0, ErrUnsupported
+}
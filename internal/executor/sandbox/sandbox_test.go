@@ -0,0 +1,34 @@
+package sandbox
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestConfigRoundTrip(t *testing.T) {
+	config := &Config{
+		WorkingDir: "/tmp/cirrus-ci-build",
+		Writable:   []string{"/tmp/cirrus-ci-build", "/root/.cache"},
+		ReadOnly:   []string{"/tmp/scripts.sh"},
+	}
+
+	encoded, err := encodeConfig(config)
+	require.NoError(t, err)
+
+	decoded, err := decodeConfig(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, config, decoded)
+}
+
+func TestSeatbeltProfile(t *testing.T) {
+	profile := seatbeltProfile(Config{
+		Writable: []string{"/Users/admin/work", "/Users/admin/with \"quotes\""},
+	}, "/private/var/folders/xx/T")
+
+	assert.Contains(t, profile, "(deny file-write*)\n")
+	assert.Contains(t, profile, "(literal \"/dev/null\")")
+	assert.Contains(t, profile, "(subpath \"/Users/admin/work\")")
+	assert.Contains(t, profile, "(subpath \"/Users/admin/with \\\"quotes\\\"\")")
+	assert.Contains(t, profile, "(subpath \"/private/var/folders/xx/T\"))\n")
+}
@@ -0,0 +1,179 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/bmatcuk/doublestar"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sbom"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sbomDir is relative to the CIRRUS_WORKING_DIR since the artifacts can only be uploaded from there.
+const sbomDir = ".cirrus-sbom"
+
+var unsafeFileNameCharacters = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type sbomSummary struct {
+	Format     string         `json:"format"`
+	Path       string         `json:"path"`
+	Components int            `json:"components"`
+	Ecosystems map[string]int `json:"ecosystems"`
+}
+
+// generateSBOM scans the directories matching the artifacts instruction paths (or the CIRRUS_WORKING_DIR
+// when there are none) and returns the instruction that uploads the resulting SBOM document instead.
+func (executor *Executor) generateSBOM(
+	ctx context.Context,
+	logUploader *LogUploader,
+	name string,
+	artifactsInstruction *api.ArtifactsInstruction,
+	customEnv *environment.Environment,
+) (*api.ArtifactsInstruction, error) {
+	format := artifactsInstruction.Format
+	workingDir := customEnv.Get("CIRRUS_WORKING_DIR")
+	outputDir := filepath.Join(workingDir, sbomDir)
+
+	roots, err := sbomRoots(workingDir, artifactsInstruction.Paths, customEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	var components []sbom.Component
+
+	for _, root := range roots {
+		rootComponents, err := sbom.Scan(root, outputDir)
+		if err != nil {
+			return nil, err
+		}
+
+		relativeRoot, err := filepath.Rel(workingDir, root)
+		if err != nil {
+			relativeRoot = root
+		}
+
+		for _, component := range rootComponents {
+			component.Location = path.Join(filepath.ToSlash(relativeRoot), component.Location)
+			components = append(components, component)
+		}
+	}
+
+	components = sbom.Deduplicate(components)
+
+	document, err := sbom.Encode(format, sbom.Subject{
+		Name:    customEnv.Get("CIRRUS_REPO_FULL_NAME"),
+		Version: customEnv.Get("CIRRUS_CHANGE_IN_REPO"),
+	}, components, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(outputDir, 0700); err != nil {
+		return nil, err
+	}
+
+	fileName := unsafeFileNameCharacters.ReplaceAllString(name, "_") + sbom.Extension(format)
+	if err := os.WriteFile(filepath.Join(outputDir, fileName), document, 0600); err != nil {
+		return nil, err
+	}
+
+	relativePath := sbomDir + "/" + fileName
+
+	summary := sbomSummary{
+		Format:     format,
+		Path:       relativePath,
+		Components: len(components),
+		Ecosystems: map[string]int{},
+	}
+	for _, component := range components {
+		summary.Ecosystems[component.Ecosystem]++
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "Generated %s SBOM with %d components: %s\n", format, len(components),
+		formatEcosystemCounts(summary.Ecosystems))
+
+	executor.reportSBOMSummary(ctx, summary)
+
+	return &api.ArtifactsInstruction{
+		Paths: []string{relativePath},
+		Type:  sbom.ContentType(format),
+	}, nil
+}
+
+func (executor *Executor) reportSBOMSummary(ctx context.Context, summary sbomSummary) {
+	rawDetails, err := json.Marshal(summary)
+	if err != nil {
+		log.Printf("Failed to serialize the SBOM summary: %v", err)
+
+		return
+	}
+
+	_, err = client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: executor.taskIdentification,
+		Annotations: []*api.Annotation{
+			{
+				Type:  api.Annotation_GENERIC,
+				Level: api.Annotation_NOTICE,
+				Message: fmt.Sprintf("SBOM (%s) with %d components: %s", summary.Format, summary.Components,
+					formatEcosystemCounts(summary.Ecosystems)),
+				RawDetails: string(rawDetails),
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("Failed to report the SBOM summary: %v", err)
+	}
+}
+
+func sbomRoots(workingDir string, paths []string, customEnv *environment.Environment) ([]string, error) {
+	if len(paths) == 0 {
+		return []string{workingDir}, nil
+	}
+
+	var result []string
+
+	for _, pattern := range absolutePatterns(workingDir, paths) {
+		matches, err := doublestar.Glob(customEnv.ExpandText(pattern))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, match := range matches {
+			if info, err := os.Stat(match); err == nil && info.IsDir() {
+				result = append(result, match)
+			}
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no directories to scan match %s", strings.Join(paths, ", "))
+	}
+
+	return result, nil
+}
+
+func formatEcosystemCounts(ecosystems map[string]int) string {
+	if len(ecosystems) == 0 {
+		return "no supported manifests found"
+	}
+
+	var counts []string
+
+	for ecosystem, count := range ecosystems {
+		counts = append(counts, fmt.Sprintf("%d %s", count, ecosystem))
+	}
+
+	sort.Strings(counts)
+
+	return strings.Join(counts, ", ")
+}
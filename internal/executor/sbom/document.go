@@ -0,0 +1,217 @@
+package sbom
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+const (
+	FormatCycloneDX = "cyclonedx"
+	FormatSPDX      = "spdx"
+
+	toolName = "cirrus-ci-agent"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported SBOM format")
+
+// Subject describes the project that the SBOM is generated for.
+type Subject struct {
+	// Name is typically the repository name (e.g. "cirruslabs/cirrus-ci-agent")
+	Name string
+
+	// Version is typically the commit SHA
+	Version string
+}
+
+// IsFormat returns true if the format is one of the supported SBOM formats.
+func IsFormat(format string) bool {
+	return format == FormatCycloneDX || format == FormatSPDX
+}
+
+// ContentType returns the media type of the documents in the specified format.
+func ContentType(format string) string {
+	if format == FormatSPDX {
+		return "application/spdx+json"
+	}
+
+	return "application/vnd.cyclonedx+json"
+}
+
+// Extension returns the conventional file extension for the documents in the specified format.
+func Extension(format string) string {
+	if format == FormatSPDX {
+		return ".spdx.json"
+	}
+
+	return ".cdx.json"
+}
+
+// Encode serializes the components into a JSON document in the specified format.
+func Encode(format string, subject Subject, components []Component, created time.Time) ([]byte, error) {
+	switch format {
+	case FormatCycloneDX:
+		return json.MarshalIndent(cycloneDX(subject, components, created), "", "  ")
+	case FormatSPDX:
+		return json.MarshalIndent(spdx(subject, components, created), "", "  ")
+	default:
+		return nil, fmt.Errorf("%w %q, supported formats are: %s and %s",
+			ErrUnsupportedFormat, format, FormatCycloneDX, FormatSPDX)
+	}
+}
+
+type cycloneDXDocument struct {
+	BOMFormat    string               `json:"bomFormat"`
+	SpecVersion  string               `json:"specVersion"`
+	SerialNumber string               `json:"serialNumber"`
+	Version      int                  `json:"version"`
+	Metadata     cycloneDXMetadata    `json:"metadata"`
+	Components   []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Name string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	Type       string              `json:"type"`
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func cycloneDX(subject Subject, components []Component, created time.Time) *cycloneDXDocument {
+	document := &cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.4",
+		SerialNumber: "urn:uuid:" + uuid.New().String(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: created.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Name: toolName}},
+			Component: cycloneDXComponent{
+				Type:    "application",
+				Name:    subject.Name,
+				Version: subject.Version,
+			},
+		},
+		Components: []cycloneDXComponent{},
+	}
+
+	for _, component := range components {
+		purl := component.PURL()
+
+		document.Components = append(document.Components, cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  purl,
+			Name:    component.Name,
+			Version: component.Version,
+			PURL:    purl,
+			Properties: []cycloneDXProperty{
+				{Name: "cirrus:location", Value: component.Location},
+			},
+		})
+	}
+
+	return document
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name             string            `json:"name"`
+	SPDXID           string            `json:"SPDXID"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	SourceInfo       string            `json:"sourceInfo,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+func spdx(subject Subject, components []Component, created time.Time) *spdxDocument {
+	const rootID = "SPDXRef-Root"
+
+	document := &spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              subject.Name,
+		DocumentNamespace: "https://spdx.org/spdxdocs/" + toolName + "/" + uuid.New().String(),
+		CreationInfo: spdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName},
+		},
+		Packages: []spdxPackage{
+			{
+				Name:             subject.Name,
+				SPDXID:           rootID,
+				VersionInfo:      subject.Version,
+				DownloadLocation: "NOASSERTION",
+			},
+		},
+		Relationships: []spdxRelationship{
+			{SPDXElementID: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSPDXElement: rootID},
+		},
+	}
+
+	for i, component := range components {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+
+		document.Packages = append(document.Packages, spdxPackage{
+			Name:             component.Name,
+			SPDXID:           id,
+			VersionInfo:      component.Version,
+			DownloadLocation: "NOASSERTION",
+			SourceInfo:       "declared in " + component.Location,
+			ExternalRefs: []spdxExternalRef{
+				{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: component.PURL()},
+			},
+		})
+		document.Relationships = append(document.Relationships, spdxRelationship{
+			SPDXElementID: rootID, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: id,
+		})
+	}
+
+	return document
+}
@@ -0,0 +1,139 @@
+package sbom_test
+
+import (
+	"encoding/json"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sbom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func TestScan(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "go.mod"), `module example.com/app
+
+go 1.17
+
+require github.com/pkg/errors v0.9.1
+
+require (
+	github.com/stretchr/testify v1.8.1
+	golang.org/x/sys v0.5.0 // indirect
+)
+`)
+	writeFile(t, filepath.Join(dir, "web", "package-lock.json"), `{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "web"},
+    "node_modules/@babel/core": {"version": "7.21.0"},
+    "node_modules/left-pad/node_modules/lodash": {"version": "4.17.21"},
+    "node_modules/local": {"link": true}
+  }
+}`)
+	writeFile(t, filepath.Join(dir, "web", "node_modules", "lodash", "package-lock.json"),
+		`{"packages": {"node_modules/ignored": {"version": "1.0.0"}}}`)
+	writeFile(t, filepath.Join(dir, "tools", "requirements.txt"), `# tools
+Django_Utils[extra]==4.1 ; python_version >= "3.8"
+requests>=2
+`)
+	writeFile(t, filepath.Join(dir, "Cargo.lock"), `[[package]]
+name = "app"
+version = "0.1.0"
+
+[[package]]
+name = "serde"
+version = "1.0.152"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+`)
+
+	components, err := sbom.Scan(dir)
+	require.NoError(t, err)
+
+	var purls []string
+	for _, component := range components {
+		purls = append(purls, component.PURL())
+	}
+
+	assert.Equal(t, []string{
+		"pkg:cargo/serde@1.0.152",
+		"pkg:golang/github.com/pkg/errors@v0.9.1",
+		"pkg:golang/github.com/stretchr/testify@v1.8.1",
+		"pkg:golang/golang.org/x/sys@v0.5.0",
+		"pkg:npm/%40babel/core@7.21.0",
+		"pkg:npm/lodash@4.17.21",
+		"pkg:pypi/django-utils@4.1",
+	}, purls)
+
+	assert.Equal(t, "web/package-lock.json", components[4].Location)
+}
+
+func TestScanPackageLockV1(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "package-lock.json"), `{
+  "lockfileVersion": 1,
+  "dependencies": {
+    "express": {"version": "4.18.2", "dependencies": {"debug": {"version": "2.6.9"}}}
+  }
+}`)
+
+	components, err := sbom.Scan(dir)
+	require.NoError(t, err)
+	require.Len(t, components, 2)
+	assert.Equal(t, "pkg:npm/debug@2.6.9", components[0].PURL())
+	assert.Equal(t, "pkg:npm/express@4.18.2", components[1].PURL())
+}
+
+func TestEncode(t *testing.T) {
+	subject := sbom.Subject{Name: "acme/app", Version: "abc123"}
+	components := []sbom.Component{
+		{Ecosystem: sbom.EcosystemGo, Name: "github.com/pkg/errors", Version: "v0.9.1", Location: "go.mod"},
+	}
+
+	cycloneDX, err := sbom.Encode(sbom.FormatCycloneDX, subject, components, time.Unix(0, 0))
+	require.NoError(t, err)
+
+	var cycloneDXDocument struct {
+		BOMFormat  string
+		Components []struct {
+			Name string
+			PURL string
+		}
+	}
+	require.NoError(t, json.Unmarshal(cycloneDX, &cycloneDXDocument))
+	assert.Equal(t, "CycloneDX", cycloneDXDocument.BOMFormat)
+	require.Len(t, cycloneDXDocument.Components, 1)
+	assert.Equal(t, "pkg:golang/github.com/pkg/errors@v0.9.1", cycloneDXDocument.Components[0].PURL)
+
+	spdx, err := sbom.Encode(sbom.FormatSPDX, subject, components, time.Unix(0, 0))
+	require.NoError(t, err)
+
+	var spdxDocument struct {
+		SPDXVersion string
+		Packages    []struct {
+			Name        string
+			VersionInfo string
+		}
+		Relationships []struct {
+			RelationshipType string
+		}
+	}
+	require.NoError(t, json.Unmarshal(spdx, &spdxDocument))
+	assert.Equal(t, "SPDX-2.3", spdxDocument.SPDXVersion)
+	require.Len(t, spdxDocument.Packages, 2)
+	assert.Equal(t, "acme/app", spdxDocument.Packages[0].Name)
+	assert.Equal(t, "v0.9.1", spdxDocument.Packages[1].VersionInfo)
+	assert.Len(t, spdxDocument.Relationships, 2)
+
+	_, err = sbom.Encode("swid", subject, components, time.Now())
+	assert.ErrorIs(t, err, sbom.ErrUnsupportedFormat)
+}
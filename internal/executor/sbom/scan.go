@@ -0,0 +1,309 @@
+// Package sbom generates the software bill of materials of a directory tree by scanning
+// the lock files and manifests of the common package managers, without any external tools.
+package sbom
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	EcosystemGo    = "golang"
+	EcosystemNPM   = "npm"
+	EcosystemPyPI  = "pypi"
+	EcosystemCargo = "cargo"
+)
+
+// Component is a third-party package that the scanned project depends on.
+type Component struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+
+	// Location is the slash-separated path of the manifest the component was found in,
+	// relative to the scanned directory
+	Location string `json:"location"`
+}
+
+// PURL returns the package URL (https://github.com/package-url/purl-spec) of the component.
+func (component Component) PURL() string {
+	name := component.Name
+
+	switch component.Ecosystem {
+	case EcosystemNPM:
+		// The scope's "@" is a part of the namespace, so it has to be encoded
+		name = strings.Replace(name, "@", "%40", 1)
+	case EcosystemPyPI:
+		name = strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+	}
+
+	return "pkg:" + component.Ecosystem + "/" + name + "@" + url.PathEscape(component.Version)
+}
+
+type parser func(r io.Reader) ([]Component, error)
+
+var parsers = map[string]parser{
+	"go.mod":            parseGoMod,
+	"package-lock.json": parsePackageLock,
+	"requirements.txt":  parseRequirements,
+	"Cargo.lock":        parseCargoLock,
+}
+
+// skippedDirs contain either the VCS metadata or the dependencies themselves,
+// which are already accounted for by the lock files of the project
+var skippedDirs = map[string]struct{}{
+	".git":         {},
+	"node_modules": {},
+	"vendor":       {},
+	"target":       {},
+}
+
+// Scan finds the components declared in the supported manifests inside of the root
+// directory (recursively), skipping the excluded absolute paths.
+func Scan(root string, excluded ...string) ([]Component, error) {
+	var result []Component
+
+	err := filepath.WalkDir(root, func(walkPath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if _, ok := skippedDirs[entry.Name()]; ok && walkPath != root {
+				return filepath.SkipDir
+			}
+
+			for _, excludedPath := range excluded {
+				if walkPath == excludedPath {
+					return filepath.SkipDir
+				}
+			}
+
+			return nil
+		}
+
+		parse, ok := parsers[entry.Name()]
+		if !ok {
+			return nil
+		}
+
+		location, err := filepath.Rel(root, walkPath)
+		if err != nil {
+			return err
+		}
+
+		components, err := parseFile(walkPath, parse)
+		if err != nil {
+			return &os.PathError{Op: "parse", Path: walkPath, Err: err}
+		}
+
+		for _, component := range components {
+			component.Location = filepath.ToSlash(location)
+			result = append(result, component)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return Deduplicate(result), nil
+}
+
+// Deduplicate removes the components with the same package URL and sorts them by it.
+func Deduplicate(components []Component) []Component {
+	seen := map[string]struct{}{}
+	var result []Component
+
+	for _, component := range components {
+		purl := component.PURL()
+
+		if _, ok := seen[purl]; ok {
+			continue
+		}
+		seen[purl] = struct{}{}
+
+		result = append(result, component)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].PURL() < result[j].PURL()
+	})
+
+	return result
+}
+
+func parseFile(path string, parse parser) ([]Component, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return parse(file)
+}
+
+func parseGoMod(r io.Reader) ([]Component, error) {
+	var result []Component
+	var inRequireBlock bool
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if idx := strings.Index(line, "//"); idx != -1 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+
+		switch {
+		case len(fields) == 0:
+			continue
+		case inRequireBlock && fields[0] == ")":
+			inRequireBlock = false
+		case fields[0] == "require" && len(fields) == 2 && fields[1] == "(":
+			inRequireBlock = true
+		case fields[0] == "require" && len(fields) == 3:
+			result = append(result, Component{Ecosystem: EcosystemGo, Name: fields[1], Version: fields[2]})
+		case inRequireBlock && len(fields) == 2:
+			result = append(result, Component{Ecosystem: EcosystemGo, Name: fields[0], Version: fields[1]})
+		}
+	}
+
+	return result, scanner.Err()
+}
+
+type packageLockDependency struct {
+	Version      string                           `json:"version"`
+	Link         bool                             `json:"link"`
+	Dependencies map[string]packageLockDependency `json:"dependencies"`
+}
+
+type packageLock struct {
+	Packages     map[string]packageLockDependency `json:"packages"`
+	Dependencies map[string]packageLockDependency `json:"dependencies"`
+}
+
+func parsePackageLock(r io.Reader) ([]Component, error) {
+	var lock packageLock
+
+	if err := json.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+
+	var result []Component
+
+	// Lockfile v2 and v3 list all of the installed packages by their path
+	if len(lock.Packages) != 0 {
+		for packagePath, dependency := range lock.Packages {
+			idx := strings.LastIndex(packagePath, "node_modules/")
+			if idx == -1 || dependency.Link || dependency.Version == "" {
+				continue
+			}
+
+			result = append(result, Component{
+				Ecosystem: EcosystemNPM,
+				Name:      packagePath[idx+len("node_modules/"):],
+				Version:   dependency.Version,
+			})
+		}
+
+		return result, nil
+	}
+
+	// Lockfile v1 nests the dependencies instead
+	var collect func(dependencies map[string]packageLockDependency)
+	collect = func(dependencies map[string]packageLockDependency) {
+		for name, dependency := range dependencies {
+			if dependency.Version != "" {
+				result = append(result, Component{Ecosystem: EcosystemNPM, Name: name, Version: dependency.Version})
+			}
+
+			collect(dependency.Dependencies)
+		}
+	}
+	collect(lock.Dependencies)
+
+	return result, nil
+}
+
+var requirementRegex = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)(\[[^]]*])?\s*===?\s*([^\s;#]+)`)
+
+// parseRequirements only picks up the pinned requirements, since
+// there's no way to know the version that was installed otherwise
+func parseRequirements(r io.Reader) ([]Component, error) {
+	var result []Component
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		matches := requirementRegex.FindStringSubmatch(strings.TrimSpace(scanner.Text()))
+		if matches == nil {
+			continue
+		}
+
+		result = append(result, Component{Ecosystem: EcosystemPyPI, Name: matches[1], Version: matches[3]})
+	}
+
+	return result, scanner.Err()
+}
+
+// parseCargoLock picks up the [[package]] entries that come from a registry or a repository,
+// skipping the crates of the workspace itself
+func parseCargoLock(r io.Reader) ([]Component, error) {
+	var result []Component
+	var current map[string]string
+
+	flush := func() {
+		if current != nil && current["source"] != "" {
+			result = append(result, Component{
+				Ecosystem: EcosystemCargo,
+				Name:      current["name"],
+				Version:   current["version"],
+			})
+		}
+
+		current = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "[") {
+			flush()
+
+			if line == "[[package]]" {
+				current = map[string]string{}
+			}
+
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		current[strings.TrimSpace(parts[0])] = strings.Trim(strings.TrimSpace(parts[1]), `"`)
+	}
+
+	flush()
+
+	return result, scanner.Err()
+}
@@ -0,0 +1,131 @@
+// Package screenrecorder records the screen into a video file using FFmpeg
+// (AVFoundation on macOS, GDI on Windows and X11 on Linux, with Xvfb when there's no display).
+package screenrecorder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultFramerate = 10
+
+	stopTimeout = 10 * time.Second
+)
+
+var ErrFFmpegNotFound = errors.New("ffmpeg is required to record the screen, but it was not found in the PATH")
+
+type Options struct {
+	// Output is the path of the resulting MP4 file
+	Output string
+
+	Framerate int
+
+	// Display is the X11 display to record on Linux, an Xvfb display is started when it's empty
+	Display string
+}
+
+type Recorder struct {
+	ffmpeg *exec.Cmd
+	stdin  io.WriteCloser
+	done   chan error
+
+	virtualDisplay *virtualDisplay
+}
+
+// Start starts recording the screen until the Stop is called.
+func Start(ctx context.Context, options Options) (*Recorder, error) {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return nil, ErrFFmpegNotFound
+	}
+
+	if options.Framerate <= 0 {
+		options.Framerate = DefaultFramerate
+	}
+
+	recorder := &Recorder{
+		done: make(chan error, 1),
+	}
+
+	if needsVirtualDisplay(options.Display) {
+		recorder.virtualDisplay, err = startVirtualDisplay(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		options.Display = recorder.virtualDisplay.Name
+	}
+
+	recorder.ffmpeg = exec.CommandContext(ctx, ffmpegPath,
+		Args(inputArgs(options.Display), options.Framerate, options.Output)...)
+
+	recorder.stdin, err = recorder.ffmpeg.StdinPipe()
+	if err != nil {
+		recorder.stopVirtualDisplay()
+
+		return nil, err
+	}
+
+	if err := recorder.ffmpeg.Start(); err != nil {
+		recorder.stopVirtualDisplay()
+
+		return nil, fmt.Errorf("failed to start ffmpeg: %w", err)
+	}
+
+	go func() {
+		recorder.done <- recorder.ffmpeg.Wait()
+	}()
+
+	return recorder, nil
+}
+
+// Display returns the X11 display that the Xvfb was started on (if any),
+// the recorded programs should use it via the DISPLAY environment variable.
+func (recorder *Recorder) Display() string {
+	if recorder.virtualDisplay == nil {
+		return ""
+	}
+
+	return recorder.virtualDisplay.Name
+}
+
+// Stop gracefully stops the recording so that the video file is properly finalized.
+func (recorder *Recorder) Stop() error {
+	defer recorder.stopVirtualDisplay()
+
+	// Asks FFmpeg to finish the recording
+	_, _ = recorder.stdin.Write([]byte("q"))
+	_ = recorder.stdin.Close()
+
+	select {
+	case err := <-recorder.done:
+		return err
+	case <-time.After(stopTimeout):
+		_ = recorder.ffmpeg.Process.Kill()
+		<-recorder.done
+
+		return fmt.Errorf("ffmpeg didn't finish the recording in %v", stopTimeout)
+	}
+}
+
+func (recorder *Recorder) stopVirtualDisplay() {
+	if recorder.virtualDisplay != nil {
+		recorder.virtualDisplay.Stop()
+	}
+}
+
+// Args returns the FFmpeg arguments that record the specified input into an MP4 file
+// that is playable in the browsers.
+func Args(input []string, framerate int, output string) []string {
+	args := []string{"-hide_banner", "-loglevel", "error", "-framerate", strconv.Itoa(framerate)}
+	args = append(args, input...)
+
+	return append(args, "-c:v", "libx264", "-preset", "ultrafast", "-pix_fmt", "yuv420p",
+		"-vf", "pad=ceil(iw/2)*2:ceil(ih/2)*2", "-y", output)
+}
@@ -0,0 +1,24 @@
+package screenrecorder
+
+import "context"
+
+func inputArgs(display string) []string {
+	// Requires the Screen Recording permission granted to the agent
+	return []string{"-f", "avfoundation", "-capture_cursor", "1", "-i", "Capture screen 0:none"}
+}
+
+func needsVirtualDisplay(display string) bool {
+	return false
+}
+
+type virtualDisplay struct {
+	Name string
+}
+
+func startVirtualDisplay(ctx context.Context) (*virtualDisplay, error) {
+	return &virtualDisplay{}, nil
+}
+
+func (display *virtualDisplay) Stop() {
+	// nothing
+}
@@ -0,0 +1,80 @@
+package screenrecorder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+)
+
+const virtualDisplayStartTimeout = 5 * time.Second
+
+func inputArgs(display string) []string {
+	return []string{"-f", "x11grab", "-draw_mouse", "1", "-i", display}
+}
+
+func needsVirtualDisplay(display string) bool {
+	return display == ""
+}
+
+type virtualDisplay struct {
+	Name string
+
+	cmd *exec.Cmd
+}
+
+func startVirtualDisplay(ctx context.Context) (*virtualDisplay, error) {
+	xvfbPath, err := exec.LookPath("Xvfb")
+	if err != nil {
+		return nil, errors.New("there's no display to record and Xvfb was not found in the PATH")
+	}
+
+	number, err := freeDisplayNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	name := fmt.Sprintf(":%d", number)
+
+	cmd := exec.CommandContext(ctx, xvfbPath, name, "-screen", "0", "1920x1080x24", "-nolisten", "tcp")
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start Xvfb: %w", err)
+	}
+
+	display := &virtualDisplay{Name: name, cmd: cmd}
+
+	// Wait for the Xvfb to start accepting the connections
+	socket := fmt.Sprintf("/tmp/.X11-unix/X%d", number)
+	deadline := time.Now().Add(virtualDisplayStartTimeout)
+
+	for {
+		if _, err := os.Stat(socket); err == nil {
+			return display, nil
+		}
+
+		if time.Now().After(deadline) {
+			display.Stop()
+
+			return nil, fmt.Errorf("Xvfb didn't start in %v", virtualDisplayStartTimeout)
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (display *virtualDisplay) Stop() {
+	_ = display.cmd.Process.Kill()
+	_ = display.cmd.Wait()
+}
+
+func freeDisplayNumber() (int, error) {
+	for number := 99; number < 200; number++ {
+		if _, err := os.Stat(fmt.Sprintf("/tmp/.X%d-lock", number)); os.IsNotExist(err) {
+			return number, nil
+		}
+	}
+
+	return 0, errors.New("failed to find a free X11 display number for the Xvfb")
+}
@@ -0,0 +1,26 @@
+//go:build !linux && !darwin && !windows
+// +build !linux,!darwin,!windows
+
+package screenrecorder
+
+import "context"
+
+func inputArgs(display string) []string {
+	return []string{"-f", "x11grab", "-draw_mouse", "1", "-i", display}
+}
+
+func needsVirtualDisplay(display string) bool {
+	return false
+}
+
+type virtualDisplay struct {
+	Name string
+}
+
+func startVirtualDisplay(ctx context.Context) (*virtualDisplay, error) {
+	return &virtualDisplay{}, nil
+}
+
+func (display *virtualDisplay) Stop() {
+	// nothing
+}
@@ -0,0 +1,13 @@
+package screenrecorder
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestArgs(t *testing.T) {
+	args := Args([]string{"-f", "x11grab", "-i", ":99"}, 15, "/tmp/recording.mp4")
+
+	assert.Equal(t, []string{"-framerate", "15", "-f", "x11grab", "-i", ":99"}, args[3:9])
+	assert.Equal(t, "/tmp/recording.mp4", args[len(args)-1])
+}
@@ -0,0 +1,24 @@
+package screenrecorder
+
+import "context"
+
+func inputArgs(display string) []string {
+	// Only records the interactive session the agent runs in, services have no desktop to capture
+	return []string{"-f", "gdigrab", "-draw_mouse", "1", "-i", "desktop"}
+}
+
+func needsVirtualDisplay(display string) bool {
+	return false
+}
+
+type virtualDisplay struct {
+	Name string
+}
+
+func startVirtualDisplay(ctx context.Context) (*virtualDisplay, error) {
+	return &virtualDisplay{}, nil
+}
+
+func (display *virtualDisplay) Stop() {
+	// nothing
+}
@@ -0,0 +1,145 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/screenrecorder"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PluginScreenRecording is the built-in plugin that records the screen for the duration
+// of the script steps that follow it. It's configured with the following properties:
+//
+//   - steps — comma-separated names of the steps to record (all the following script steps by default)
+//   - upload — "on_failure" (default) to only upload the recordings of the failed steps or "always"
+//   - framerate — frames per second (10 by default)
+const PluginScreenRecording = "screen_recording"
+
+var unsafeArtifactNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+type screenRecording struct {
+	// steps to record, all the script steps when empty
+	steps        map[string]struct{}
+	uploadAlways bool
+	framerate    int
+}
+
+func (executor *Executor) configureScreenRecording(
+	ctx context.Context,
+	logUploader *LogUploader,
+	command *api.Command,
+) bool {
+	recording := &screenRecording{
+		steps:     map[string]struct{}{},
+		framerate: screenrecorder.DefaultFramerate,
+	}
+
+	for _, step := range strings.Split(executor.env.ExpandText(command.Properties["steps"]), ",") {
+		if step = strings.TrimSpace(step); step != "" {
+			recording.steps[step] = struct{}{}
+		}
+	}
+
+	switch upload := executor.env.ExpandText(command.Properties["upload"]); upload {
+	case "", "on_failure":
+	case "always":
+		recording.uploadAlways = true
+	default:
+		_, _ = fmt.Fprintf(logUploader, "Unsupported upload mode %q, should be either \"on_failure\" or \"always\"\n",
+			upload)
+
+		return false
+	}
+
+	if rawFramerate := executor.env.ExpandText(command.Properties["framerate"]); rawFramerate != "" {
+		framerate, err := strconv.Atoi(rawFramerate)
+		if err != nil || framerate <= 0 {
+			_, _ = fmt.Fprintf(logUploader, "Invalid framerate %q, should be a positive number\n", rawFramerate)
+
+			return false
+		}
+
+		recording.framerate = framerate
+	}
+
+	executor.screenRecording = recording
+
+	if len(recording.steps) == 0 {
+		_, _ = fmt.Fprintln(logUploader, "The screen will be recorded during the following script steps")
+	} else {
+		_, _ = fmt.Fprintf(logUploader, "The screen will be recorded during the %d step(s)\n", len(recording.steps))
+	}
+
+	return true
+}
+
+// startScreenRecording starts recording the screen if the step was requested to be recorded,
+// returning a function that stops the recording and uploads it (if needed).
+func (executor *Executor) startScreenRecording(
+	ctx context.Context,
+	logUploader *LogUploader,
+	stepName string,
+) func(success bool) {
+	recording := executor.screenRecording
+	if recording == nil {
+		return func(bool) {}
+	}
+
+	if _, ok := recording.steps[stepName]; len(recording.steps) != 0 && !ok {
+		return func(bool) {}
+	}
+
+	artifactName := unsafeArtifactNameChars.ReplaceAllString(stepName, "_") + "-screen-recording"
+
+	dir, err := executor.newDiagnosticsDir(artifactName)
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "Failed to start the screen recording: %v\n", err)
+
+		return func(bool) {}
+	}
+
+	recorder, err := screenrecorder.Start(ctx, screenrecorder.Options{
+		Output:    filepath.Join(dir, "recording.mp4"),
+		Framerate: recording.framerate,
+		Display:   executor.env.Get("DISPLAY"),
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "Failed to start the screen recording: %v\n", err)
+		_ = os.RemoveAll(dir)
+
+		return func(bool) {}
+	}
+
+	// Make the recorded programs use the virtual display
+	if display := recorder.Display(); display != "" {
+		executor.env.Set("DISPLAY", display)
+	}
+
+	return func(success bool) {
+		if recorder.Display() != "" {
+			executor.env.Unset("DISPLAY")
+		}
+
+		if err := recorder.Stop(); err != nil {
+			_, _ = fmt.Fprintf(logUploader, "\nFailed to finish the screen recording: %v\n", err)
+		}
+
+		if success && !recording.uploadAlways {
+			_ = os.RemoveAll(dir)
+
+			return
+		}
+
+		_, _ = fmt.Fprintf(logUploader, "\nUploading the screen recording as the %q artifact...\n", artifactName)
+
+		if !executor.uploadDiagnostics(ctx, logUploader, artifactName) {
+			log.Printf("Failed to upload the screen recording of %s", stepName)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/annotationserver"
+	"sync"
+)
+
+// scriptActions performs the artifact and cache uploads requested by the user scripts
+// through the annotations server on behalf of the currently running step.
+type scriptActions struct {
+	executor *Executor
+
+	// mtx serializes the actions and makes sure that none of them outlives the step
+	mtx         sync.Mutex
+	ctx         context.Context
+	logUploader *LogUploader
+	stepName    string
+}
+
+func (actions *scriptActions) enter(ctx context.Context, logUploader *LogUploader, stepName string) {
+	if actions == nil {
+		return
+	}
+
+	actions.mtx.Lock()
+	defer actions.mtx.Unlock()
+
+	actions.ctx = ctx
+	actions.logUploader = logUploader
+	actions.stepName = stepName
+}
+
+func (actions *scriptActions) leave() {
+	if actions == nil {
+		return
+	}
+
+	actions.mtx.Lock()
+	defer actions.mtx.Unlock()
+
+	actions.ctx = nil
+	actions.logUploader = nil
+	actions.stepName = ""
+}
+
+func (actions *scriptActions) UploadArtifacts(request *annotationserver.ArtifactsRequest) error {
+	actions.mtx.Lock()
+	defer actions.mtx.Unlock()
+
+	if actions.logUploader == nil {
+		return annotationserver.ErrNoStep
+	}
+
+	_, _ = fmt.Fprintf(actions.logUploader, "\nUploading the %s artifacts as requested by the script...\n", request.Name)
+
+	if !actions.executor.UploadArtifacts(actions.ctx, actions.logUploader, request.Name, &api.ArtifactsInstruction{
+		Paths:  request.Paths,
+		Type:   request.Type,
+		Format: request.Format,
+	}, actions.executor.env) {
+		return fmt.Errorf("failed to upload the %s artifacts, see the %s step's log for details",
+			request.Name, actions.stepName)
+	}
+
+	return nil
+}
+
+func (actions *scriptActions) UploadCache(request *annotationserver.CacheRequest) error {
+	actions.mtx.Lock()
+	defer actions.mtx.Unlock()
+
+	if actions.logUploader == nil {
+		return annotationserver.ErrNoStep
+	}
+
+	if actions.executor.httpCacheHost == "" {
+		return errors.New("cache is not available")
+	}
+
+	_, _ = fmt.Fprintf(actions.logUploader, "\nUploading the %s cache as requested by the script...\n", request.Name)
+
+	if !actions.executor.UploadCache(actions.ctx, actions.logUploader, actions.stepName,
+		actions.executor.httpCacheHost, &api.UploadCacheInstruction{CacheName: request.Name}) {
+		return fmt.Errorf("failed to upload the %s cache, see the %s step's log for details",
+			request.Name, actions.stepName)
+	}
+
+	return nil
+}
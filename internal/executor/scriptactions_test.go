@@ -0,0 +1,71 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/annotationserver"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestScriptActionsConcurrentWithOutput is meant to be run with -race
+func TestScriptActionsConcurrentWithOutput(t *testing.T) {
+	executor := &Executor{env: environment.NewEmpty()}
+	executor.scriptActions = &scriptActions{executor: executor}
+
+	logUploader := &LogUploader{
+		logsChannel:      make(chan []byte, 16),
+		env:              executor.env,
+		CollapseProgress: true,
+		MaxSize:          64 * 1024,
+	}
+
+	var output strings.Builder
+
+	drained := make(chan struct{})
+	go func() {
+		for chunk := range logUploader.logsChannel {
+			output.Write(chunk)
+		}
+		close(drained)
+	}()
+
+	executor.scriptActions.enter(context.Background(), logUploader, "main")
+
+	var wg sync.WaitGroup
+
+	// Script's output
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 100; i++ {
+			_, _ = logUploader.Write([]byte("progress\rdone\n"))
+		}
+	}()
+
+	// Script actions
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			require.NoError(t, executor.scriptActions.UploadArtifacts(&annotationserver.ArtifactsRequest{
+				Name: "binaries",
+			}))
+			_ = executor.bytesUploaded()
+		}()
+	}
+
+	wg.Wait()
+	executor.scriptActions.leave()
+
+	logUploader.flushHeldOutput()
+	close(logUploader.logsChannel)
+	<-drained
+
+	require.Equal(t, 100, strings.Count(output.String(), "done\n"))
+	require.Equal(t, 10, strings.Count(output.String(), "Skipping artifacts upload"))
+}
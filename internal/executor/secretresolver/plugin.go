@@ -0,0 +1,84 @@
+package secretresolver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+const (
+	EnvCirrusSecretResolver = "CIRRUS_SECRET_RESOLVER"
+
+	PluginProtocolVersion = 1
+)
+
+// PluginRequest is written to the plugin's standard input.
+type PluginRequest struct {
+	Version int               `json:"version"`
+	Values  map[string]string `json:"values"`
+}
+
+// PluginResponse is read from the plugin's standard output, the plugin
+// should exit with a non-zero code and describe the problem in the standard
+// error if it recognized a boxed value, but failed to resolve it.
+type PluginResponse struct {
+	Values map[string]string `json:"values"`
+}
+
+// Plugin is an exec-based Resolver that runs the binary once per batch.
+type Plugin struct {
+	path string
+	env  []string
+}
+
+// NewPlugin creates a plugin that is executed with the specified environment,
+// so that it can authenticate using e.g. CIRRUS_OIDC_TOKEN.
+func NewPlugin(path string, env []string) *Plugin {
+	return &Plugin{
+		path: path,
+		env:  env,
+	}
+}
+
+func (plugin *Plugin) Resolve(ctx context.Context, values map[string]string) (map[string]string, error) {
+	request, err := json.Marshal(&PluginRequest{
+		Version: PluginProtocolVersion,
+		Values:  values,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, plugin.path)
+	cmd.Env = plugin.env
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("secret resolver plugin %s failed: %w: %s", plugin.path, err,
+			strings.TrimSpace(stderr.String()))
+	}
+
+	var response PluginResponse
+
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("secret resolver plugin %s returned a malformed response: %w", plugin.path, err)
+	}
+
+	// Don't let the plugin introduce new variables
+	result := map[string]string{}
+
+	for key, value := range response.Values {
+		if _, ok := values[key]; ok {
+			result[key] = value
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,44 @@
+//go:build !windows
+// +build !windows
+
+package secretresolver_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/secretresolver"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/testutil"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPlugin(t *testing.T) {
+	pluginPath := filepath.Join(testutil.TempDir(t), "resolver")
+
+	// Resolves the first value, tries to introduce a new variable and ignores the rest
+	require.NoError(t, os.WriteFile(pluginPath, []byte(`#!/bin/sh
+grep -q '"version":1' || exit 1
+echo '{"values":{"FIRST":"resolved with '"$PLUGIN_TOKEN"'","INJECTED":"value"}}'
+`), 0700))
+
+	plugin := secretresolver.NewPlugin(pluginPath, []string{"PLUGIN_TOKEN=token"})
+
+	resolved, err := plugin.Resolve(context.Background(), map[string]string{
+		"FIRST":  "STORE[first]",
+		"SECOND": "OTHER[second]",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"FIRST": "resolved with token"}, resolved)
+}
+
+func TestPluginFailure(t *testing.T) {
+	pluginPath := filepath.Join(testutil.TempDir(t), "resolver")
+
+	require.NoError(t, os.WriteFile(pluginPath, []byte("#!/bin/sh\necho 'access denied' >&2\nexit 1\n"), 0700))
+
+	_, err := secretresolver.NewPlugin(pluginPath, nil).Resolve(context.Background(), map[string]string{
+		"FIRST": "STORE[first]",
+	})
+	require.ErrorContains(t, err, "access denied")
+}
@@ -0,0 +1,22 @@
+// Package secretresolver allows plugging secret stores not supported
+// by the agent natively into the unboxing phase.
+package secretresolver
+
+import (
+	"context"
+	"regexp"
+)
+
+// Resolver resolves the boxed values (e.g. "STORE[path/to/secret]") in batches.
+type Resolver interface {
+	// Resolve returns the secrets for the keys whose values were recognized
+	// as boxed by the resolver, leaving the rest of the keys out of the result.
+	Resolve(ctx context.Context, values map[string]string) (map[string]string, error)
+}
+
+var boxedValueRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9_]*\[.+\]$`)
+
+// LooksBoxed returns true if the value has the PREFIX[...] form used by all boxed values.
+func LooksBoxed(value string) bool {
+	return boxedValueRegexp.MatchString(value)
+}
@@ -0,0 +1,15 @@
+package secretresolver_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/secretresolver"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLooksBoxed(t *testing.T) {
+	require.True(t, secretresolver.LooksBoxed("CYBERARK[safe/account]"))
+	require.True(t, secretresolver.LooksBoxed("MY_STORE2[secret]"))
+	require.False(t, secretresolver.LooksBoxed("plain value"))
+	require.False(t, secretresolver.LooksBoxed("lower[case]"))
+	require.False(t, secretresolver.LooksBoxed("EMPTY[]"))
+}
@@ -0,0 +1,181 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/secretscan"
+	"log"
+	"sort"
+	"strings"
+)
+
+const (
+	// EnvCirrusSecretScan set to "true" masks the potential secrets (known credential formats and
+	// high-entropy values assigned to secret-looking names) in the logs and flags them in the artifacts,
+	// while "strict" also refuses to upload the artifacts that contain them.
+	EnvCirrusSecretScan = "CIRRUS_SECRET_SCAN"
+
+	secretScanStrict = "strict"
+)
+
+type secretFindingsDetails struct {
+	Command  string         `json:"command"`
+	Findings map[string]int `json:"findings"`
+}
+
+func newSecretScanner(env *environment.Environment) *secretscan.Scanner {
+	switch env.Get(EnvCirrusSecretScan) {
+	case "true", secretScanStrict:
+		return secretscan.New(secretscan.DefaultRules)
+	default:
+		return nil
+	}
+}
+
+func (uploader *LogUploader) WithMaskedSecrets(input []byte) []byte {
+	if uploader.SecretScanner == nil {
+		return input
+	}
+
+	masked, findings := uploader.SecretScanner.Mask(input, []byte(maskReplacement))
+
+	for _, finding := range findings {
+		if uploader.secretFindings == nil {
+			uploader.secretFindings = map[string]int{}
+		}
+
+		uploader.secretFindings[finding.Rule]++
+	}
+
+	return masked
+}
+
+// reportSecretFindings lets the user know that the logs contained something that looks like a secret,
+// since masking only prevents the leak, but the credential likely needs to be rotated anyway.
+func (uploader *LogUploader) reportSecretFindings(ctx context.Context) {
+	if len(uploader.secretFindings) == 0 {
+		return
+	}
+
+	message := fmt.Sprintf("Masked potential secrets in the logs of %s: %s", uploader.commandName,
+		formatSecretFindings(uploader.secretFindings))
+	log.Print(message)
+
+	rawDetails, err := json.Marshal(&secretFindingsDetails{
+		Command:  uploader.commandName,
+		Findings: uploader.secretFindings,
+	})
+	if err != nil {
+		log.Printf("Failed to serialize the secret scan findings: %v", err)
+
+		return
+	}
+
+	_, err = client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: uploader.taskIdentification,
+		Annotations: []*api.Annotation{
+			{
+				Type:       api.Annotation_GENERIC,
+				Level:      api.Annotation_WARNING,
+				Message:    message,
+				RawDetails: string(rawDetails),
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("Failed to report the secret scan findings: %v", err)
+	}
+}
+
+// scanArtifactsForSecrets flags the artifact files that contain the potential secrets,
+// returning false if the upload should be aborted because of them.
+func (executor *Executor) scanArtifactsForSecrets(
+	ctx context.Context,
+	logUploader *LogUploader,
+	artifacts *Artifacts,
+	customEnv *environment.Environment,
+) bool {
+	scanner := newSecretScanner(customEnv)
+	if scanner == nil {
+		return true
+	}
+
+	strict := customEnv.Get(EnvCirrusSecretScan) == secretScanStrict
+
+	level := api.Annotation_WARNING
+	if strict {
+		level = api.Annotation_FAILURE
+	}
+
+	var annotations []*api.Annotation
+
+	for _, pattern := range artifacts.patterns {
+		for _, path := range pattern.Paths {
+			if path.info.IsDir() {
+				continue
+			}
+
+			findings, err := scanner.ScanFile(path.absolutePath)
+			if err != nil {
+				fmt.Fprintf(logUploader, "Failed to scan %s for secrets: %v\n", path.relativePath, err)
+
+				continue
+			}
+
+			for _, finding := range findings {
+				fmt.Fprintf(logUploader, "%s:%d: potential secret (%s)\n", path.relativePath, finding.Line, finding.Rule)
+
+				annotations = append(annotations, &api.Annotation{
+					Type:    api.Annotation_GENERIC,
+					Level:   level,
+					Message: fmt.Sprintf("Potential secret (%s) in the %s artifacts", finding.Rule, artifacts.Name),
+					FileLocation: &api.Annotation_FileLocation{
+						Path:      path.relativePath,
+						StartLine: int64(finding.Line),
+						EndLine:   int64(finding.Line),
+					},
+				})
+			}
+		}
+	}
+
+	if len(annotations) == 0 {
+		return true
+	}
+
+	_, err := client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: executor.taskIdentification,
+		Annotations:        annotations,
+	})
+	if err != nil {
+		log.Printf("Failed to report the secret scan findings: %v", err)
+	}
+
+	if strict {
+		fmt.Fprintf(logUploader, "Refusing to upload artifacts with %d potential secrets because %s is set to %s\n",
+			len(annotations), EnvCirrusSecretScan, secretScanStrict)
+
+		return false
+	}
+
+	fmt.Fprintf(logUploader, "Found %d potential secrets in the artifacts, make sure that they're not real credentials\n",
+		len(annotations))
+
+	return true
+}
+
+func formatSecretFindings(findings map[string]int) string {
+	var result []string
+
+	for rule, count := range findings {
+		result = append(result, fmt.Sprintf("%d %s", count, rule))
+	}
+
+	sort.Strings(result)
+
+	return strings.Join(result, ", ")
+}
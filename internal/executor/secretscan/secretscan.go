@@ -0,0 +1,208 @@
+// Package secretscan detects the credentials that were accidentally printed or written
+// to files, both by the well-known token formats and by the high-entropy values
+// assigned to the secret-looking names.
+package secretscan
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math"
+	"os"
+	"regexp"
+)
+
+const (
+	// MaxFileSize limits the size of the scanned files, since the bigger ones are unlikely to be text
+	MaxFileSize = 16 * 1024 * 1024
+
+	// minEntropy in bits per character is what random base64 and hex strings typically have
+	minEntropy       = 3.5
+	minEntropyLength = 20
+
+	binaryDetectionSize = 8000
+)
+
+type Rule struct {
+	Name  string
+	Regex *regexp.Regexp
+
+	// Group is the index of the regex group containing the secret, 0 for the whole match
+	Group int
+
+	// HighEntropy requires the secret to look random to avoid the false positives
+	HighEntropy bool
+}
+
+// DefaultRules cover the credentials that are most commonly leaked in the CI.
+var DefaultRules = []Rule{
+	{Name: "aws-access-key-id", Regex: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{Name: "github-token", Regex: regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,255}\b`)},
+	{Name: "github-fine-grained-token", Regex: regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{82}\b`)},
+	{Name: "gitlab-token", Regex: regexp.MustCompile(`\bglpat-[A-Za-z0-9_\-]{20}\b`)},
+	{Name: "slack-token", Regex: regexp.MustCompile(`\bxox[baprs]-[A-Za-z0-9\-]{10,}\b`)},
+	{Name: "google-api-key", Regex: regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`)},
+	{Name: "stripe-secret-key", Regex: regexp.MustCompile(`\b[rs]k_live_[0-9A-Za-z]{24,}\b`)},
+	{Name: "npm-token", Regex: regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`)},
+	{Name: "private-key", Regex: regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )?PRIVATE KEY-----`)},
+	{Name: "jwt", Regex: regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]{10,}\.eyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}\b`)},
+	{
+		Name: "generic-secret",
+		Regex: regexp.MustCompile(`(?i)(?:secret|token|passw(?:or)?d|api[_\-]?key|access[_\-]?key|credential|auth)` +
+			`[A-Za-z0-9_\-]*["']?\s*[:=]\s*["']?([A-Za-z0-9+/=_\-]{20,})`),
+		Group:       1,
+		HighEntropy: true,
+	},
+}
+
+// Finding is a potential secret.
+type Finding struct {
+	Rule string `json:"rule"`
+
+	// Line is 1-based and only set for the findings in files
+	Line int `json:"line,omitempty"`
+
+	// Start and End are the byte offsets of the secret in the scanned chunk or line
+	Start int `json:"-"`
+	End   int `json:"-"`
+}
+
+type Scanner struct {
+	rules []Rule
+}
+
+func New(rules []Rule) *Scanner {
+	return &Scanner{rules: rules}
+}
+
+// Scan returns the potential secrets in the data, sorted by their position and not overlapping.
+func (scanner *Scanner) Scan(data []byte) []Finding {
+	var result []Finding
+
+	for _, rule := range scanner.rules {
+		for _, match := range rule.Regex.FindAllSubmatchIndex(data, -1) {
+			start, end := match[2*rule.Group], match[2*rule.Group+1]
+			if start < 0 {
+				continue
+			}
+
+			if rule.HighEntropy && !isHighEntropy(data[start:end]) {
+				continue
+			}
+
+			result = insertFinding(result, Finding{Rule: rule.Name, Start: start, End: end})
+		}
+	}
+
+	return result
+}
+
+// Mask replaces the potential secrets in the data with the replacement.
+func (scanner *Scanner) Mask(data []byte, replacement []byte) ([]byte, []Finding) {
+	findings := scanner.Scan(data)
+	if len(findings) == 0 {
+		return data, nil
+	}
+
+	var result []byte
+	var last int
+
+	for _, finding := range findings {
+		result = append(result, data[last:finding.Start]...)
+		result = append(result, replacement...)
+		last = finding.End
+	}
+
+	return append(result, data[last:]...), findings
+}
+
+// ScanFile returns the potential secrets in the text file, the binary
+// and the files bigger than MaxFileSize are skipped.
+func (scanner *Scanner) ScanFile(path string) ([]Finding, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if info.Size() > MaxFileSize {
+		return nil, nil
+	}
+
+	reader := bufio.NewReader(file)
+
+	head, err := reader.Peek(binaryDetectionSize)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+
+	if bytes.IndexByte(head, 0) != -1 {
+		return nil, nil
+	}
+
+	var result []Finding
+
+	lineScanner := bufio.NewScanner(reader)
+	lineScanner.Buffer(make([]byte, 64*1024), MaxFileSize)
+
+	for lineNumber := 1; lineScanner.Scan(); lineNumber++ {
+		for _, finding := range scanner.Scan(lineScanner.Bytes()) {
+			finding.Line = lineNumber
+			result = append(result, finding)
+		}
+	}
+
+	return result, lineScanner.Err()
+}
+
+// insertFinding keeps the findings sorted, preferring the earlier
+// rules (which are more specific) when the findings overlap
+func insertFinding(findings []Finding, finding Finding) []Finding {
+	idx := len(findings)
+
+	for i, existing := range findings {
+		if finding.Start < existing.End && existing.Start < finding.End {
+			return findings
+		}
+
+		if finding.Start < existing.Start {
+			idx = i
+			break
+		}
+	}
+
+	findings = append(findings, Finding{})
+	copy(findings[idx+1:], findings[idx:])
+	findings[idx] = finding
+
+	return findings
+}
+
+func isHighEntropy(value []byte) bool {
+	if len(value) < minEntropyLength {
+		return false
+	}
+
+	var counts [256]int
+	for _, b := range value {
+		counts[b]++
+	}
+
+	var entropy float64
+
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+
+		probability := float64(count) / float64(len(value))
+		entropy -= probability * math.Log2(probability)
+	}
+
+	return entropy >= minEntropy
+}
@@ -0,0 +1,47 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/services"
+	"log"
+)
+
+// EnvCirrusServices contains the YAML (or JSON) list of the service containers
+// to start before running the commands, see services.Spec for the format.
+const EnvCirrusServices = "CIRRUS_SERVICES"
+
+// startServices starts the service containers (if any were declared) and exposes their
+// connection details to the scripts, returning a function that tears them down.
+func (executor *Executor) startServices(ctx context.Context) (func(), error) {
+	text, ok := executor.env.Lookup(EnvCirrusServices)
+	if !ok || text == "" {
+		return func() {}, nil
+	}
+
+	specs, err := services.ParseSpecs(executor.env.ExpandText(text))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(specs) == 0 {
+		return func() {}, nil
+	}
+
+	manager, err := services.New(executor.taskIdentification.TaskId, executor.env.Get("DOCKER_HOST"), log.Printf)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := manager.Start(ctx, specs); err != nil {
+		manager.Stop()
+
+		return nil, fmt.Errorf("failed to start the services declared in %s: %w", EnvCirrusServices, err)
+	}
+
+	for key, value := range manager.Env() {
+		executor.env.Set(key, value)
+	}
+
+	return manager.Stop, nil
+}
@@ -0,0 +1,347 @@
+// Package services starts the dependent containers (databases, message brokers, etc.)
+// declared for the task via the local Docker (or Podman) API, similarly to docker-compose.
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/docker/go-connections/nat"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+const (
+	// Host on which the service ports are published
+	Host = "127.0.0.1"
+
+	LabelTaskID = "org.cirruslabs.cirrus-ci-agent.task-id"
+
+	pollInterval     = time.Second
+	removeTimeout    = 30 * time.Second
+	failureLogsLines = "20"
+)
+
+type Service struct {
+	Spec        *Spec
+	ContainerID string
+	HostPorts   map[int]int
+}
+
+type Manager struct {
+	cli    *client.Client
+	taskID int64
+	logf   func(format string, args ...interface{})
+
+	services []*Service
+}
+
+// New connects to the Docker daemon at the dockerHost (if specified) or the one specified by
+// the DOCKER_HOST and other standard environment variables, falling back to the Podman's socket
+// when there's no Docker socket.
+func New(taskID int64, dockerHost string, logf func(format string, args ...interface{})) (*Manager, error) {
+	opts := []client.Opt{client.FromEnv, client.WithAPIVersionNegotiation()}
+
+	if dockerHost != "" {
+		opts = append(opts, client.WithHost(dockerHost))
+	} else if os.Getenv("DOCKER_HOST") == "" {
+		if host := podmanHost(); host != "" {
+			opts = append(opts, client.WithHost(host))
+		}
+	}
+
+	cli, err := client.NewClientWithOpts(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the Docker client: %w", err)
+	}
+
+	return &Manager{
+		cli:    cli,
+		taskID: taskID,
+		logf:   logf,
+	}, nil
+}
+
+// Start starts the services in the order they're declared in,
+// waiting for each of them to become healthy.
+func (manager *Manager) Start(ctx context.Context, specs []*Spec) error {
+	for _, spec := range specs {
+		manager.logf("Starting service %s (%s)...", spec.Name, spec.Image)
+
+		service, err := manager.start(ctx, spec)
+		if service != nil {
+			manager.services = append(manager.services, service)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to start service %s: %w", spec.Name, err)
+		}
+
+		manager.logf("Service %s is ready", spec.Name)
+	}
+
+	return nil
+}
+
+// Env returns the connection environment variables for all of the started services.
+func (manager *Manager) Env() map[string]string {
+	result := map[string]string{}
+
+	for _, service := range manager.services {
+		for key, value := range service.Spec.ConnectionEnv(Host, service.HostPorts) {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// Stop removes the containers of all of the started services.
+func (manager *Manager) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), removeTimeout)
+	defer cancel()
+
+	for i := len(manager.services) - 1; i >= 0; i-- {
+		service := manager.services[i]
+
+		err := manager.cli.ContainerRemove(ctx, service.ContainerID, types.ContainerRemoveOptions{
+			RemoveVolumes: true,
+			Force:         true,
+		})
+		if err != nil {
+			manager.logf("Failed to remove service %s: %v", service.Spec.Name, err)
+		}
+	}
+
+	manager.services = nil
+
+	_ = manager.cli.Close()
+}
+
+func (manager *Manager) start(ctx context.Context, spec *Spec) (*Service, error) {
+	if err := manager.ensureImage(ctx, spec.Image); err != nil {
+		return nil, err
+	}
+
+	exposedPorts := nat.PortSet{}
+	portBindings := nat.PortMap{}
+
+	for _, port := range spec.Ports {
+		containerPort := nat.Port(fmt.Sprintf("%d/tcp", port))
+
+		exposedPorts[containerPort] = struct{}{}
+		portBindings[containerPort] = []nat.PortBinding{{HostIP: Host}}
+	}
+
+	config := &container.Config{
+		Image:        spec.Image,
+		Cmd:          spec.Command,
+		Env:          envSlice(spec.Env),
+		ExposedPorts: exposedPorts,
+		Labels: map[string]string{
+			LabelTaskID: strconv.FormatInt(manager.taskID, 10),
+		},
+	}
+
+	if spec.HealthCheck != nil {
+		config.Healthcheck = &container.HealthConfig{
+			Test:     spec.HealthCheck.test(),
+			Interval: spec.HealthCheck.Interval,
+			Timeout:  spec.HealthCheck.Timeout,
+			Retries:  spec.HealthCheck.Retries,
+		}
+	}
+
+	hostConfig := &container.HostConfig{
+		PortBindings: portBindings,
+	}
+
+	name := fmt.Sprintf("cirrus-%d-%s", manager.taskID, spec.Name)
+
+	created, err := manager.cli.ContainerCreate(ctx, config, hostConfig, nil, nil, name)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &Service{
+		Spec:        spec,
+		ContainerID: created.ID,
+		HostPorts:   map[int]int{},
+	}
+
+	if err := manager.cli.ContainerStart(ctx, created.ID, types.ContainerStartOptions{}); err != nil {
+		return service, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, spec.Timeout)
+	defer cancel()
+
+	if err := manager.waitReady(waitCtx, service); err != nil {
+		return service, fmt.Errorf("%w\n%s", err, manager.tailLogs(ctx, service))
+	}
+
+	return service, nil
+}
+
+func (manager *Manager) ensureImage(ctx context.Context, image string) error {
+	if _, _, err := manager.cli.ImageInspectWithRaw(ctx, image); err == nil {
+		return nil
+	}
+
+	manager.logf("Pulling %s...", image)
+
+	progress, err := manager.cli.ImagePull(ctx, image, types.ImagePullOptions{})
+	if err != nil {
+		return err
+	}
+	defer progress.Close()
+
+	// The pull is only complete once the progress stream is fully consumed
+	_, err = io.Copy(io.Discard, progress)
+
+	return err
+}
+
+// waitReady waits for the container's health check to pass (if any)
+// or for all of its ports to start accepting connections otherwise.
+func (manager *Manager) waitReady(ctx context.Context, service *Service) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		inspect, err := manager.cli.ContainerInspect(ctx, service.ContainerID)
+		if err != nil {
+			return err
+		}
+
+		if inspect.State == nil || !inspect.State.Running {
+			exitCode := -1
+			if inspect.State != nil {
+				exitCode = inspect.State.ExitCode
+			}
+
+			return fmt.Errorf("container exited with code %d", exitCode)
+		}
+
+		if err := collectHostPorts(service, inspect.NetworkSettings); err != nil {
+			return err
+		}
+
+		if health := inspect.State.Health; health != nil {
+			switch health.Status {
+			case types.Healthy:
+				return nil
+			case types.Unhealthy:
+				return fmt.Errorf("container is unhealthy")
+			}
+		} else if portsAccept(ctx, service) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("service didn't become ready in %v", service.Spec.Timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (manager *Manager) tailLogs(ctx context.Context, service *Service) string {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	logs, err := manager.cli.ContainerLogs(ctx, service.ContainerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       failureLogsLines,
+	})
+	if err != nil {
+		return fmt.Sprintf("failed to retrieve the container logs: %v", err)
+	}
+	defer logs.Close()
+
+	var buf bytes.Buffer
+	if _, err := stdcopy.StdCopy(&buf, &buf, logs); err != nil {
+		return fmt.Sprintf("failed to retrieve the container logs: %v", err)
+	}
+
+	return fmt.Sprintf("Last %s lines of the container logs:\n%s", failureLogsLines, buf.String())
+}
+
+func collectHostPorts(service *Service, networkSettings *types.NetworkSettings) error {
+	if networkSettings == nil {
+		return nil
+	}
+
+	for _, port := range service.Spec.Ports {
+		bindings := networkSettings.Ports[nat.Port(fmt.Sprintf("%d/tcp", port))]
+		if len(bindings) == 0 {
+			continue
+		}
+
+		hostPort, err := strconv.Atoi(bindings[0].HostPort)
+		if err != nil {
+			return fmt.Errorf("invalid host port %q for port %d", bindings[0].HostPort, port)
+		}
+
+		service.HostPorts[port] = hostPort
+	}
+
+	return nil
+}
+
+func portsAccept(ctx context.Context, service *Service) bool {
+	dialer := net.Dialer{Timeout: pollInterval}
+
+	for _, port := range service.Spec.Ports {
+		hostPort, ok := service.HostPorts[port]
+		if !ok {
+			return false
+		}
+
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(Host, strconv.Itoa(hostPort)))
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+	}
+
+	return true
+}
+
+func envSlice(env map[string]string) []string {
+	var result []string
+
+	for key, value := range env {
+		result = append(result, key+"="+value)
+	}
+
+	return result
+}
+
+func podmanHost() string {
+	if _, err := os.Stat("/var/run/docker.sock"); err == nil {
+		return ""
+	}
+
+	candidates := []string{"/run/podman/podman.sock"}
+
+	if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); runtimeDir != "" {
+		candidates = append([]string{filepath.Join(runtimeDir, "podman", "podman.sock")}, candidates...)
+	}
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			return "unix://" + candidate
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultStartupTimeout is how long to wait for a service
+// to become healthy when it doesn't specify its own timeout.
+const DefaultStartupTimeout = 2 * time.Minute
+
+var (
+	ErrInvalidSpec = errors.New("invalid services specification")
+
+	nameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+)
+
+// Spec describes a single service container.
+type Spec struct {
+	Name        string            `yaml:"name"`
+	Image       string            `yaml:"image"`
+	Command     []string          `yaml:"command"`
+	Env         map[string]string `yaml:"env"`
+	Ports       []int             `yaml:"ports"`
+	HealthCheck *HealthCheck      `yaml:"healthcheck"`
+	Timeout     time.Duration     `yaml:"timeout"`
+}
+
+// HealthCheck either runs the Command directly or the Shell
+// command line in the container's shell.
+type HealthCheck struct {
+	Command  []string      `yaml:"command"`
+	Shell    string        `yaml:"shell"`
+	Interval time.Duration `yaml:"interval"`
+	Timeout  time.Duration `yaml:"timeout"`
+	Retries  int           `yaml:"retries"`
+}
+
+// ParseSpecs parses the YAML (or JSON) list of the service specifications.
+func ParseSpecs(text string) ([]*Spec, error) {
+	var specs []*Spec
+
+	if err := yaml.Unmarshal([]byte(text), &specs); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSpec, err)
+	}
+
+	names := map[string]struct{}{}
+
+	for _, spec := range specs {
+		if !nameRegexp.MatchString(spec.Name) {
+			return nil, fmt.Errorf("%w: service name %q should start with a letter and only contain "+
+				"letters, digits, dashes and underscores", ErrInvalidSpec, spec.Name)
+		}
+
+		if _, ok := names[spec.Name]; ok {
+			return nil, fmt.Errorf("%w: duplicate service name %q", ErrInvalidSpec, spec.Name)
+		}
+		names[spec.Name] = struct{}{}
+
+		if spec.Image == "" {
+			return nil, fmt.Errorf("%w: service %q has no image", ErrInvalidSpec, spec.Name)
+		}
+
+		for _, port := range spec.Ports {
+			if port <= 0 || port > 65535 {
+				return nil, fmt.Errorf("%w: service %q has an invalid port %d", ErrInvalidSpec, spec.Name, port)
+			}
+		}
+
+		if spec.HealthCheck != nil && len(spec.HealthCheck.Command) != 0 && spec.HealthCheck.Shell != "" {
+			return nil, fmt.Errorf("%w: service %q health check should either have a command or a shell, "+
+				"but not both", ErrInvalidSpec, spec.Name)
+		}
+
+		if spec.Timeout == 0 {
+			spec.Timeout = DefaultStartupTimeout
+		}
+	}
+
+	return specs, nil
+}
+
+// EnvPrefix returns the prefix of the environment variables
+// with the connection details of the service.
+func (spec *Spec) EnvPrefix() string {
+	return strings.ToUpper(strings.ReplaceAll(spec.Name, "-", "_"))
+}
+
+// ConnectionEnv returns the environment variables that scripts can use to connect to the service:
+// <NAME>_HOST, <NAME>_PORT (for the first port) and <NAME>_PORT_<CONTAINER PORT> for each port.
+func (spec *Spec) ConnectionEnv(host string, hostPorts map[int]int) map[string]string {
+	prefix := spec.EnvPrefix()
+
+	result := map[string]string{
+		prefix + "_HOST": host,
+	}
+
+	for i, port := range spec.Ports {
+		hostPort, ok := hostPorts[port]
+		if !ok {
+			continue
+		}
+
+		if i == 0 {
+			result[prefix+"_PORT"] = strconv.Itoa(hostPort)
+		}
+
+		result[fmt.Sprintf("%s_PORT_%d", prefix, port)] = strconv.Itoa(hostPort)
+	}
+
+	return result
+}
+
+func (healthCheck *HealthCheck) test() []string {
+	if healthCheck.Shell != "" {
+		return []string{"CMD-SHELL", healthCheck.Shell}
+	}
+
+	if len(healthCheck.Command) != 0 {
+		return append([]string{"CMD"}, healthCheck.Command...)
+	}
+
+	// Inherit the image's health check
+	return nil
+}
@@ -0,0 +1,93 @@
+package services_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseSpecs(t *testing.T) {
+	specs, err := services.ParseSpecs(`
+- name: postgres
+  image: postgres:15
+  env:
+    POSTGRES_PASSWORD: secret
+  ports: [5432]
+  healthcheck:
+    shell: pg_isready -U postgres
+    interval: 2s
+    retries: 30
+  timeout: 5m
+- name: object-store
+  image: minio/minio
+  command: [server, /data]
+  ports: [9000, 9001]
+`)
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+
+	assert.Equal(t, &services.Spec{
+		Name:  "postgres",
+		Image: "postgres:15",
+		Env:   map[string]string{"POSTGRES_PASSWORD": "secret"},
+		Ports: []int{5432},
+		HealthCheck: &services.HealthCheck{
+			Shell:    "pg_isready -U postgres",
+			Interval: 2 * time.Second,
+			Retries:  30,
+		},
+		Timeout: 5 * time.Minute,
+	}, specs[0])
+
+	assert.Equal(t, []string{"server", "/data"}, specs[1].Command)
+	assert.Equal(t, services.DefaultStartupTimeout, specs[1].Timeout)
+}
+
+func TestParseSpecsJSON(t *testing.T) {
+	specs, err := services.ParseSpecs(`[{"name": "redis", "image": "redis:7", "ports": [6379]}]`)
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+	assert.Equal(t, "redis", specs[0].Name)
+	assert.Equal(t, []int{6379}, specs[0].Ports)
+}
+
+func TestParseSpecsInvalid(t *testing.T) {
+	for _, text := range []string{
+		`- image: redis`,
+		`- name: 1redis
+  image: redis`,
+		`- name: redis`,
+		`- name: redis
+  image: redis
+  ports: [70000]`,
+		`- name: redis
+  image: redis
+- name: redis
+  image: redis`,
+		`- name: redis
+  image: redis
+  healthcheck:
+    command: [redis-cli, ping]
+    shell: redis-cli ping`,
+		`name: redis`,
+	} {
+		_, err := services.ParseSpecs(text)
+		assert.ErrorIs(t, err, services.ErrInvalidSpec, text)
+	}
+}
+
+func TestConnectionEnv(t *testing.T) {
+	spec := &services.Spec{
+		Name:  "object-store",
+		Ports: []int{9000, 9001},
+	}
+
+	assert.Equal(t, map[string]string{
+		"OBJECT_STORE_HOST":      "127.0.0.1",
+		"OBJECT_STORE_PORT":      "32768",
+		"OBJECT_STORE_PORT_9000": "32768",
+		"OBJECT_STORE_PORT_9001": "32769",
+	}, spec.ConnectionEnv("127.0.0.1", map[int]int{9000: 32768, 9001: 32769}))
+}
@@ -36,6 +36,19 @@ func ShellCommandsAndWait(
 	custom_env *environment.Environment,
 	handler ShellOutputHandler,
 	shouldKillProcesses bool,
+) (*exec.Cmd, error) {
+	return shellCommandsAndWait(ctx, scripts, custom_env, handler, shouldKillProcesses, nil)
+}
+
+// shellCommandsAndWait is ShellCommandsAndWait that additionally calls onStart (if not nil)
+// once the shell has been started.
+func shellCommandsAndWait(
+	ctx context.Context,
+	scripts []string,
+	custom_env *environment.Environment,
+	handler ShellOutputHandler,
+	shouldKillProcesses bool,
+	onStart func(cmd *exec.Cmd),
 ) (*exec.Cmd, error) {
 	sc, err := NewShellCommands(ctx, scripts, custom_env, handler)
 	if err != nil {
@@ -44,6 +57,10 @@ func ShellCommandsAndWait(
 
 	cmd := sc.cmd
 
+	if onStart != nil {
+		onStart(cmd)
+	}
+
 	done := make(chan error)
 	go func() {
 		// give time to flush logs
@@ -142,7 +159,12 @@ func NewShellCommands(
 		}
 	}
 
-	cmd.Env = env
+	cmd.Env = withoutDeniedVariables(env)
+	if err := validateEnvironment(cmd.Env); err != nil {
+		message := fmt.Sprintf("Error preparing the environment: %s", err)
+		handler([]byte(message))
+		return nil, errors.New(message)
+	}
 	if custom_env != nil {
 		if workingDir, ok := custom_env.Lookup("CIRRUS_WORKING_DIR"); ok {
 			EnsureFolderExists(workingDir)
@@ -150,6 +172,12 @@ func NewShellCommands(
 		}
 	}
 
+	if err := sandboxCommand(cmd, scriptFile); err != nil {
+		message := fmt.Sprintf("Error preparing the sandbox: %s", err)
+		handler([]byte(message))
+		return nil, errors.New(message)
+	}
+
 	writer := ShellOutputWriter{
 		handler: handler,
 	}
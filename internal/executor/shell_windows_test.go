@@ -192,3 +192,27 @@ func Test_Powershell(t *testing.T) {
 		t.Errorf("Wrong output: '%+q' expected '%+q'", output, expected_output)
 	}
 }
+
+func Test_Powershell_Special_Characters(t *testing.T) {
+	values := map[string]string{
+		"MULTILINE": "-----BEGIN KEY-----\nabc\ndef\n-----END KEY-----",
+		"QUOTES":    "\"double\" 'single' `backtick` & | < > ^ %PATH%",
+		"UNICODE":   "héllo wörld ✓ 你好",
+	}
+
+	for key, value := range values {
+		test_env := environment.New(map[string]string{
+			"CIRRUS_WORKING_DIR": "C:\\Windows\\TEMP",
+			"CIRRUS_SHELL":       "powershell",
+		})
+		// Avoid the agent-side expansion to test the propagation only
+		test_env.Items()[key] = value
+
+		success, output := ShellCommandsAndGetOutput(context.Background(), []string{
+			fmt.Sprintf("[Console]::Write($env:%s)", key),
+		}, test_env)
+
+		assert.True(t, success, output)
+		assert.Equal(t, value, output, key)
+	}
+}
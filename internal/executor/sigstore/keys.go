@@ -0,0 +1,111 @@
+package sigstore
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+var ErrInvalidKey = errors.New("invalid private key")
+
+// encryptedKey is the format of the "cosign generate-key-pair" private keys
+type encryptedKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// LoadPrivateKey parses the PEM-encoded ECDSA private key, which is either
+// encrypted with the password by cosign or stored in plain PKCS #8 or SEC 1 form.
+func LoadPrivateKey(pemData []byte, password []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block found", ErrInvalidKey)
+	}
+
+	der := block.Bytes
+
+	switch block.Type {
+	case "ENCRYPTED COSIGN PRIVATE KEY", "ENCRYPTED SIGSTORE PRIVATE KEY":
+		var err error
+
+		der, err = decryptKey(block.Bytes, password)
+		if err != nil {
+			return nil, err
+		}
+	case "EC PRIVATE KEY":
+		key, err := x509.ParseECPrivateKey(der)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+		}
+
+		return key, nil
+	case "PRIVATE KEY":
+	default:
+		return nil, fmt.Errorf("%w: unsupported PEM block type %q", ErrInvalidKey, block.Type)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: only ECDSA keys are supported, got %T", ErrInvalidKey, key)
+	}
+
+	return ecdsaKey, nil
+}
+
+func decryptKey(content []byte, password []byte) ([]byte, error) {
+	var encrypted encryptedKey
+
+	if err := json.Unmarshal(content, &encrypted); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+
+	if encrypted.KDF.Name != "scrypt" || encrypted.Cipher.Name != "nacl/secretbox" {
+		return nil, fmt.Errorf("%w: unsupported encryption %s with %s", ErrInvalidKey,
+			encrypted.Cipher.Name, encrypted.KDF.Name)
+	}
+
+	if len(encrypted.Cipher.Nonce) != 24 {
+		return nil, fmt.Errorf("%w: invalid nonce length", ErrInvalidKey)
+	}
+
+	secret, err := scrypt.Key(password, encrypted.KDF.Salt, encrypted.KDF.Params.N,
+		encrypted.KDF.Params.R, encrypted.KDF.Params.P, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidKey, err)
+	}
+
+	var secretKey [32]byte
+	copy(secretKey[:], secret)
+
+	var nonce [24]byte
+	copy(nonce[:], encrypted.Cipher.Nonce)
+
+	decrypted, ok := secretbox.Open(nil, encrypted.Ciphertext, &nonce, &secretKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: decryption failed, is the password correct?", ErrInvalidKey)
+	}
+
+	return decrypted, nil
+}
@@ -0,0 +1,74 @@
+package sigstore_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/sigstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+	"testing"
+)
+
+func TestLoadPrivateKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	loadedKey, err := sigstore.LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(loadedKey))
+}
+
+func TestLoadPrivateKeyEncrypted(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	// Same format as "cosign generate-key-pair" produces, but with cheaper scrypt parameters
+	salt := []byte("0123456789abcdef0123456789abcdef")
+	var nonce [24]byte
+	copy(nonce[:], "0123456789abcdef01234567")
+
+	secret, err := scrypt.Key([]byte("hunter2"), salt, 1024, 8, 1, 32)
+	require.NoError(t, err)
+	var secretKey [32]byte
+	copy(secretKey[:], secret)
+
+	content, err := json.Marshal(map[string]interface{}{
+		"kdf": map[string]interface{}{
+			"name":   "scrypt",
+			"params": map[string]int{"N": 1024, "r": 8, "p": 1},
+			"salt":   salt,
+		},
+		"cipher": map[string]interface{}{
+			"name":  "nacl/secretbox",
+			"nonce": nonce[:],
+		},
+		"ciphertext": secretbox.Seal(nil, der, &nonce, &secretKey),
+	})
+	require.NoError(t, err)
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: content})
+
+	loadedKey, err := sigstore.LoadPrivateKey(keyPEM, []byte("hunter2"))
+	require.NoError(t, err)
+	assert.True(t, key.Equal(loadedKey))
+
+	_, err = sigstore.LoadPrivateKey(keyPEM, []byte("wrong"))
+	assert.ErrorIs(t, err, sigstore.ErrInvalidKey)
+}
+
+func TestLoadPrivateKeyInvalid(t *testing.T) {
+	_, err := sigstore.LoadPrivateKey([]byte("not a key"), nil)
+	assert.ErrorIs(t, err, sigstore.ErrInvalidKey)
+}
@@ -0,0 +1,255 @@
+// Package sigstore implements the parts of the Sigstore (https://sigstore.dev) APIs needed
+// for the keyless signing: obtaining the short-lived signing certificates from Fulcio
+// and recording the signatures in the Rekor transparency log.
+package sigstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	DefaultFulcioURL = "https://fulcio.sigstore.dev"
+	DefaultRekorURL  = "https://rekor.sigstore.dev"
+)
+
+type Client struct {
+	FulcioURL string
+
+	// RekorURL can be empty to skip recording the signatures in the transparency log
+	RekorURL string
+
+	HTTPClient *http.Client
+}
+
+// LogEntry is the Rekor's transparency log entry.
+type LogEntry struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+	Verification   struct {
+		SignedEntryTimestamp string `json:"signedEntryTimestamp"`
+	} `json:"verification"`
+
+	// Raw is the entry as returned by Rekor
+	Raw json.RawMessage `json:"-"`
+}
+
+// RequestCertificate obtains the certificate chain (PEM-encoded, starting with the signing certificate)
+// for the key from Fulcio, using the identity from the OIDC token (which should have the "sigstore" audience).
+func (client *Client) RequestCertificate(ctx context.Context, key *ecdsa.PrivateKey, identityToken string) ([]string, error) {
+	subject, err := TokenSubject(identityToken)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := MarshalPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prove the possession of the private key by signing the token's subject
+	subjectDigest := sha256.Sum256([]byte(subject))
+
+	proof, err := ecdsa.SignASN1(rand.Reader, key, subjectDigest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	request := map[string]interface{}{
+		"credentials": map[string]string{
+			"oidcIdentityToken": identityToken,
+		},
+		"publicKeyRequest": map[string]interface{}{
+			"publicKey": map[string]string{
+				"algorithm": "ECDSA",
+				"content":   string(publicKey),
+			},
+			"proofOfPossession": base64.StdEncoding.EncodeToString(proof),
+		},
+	}
+
+	var response struct {
+		SignedCertificateEmbeddedSct *struct {
+			Chain struct {
+				Certificates []string `json:"certificates"`
+			} `json:"chain"`
+		} `json:"signedCertificateEmbeddedSct"`
+		SignedCertificateDetachedSct *struct {
+			Chain struct {
+				Certificates []string `json:"certificates"`
+			} `json:"chain"`
+		} `json:"signedCertificateDetachedSct"`
+	}
+
+	if err := client.post(ctx, strings.TrimSuffix(client.FulcioURL, "/")+"/api/v2/signingCert",
+		request, &response); err != nil {
+		return nil, fmt.Errorf("failed to obtain the signing certificate from Fulcio: %w", err)
+	}
+
+	var certificates []string
+
+	switch {
+	case response.SignedCertificateEmbeddedSct != nil:
+		certificates = response.SignedCertificateEmbeddedSct.Chain.Certificates
+	case response.SignedCertificateDetachedSct != nil:
+		certificates = response.SignedCertificateDetachedSct.Chain.Certificates
+	}
+
+	if len(certificates) == 0 {
+		return nil, errors.New("failed to obtain the signing certificate from Fulcio: no certificates in the response")
+	}
+
+	return certificates, nil
+}
+
+// UploadDSSE records the signed DSSE envelope in Rekor, the verifier is either
+// the PEM-encoded signing certificate or the public key.
+func (client *Client) UploadDSSE(ctx context.Context, envelope []byte, verifier string) (*LogEntry, error) {
+	return client.upload(ctx, map[string]interface{}{
+		"apiVersion": "0.0.1",
+		"kind":       "dsse",
+		"spec": map[string]interface{}{
+			"proposedContent": map[string]interface{}{
+				"envelope":  string(envelope),
+				"verifiers": []string{base64.StdEncoding.EncodeToString([]byte(verifier))},
+			},
+		},
+	})
+}
+
+// UploadHashedRekord records the signature of the SHA-256 digest in Rekor, the verifier
+// is either the PEM-encoded signing certificate or the public key.
+func (client *Client) UploadHashedRekord(ctx context.Context, digest []byte, signature []byte, verifier string) (*LogEntry, error) {
+	return client.upload(ctx, map[string]interface{}{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]interface{}{
+			"signature": map[string]interface{}{
+				"content": base64.StdEncoding.EncodeToString(signature),
+				"publicKey": map[string]string{
+					"content": base64.StdEncoding.EncodeToString([]byte(verifier)),
+				},
+			},
+			"data": map[string]interface{}{
+				"hash": map[string]string{
+					"algorithm": "sha256",
+					"value":     hex.EncodeToString(digest),
+				},
+			},
+		},
+	})
+}
+
+func (client *Client) upload(ctx context.Context, request interface{}) (*LogEntry, error) {
+	// The response is keyed by the entry's UUID
+	var response map[string]json.RawMessage
+
+	if err := client.post(ctx, strings.TrimSuffix(client.RekorURL, "/")+"/api/v1/log/entries",
+		request, &response); err != nil {
+		return nil, fmt.Errorf("failed to record the signature in Rekor: %w", err)
+	}
+
+	for _, rawEntry := range response {
+		var entry LogEntry
+
+		if err := json.Unmarshal(rawEntry, &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse the Rekor's log entry: %w", err)
+		}
+		entry.Raw = rawEntry
+
+		return &entry, nil
+	}
+
+	return nil, errors.New("failed to record the signature in Rekor: no log entry in the response")
+}
+
+func (client *Client) post(ctx context.Context, url string, request interface{}, response interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("Accept", "application/json")
+
+	httpClient := client.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	httpResponse, err := httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK && httpResponse.StatusCode != http.StatusCreated {
+		message, _ := io.ReadAll(io.LimitReader(httpResponse.Body, 4096))
+
+		return fmt.Errorf("%s returned HTTP %d: %s", url, httpResponse.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return json.NewDecoder(httpResponse.Body).Decode(response)
+}
+
+// MarshalPublicKey returns the PEM-encoded public key.
+func MarshalPublicKey(publicKey *ecdsa.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// TokenSubject returns the identity that Fulcio will put into the certificate:
+// the e-mail for the e-mail-based issuers and the subject otherwise.
+func TokenSubject(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("OIDC token should be a JWT consisting of 3 parts")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return "", err
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+		Email   string `json:"email"`
+	}
+
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", err
+	}
+
+	if claims.Email != "" {
+		return claims.Email, nil
+	}
+
+	if claims.Subject == "" {
+		return "", errors.New("OIDC token has no subject")
+	}
+
+	return claims.Subject, nil
+}
@@ -0,0 +1,76 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/systemlog"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	systemLogArtifact = "system-log"
+	systemLogMaxLines = 10000
+	systemLogTimeout  = 30 * time.Second
+)
+
+// uploadSystemLog uploads the tail of the operating system's log covering the task's duration
+// to expose the OOM kills, the disk errors and the other kernel-level problems behind the failures.
+func (executor *Executor) uploadSystemLog(ctx context.Context, startedOn time.Time) {
+	if err := executor.captureAndUploadSystemLog(ctx, startedOn); err != nil {
+		if errors.Is(err, systemlog.ErrUnsupported) {
+			return
+		}
+
+		message := fmt.Sprintf("Failed to capture the system log: %v", err)
+		log.Print(message)
+		executor.reportWarning(ctx, client.ProblemAgent, message)
+	}
+}
+
+func (executor *Executor) captureAndUploadSystemLog(ctx context.Context, startedOn time.Time) error {
+	captureCtx, cancel := context.WithTimeout(ctx, systemLogTimeout)
+	defer cancel()
+
+	// The system log is timestamped with the local clock, undo the skew correction
+	since := startedOn.Add(-clockskew.Offset())
+
+	output, source, err := systemlog.Capture(captureCtx, since, time.Now(), systemLogMaxLines)
+	if err != nil {
+		return err
+	}
+
+	dir, err := executor.newDiagnosticsDir(systemLogArtifact)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	header := fmt.Sprintf("# The last %d lines of the %s since %s\n\n", systemLogMaxLines, source,
+		since.UTC().Format(time.RFC3339))
+
+	if err := os.WriteFile(filepath.Join(dir, "system.log"), append([]byte(header), output...), 0600); err != nil {
+		return err
+	}
+
+	artifacts, err := NewArtifacts(systemLogArtifact, &api.ArtifactsInstruction{
+		Paths: []string{diagnosticsDir + "/" + systemLogArtifact + "/*"},
+	}, executor.env)
+	if err != nil {
+		return err
+	}
+
+	if err := executor.uploadArtifactFiles(ctx, log.Writer(), artifacts); err != nil {
+		return err
+	}
+
+	log.Printf("Uploaded the %s as the %q artifact", source, systemLogArtifact)
+
+	return nil
+}
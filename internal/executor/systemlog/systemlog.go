@@ -0,0 +1,48 @@
+// Package systemlog captures the operating system's log for a time window to expose
+// the OOM kills, the disk errors and the other kernel-level problems behind the task failures.
+package systemlog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os/exec"
+)
+
+var ErrUnsupported = errors.New("system log capture is only supported on Linux and macOS")
+
+// Tail returns the last maxLines lines of the output.
+func Tail(output []byte, maxLines int) []byte {
+	output = bytes.TrimRight(output, "\n")
+
+	end := len(output)
+
+	for i := 0; i < maxLines; i++ {
+		newline := bytes.LastIndexByte(output[:end], '\n')
+		if newline == -1 {
+			return append(output, '\n')
+		}
+
+		end = newline
+	}
+
+	return append(output[end+1:], '\n')
+}
+
+func run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		if stderr.Len() != 0 {
+			return nil, errors.New(string(bytes.TrimSpace(stderr.Bytes())))
+		}
+
+		return nil, err
+	}
+
+	return output, nil
+}
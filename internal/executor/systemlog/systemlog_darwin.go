@@ -0,0 +1,25 @@
+package systemlog
+
+import (
+	"context"
+	"time"
+)
+
+// unifiedLogPredicate narrows the unified log (which easily produces
+// thousands of lines per second) to the kernel and the problems reported by the processes.
+const unifiedLogPredicate = `process == "kernel" OR messageType == error OR messageType == fault`
+
+// Capture returns the last maxLines lines of the unified log for the time window
+// along with the name of the source.
+func Capture(ctx context.Context, since time.Time, until time.Time, maxLines int) ([]byte, string, error) {
+	const layout = "2006-01-02 15:04:05"
+
+	output, err := run(ctx, "log", "show", "--style", "syslog",
+		"--start", since.Local().Format(layout), "--end", until.Add(time.Second).Local().Format(layout),
+		"--predicate", unifiedLogPredicate)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return Tail(output, maxLines), "unified log", nil
+}
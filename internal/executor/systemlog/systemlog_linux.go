@@ -0,0 +1,31 @@
+package systemlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Capture returns the last maxLines lines of the journald log for the time window (which includes
+// the kernel messages) along with the name of the source, falling back to the kernel ring buffer
+// when journald is not available.
+func Capture(ctx context.Context, since time.Time, until time.Time, maxLines int) ([]byte, string, error) {
+	output, journalErr := run(ctx, "journalctl", "--no-pager", "--quiet", "--output=short-iso",
+		fmt.Sprintf("--since=@%d", since.Unix()), fmt.Sprintf("--until=@%d", until.Unix()+1),
+		fmt.Sprintf("--lines=%d", maxLines))
+	if journalErr == nil && len(output) != 0 {
+		return Tail(output, maxLines), "journald", nil
+	}
+
+	// The ring buffer has no notion of wall-clock time that we can reliably filter on
+	output, err := run(ctx, "dmesg", "--time-format=iso")
+	if err != nil {
+		if journalErr != nil {
+			return nil, "", fmt.Errorf("journalctl: %v, dmesg: %w", journalErr, err)
+		}
+
+		return nil, "", fmt.Errorf("dmesg: %w", err)
+	}
+
+	return Tail(output, maxLines), "dmesg", nil
+}
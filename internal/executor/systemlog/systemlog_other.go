@@ -0,0 +1,13 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package systemlog
+
+import (
+	"context"
+	"time"
+)
+
+func Capture(ctx context.Context, since time.Time, until time.Time, maxLines int) ([]byte, string, error) {
+	return nil, "", ErrUnsupported
+}
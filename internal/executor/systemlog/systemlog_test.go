@@ -0,0 +1,12 @@
+package systemlog
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTail(t *testing.T) {
+	assert.Equal(t, "c\nd\n", string(Tail([]byte("a\nb\nc\nd\n"), 2)))
+	assert.Equal(t, "a\nb\n", string(Tail([]byte("a\nb"), 5)))
+	assert.Equal(t, "\n", string(Tail(nil, 5)))
+}
@@ -0,0 +1,235 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// EnvCirrusTerminalOnFailure opens a terminal session right after the first failed script
+// so that the failure state can be inspected before the on_failure/always instructions run.
+const EnvCirrusTerminalOnFailure = "CIRRUS_TERMINAL_ON_FAILURE"
+
+// EnvCirrusTerminalSessions is the number of independent terminals (each with its own locator)
+// to launch, so that multiple engineers can debug the same task simultaneously
+const EnvCirrusTerminalSessions = "CIRRUS_TERMINAL_SESSIONS"
+
+const defaultTerminalExpirationWindow = 15 * time.Minute
+
+// terminalRecordingsDir is relative to the CIRRUS_WORKING_DIR since the artifacts can only be uploaded from there.
+const terminalRecordingsDir = ".cirrus-terminal-recordings"
+
+// terminalExpirationWindow returns for how long the terminal sessions should be inactive
+// before we stop waiting for them.
+func (executor *Executor) terminalExpirationWindow() time.Duration {
+	expireInString, ok := executor.env.Lookup("CIRRUS_TERMINAL_EXPIRATION_WINDOW")
+	if !ok {
+		return defaultTerminalExpirationWindow
+	}
+
+	expireInInt, err := strconv.Atoi(expireInString)
+	if err != nil {
+		return defaultTerminalExpirationWindow
+	}
+
+	return time.Duration(expireInInt) * time.Second
+}
+
+// terminalNumHosts returns the number of terminal hosts to launch.
+func (executor *Executor) terminalNumHosts() int {
+	numHosts, err := strconv.Atoi(executor.env.Get(EnvCirrusTerminalSessions))
+	if err != nil || numHosts < 1 {
+		return 1
+	}
+
+	if numHosts > terminalwrapper.MaxTerminalHosts {
+		log.Printf("Limiting the number of terminals from %d to %d", numHosts, terminalwrapper.MaxTerminalHosts)
+
+		return terminalwrapper.MaxTerminalHosts
+	}
+
+	return numHosts
+}
+
+// waitForTerminal streams the terminal wrapper's log messages to the logUploader
+// until the terminal sessions become inactive.
+func (executor *Executor) waitForTerminal(logUploader *LogUploader, wrapper *terminalwrapper.Wrapper) bool {
+	operationChan := wrapper.Wait()
+
+	for {
+		switch operation := (<-operationChan).(type) {
+		case *terminalwrapper.LogOperation:
+			log.Println(operation.Message)
+			_, _ = fmt.Fprintln(logUploader, operation.Message)
+		case *terminalwrapper.ExitOperation:
+			return operation.Success
+		}
+	}
+}
+
+// openTerminalOnFailure launches a terminal session for the failed command when
+// CIRRUS_TERMINAL_ON_FAILURE is enabled and the task has no explicit wait_for_terminal instruction.
+//
+// This only happens once per task.
+func (executor *Executor) openTerminalOnFailure(ctx context.Context, logUploader *LogUploader) {
+	if executor.env.Get(EnvCirrusTerminalOnFailure) != "true" {
+		return
+	}
+
+	if executor.terminalWrapper != nil || executor.terminalOnFailure {
+		return
+	}
+	executor.terminalOnFailure = true
+
+	expireIn := executor.terminalExpirationWindow()
+
+	shellEnv, startup, err := executor.terminalShellEnv()
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "Not opening a terminal session: %v\n", err)
+
+		return
+	}
+	defer func() {
+		_ = startup.Close()
+	}()
+
+	terminalCtx, terminalCancel := context.WithCancel(ctx)
+	defer terminalCancel()
+
+	_, _ = fmt.Fprintln(logUploader, "Opening a terminal session to inspect the failure...")
+
+	wrapper := terminalwrapper.New(terminalCtx, executor.taskIdentification, "", expireIn, shellEnv,
+		executor.terminalNumHosts())
+	wrapper.SetNotifier(startup.Notify)
+
+	// Don't hold the task if the terminal server is unreachable
+	go func() {
+		select {
+		case <-time.After(expireIn):
+			if !wrapper.Connected() {
+				log.Printf("Terminal host failed to connect in %v, giving up", expireIn)
+				terminalCancel()
+			}
+		case <-terminalCtx.Done():
+		}
+	}()
+
+	_ = executor.waitForTerminal(logUploader, wrapper)
+
+	executor.uploadTerminalRecordings(ctx, logUploader)
+}
+
+// terminalShellEnv returns the environment for the terminal shells along with the shell startup
+// customizations (tmux, recording, access restrictions and notifications), which might be nil
+// if the customizations are not supported and need to be closed after use.
+//
+// An error is only returned when the access restrictions were requested, but can't be applied,
+// in which case the terminal shouldn't be launched at all.
+func (executor *Executor) terminalShellEnv() ([]string, *terminalwrapper.ShellStartup, error) {
+	shellEnv := withoutDeniedVariables(append(os.Environ(), EnvMapAsSlice(executor.env.Items())...))
+
+	// Allows the terminal clients to run "$CIRRUS_AGENT_BINARY -port-forward"
+	// and "$CIRRUS_AGENT_BINARY -file-transfer"
+	agentBinary, err := os.Executable()
+	if err == nil {
+		shellEnv = append(shellEnv, "CIRRUS_AGENT_BINARY="+agentBinary)
+	}
+
+	enableTmux := executor.env.Get(terminalwrapper.EnvCirrusTerminalTmux) != "false"
+	enableRecording := executor.env.Get(terminalwrapper.EnvCirrusTerminalRecord) == "true" && agentBinary != ""
+	enableReadOnly := executor.env.Get(terminalwrapper.EnvCirrusTerminalReadOnly) == "true"
+	approvalToken := executor.env.Get(terminalwrapper.EnvCirrusTerminalApprovalToken)
+	restricted := enableReadOnly || approvalToken != ""
+
+	if restricted && agentBinary == "" {
+		return nil, nil, fmt.Errorf("terminal access restrictions were requested, "+
+			"but the agent binary path can't be determined: %v", err)
+	}
+
+	startup, err := terminalwrapper.NewShellStartup()
+	if err != nil {
+		if restricted {
+			return nil, nil, fmt.Errorf("failed to apply the terminal access restrictions: %w", err)
+		}
+
+		log.Printf("Failed to customize the terminal shell startup: %v", err)
+
+		return shellEnv, nil, nil
+	}
+
+	// Compliance-sensitive organizations may only want to allow viewing
+	// the state of the system and/or require an explicit approval
+	if approvalToken != "" {
+		if err := startup.EnableApproval(agentBinary, approvalToken); err != nil {
+			_ = startup.Close()
+
+			return nil, nil, fmt.Errorf("failed to apply the terminal access restrictions: %w", err)
+		}
+	}
+	if enableReadOnly {
+		startup.EnableReadOnly(agentBinary)
+	}
+
+	// Make the terminal sessions resumable and shareable between the operators
+	if enableTmux {
+		if err := startup.EnableTmux(fmt.Sprintf("cirrus-task-%d", executor.taskIdentification.TaskId)); err != nil {
+			log.Printf("Terminal sessions won't be resumable: %v", err)
+		}
+	}
+
+	// Keep an audit trail of what was done during the interactive debugging
+	if enableRecording {
+		executor.terminalRecordings = filepath.Join(executor.env.Get("CIRRUS_WORKING_DIR"), terminalRecordingsDir)
+		startup.EnableRecording(agentBinary, executor.terminalRecordings)
+	}
+
+	// Warn the users before closing the inactive terminal
+	startup.EnableNotifications()
+
+	customizedShellEnv, err := startup.ShellEnv(shellEnv)
+	if err != nil {
+		_ = startup.Close()
+
+		if restricted {
+			return nil, nil, fmt.Errorf("failed to apply the terminal access restrictions: %w", err)
+		}
+
+		log.Printf("Failed to customize the terminal shell startup: %v", err)
+
+		return shellEnv, nil, nil
+	}
+
+	return customizedShellEnv, startup, nil
+}
+
+// uploadTerminalRecordings uploads the asciinema recordings of the terminal sessions (if any) as artifacts.
+func (executor *Executor) uploadTerminalRecordings(ctx context.Context, logUploader *LogUploader) {
+	if executor.terminalRecordings == "" {
+		return
+	}
+
+	recordings, err := filepath.Glob(filepath.Join(executor.terminalRecordings, "*.cast"))
+	if err != nil || len(recordings) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "Uploading %d terminal session recording(s)...\n", len(recordings))
+
+	if !executor.UploadArtifacts(ctx, logUploader, "terminal_recordings", &api.ArtifactsInstruction{
+		Paths: []string{terminalRecordingsDir + "/*.cast"},
+	}, executor.env) {
+		log.Printf("Failed to upload the terminal session recordings")
+
+		return
+	}
+
+	if err := os.RemoveAll(executor.terminalRecordings); err != nil {
+		log.Printf("Failed to clean up the terminal session recordings: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package executor
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper"
+	"github.com/stretchr/testify/require"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestTerminalExpirationWindow(t *testing.T) {
+	executor := &Executor{env: environment.New(map[string]string{})}
+	require.Equal(t, defaultTerminalExpirationWindow, executor.terminalExpirationWindow())
+
+	executor.env.Set("CIRRUS_TERMINAL_EXPIRATION_WINDOW", "60")
+	require.Equal(t, time.Minute, executor.terminalExpirationWindow())
+
+	executor.env.Set("CIRRUS_TERMINAL_EXPIRATION_WINDOW", "soon")
+	require.Equal(t, defaultTerminalExpirationWindow, executor.terminalExpirationWindow())
+}
+
+func TestTerminalNumHosts(t *testing.T) {
+	executor := &Executor{env: environment.New(map[string]string{})}
+	require.Equal(t, 1, executor.terminalNumHosts())
+
+	executor.env.Set(EnvCirrusTerminalSessions, "2")
+	require.Equal(t, 2, executor.terminalNumHosts())
+
+	executor.env.Set(EnvCirrusTerminalSessions, "100")
+	require.Equal(t, terminalwrapper.MaxTerminalHosts, executor.terminalNumHosts())
+
+	executor.env.Set(EnvCirrusTerminalSessions, "0")
+	require.Equal(t, 1, executor.terminalNumHosts())
+}
+
+func TestOpenTerminalOnFailureRequiresOptIn(t *testing.T) {
+	executor := &Executor{env: environment.New(map[string]string{})}
+
+	executor.openTerminalOnFailure(context.Background(), nil)
+	require.False(t, executor.terminalOnFailure)
+}
+
+func TestTerminalShellEnvRestrictions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("customizing the terminal shell startup is not supported on Windows")
+	}
+
+	// The shell shims are prepended to the agent's PATH
+	t.Setenv("PATH", os.Getenv("PATH"))
+
+	executor := &Executor{env: environment.New(map[string]string{
+		"CIRRUS_TERMINAL_TMUX":           "false",
+		"CIRRUS_TERMINAL_READ_ONLY":      "true",
+		"CIRRUS_TERMINAL_APPROVAL_TOKEN": "s3cr3t",
+	})}
+
+	shellEnv, startup, err := executor.terminalShellEnv()
+	require.NoError(t, err)
+	defer startup.Close()
+
+	require.NotContains(t, shellEnv, "CIRRUS_TERMINAL_APPROVAL_TOKEN=s3cr3t")
+	require.Contains(t, shellEnv, "CIRRUS_TERMINAL_GATED=1")
+
+	// The terminal host starts the restrictions instead of the shell
+	shellPath, err := exec.LookPath("bash")
+	require.NoError(t, err)
+
+	shim, err := os.ReadFile(shellPath)
+	require.NoError(t, err)
+	require.Contains(t, string(shim), "-approve-terminal")
+	require.Contains(t, string(shim), "-restricted-shell")
+}
@@ -0,0 +1,72 @@
+// Package approval gates the terminal sessions behind an approval token
+// that needs to be entered before the shell becomes available.
+package approval
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/rawstdio"
+	"io"
+	"os"
+	"strings"
+)
+
+const maxAttempts = 3
+
+var ErrNotApproved = errors.New("terminal session was not approved")
+
+// WriteTokenHash stores the hash of the approval token, so that the token itself
+// is never exposed to the terminal sessions.
+func WriteTokenHash(path string, token string) error {
+	return os.WriteFile(path, []byte(hashToken(token)), 0600)
+}
+
+// GateStdio asks for the approval token on the session's terminal with the echo disabled,
+// so that the token doesn't end up on the screen (or in the recordings).
+func GateStdio(tokenHashPath string) error {
+	restore := rawstdio.DisableEcho(os.Stdin)
+	defer restore()
+
+	return Gate(os.Stdin, os.Stdout, tokenHashPath)
+}
+
+// Gate asks for the approval token and returns ErrNotApproved if
+// the correct token wasn't entered in a few attempts.
+func Gate(in io.Reader, out io.Writer, tokenHashPath string) error {
+	expectedHash, err := os.ReadFile(tokenHashPath)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(in)
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		_, _ = fmt.Fprint(out, "This terminal session requires approval, enter the approval token: ")
+
+		line, err := reader.ReadString('\n')
+		_, _ = fmt.Fprintln(out)
+
+		token := strings.TrimRight(line, "\r\n")
+		if token != "" && subtle.ConstantTimeCompare([]byte(hashToken(token)), expectedHash) == 1 {
+			return nil
+		}
+
+		if err != nil {
+			break
+		}
+
+		_, _ = fmt.Fprintln(out, "Invalid approval token.")
+	}
+
+	return ErrNotApproved
+}
+
+func hashToken(token string) string {
+	digest := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,29 @@
+package approval_test
+
+import (
+	"bytes"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/approval"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGate(t *testing.T) {
+	tokenHashPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, approval.WriteTokenHash(tokenHashPath, "s3cr3t"))
+
+	tokenHash, err := os.ReadFile(tokenHashPath)
+	require.NoError(t, err)
+	require.NotContains(t, string(tokenHash), "s3cr3t")
+
+	var output bytes.Buffer
+
+	require.NoError(t, approval.Gate(strings.NewReader("wrong\ns3cr3t\n"), &output, tokenHashPath))
+	require.Equal(t, 1, strings.Count(output.String(), "Invalid approval token."))
+
+	require.ErrorIs(t, approval.Gate(strings.NewReader("a\nb\nc\ns3cr3t\n"), &output, tokenHashPath),
+		approval.ErrNotApproved)
+	require.ErrorIs(t, approval.Gate(strings.NewReader(""), &output, tokenHashPath), approval.ErrNotApproved)
+}
@@ -0,0 +1,21 @@
+//go:build !windows
+// +build !windows
+
+package approval
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// Exec replaces the current process with the command that was gated by the approval
+// (e.g. the shell), so that nothing is left to interrupt in between.
+func Exec(command []string) error {
+	path, err := exec.LookPath(command[0])
+	if err != nil {
+		return err
+	}
+
+	return syscall.Exec(path, command, os.Environ())
+}
@@ -0,0 +1,10 @@
+//go:build windows
+// +build windows
+
+package approval
+
+import "errors"
+
+func Exec(command []string) error {
+	return errors.New("gating the terminal sessions is not supported on Windows")
+}
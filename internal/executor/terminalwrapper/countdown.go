@@ -0,0 +1,67 @@
+package terminalwrapper
+
+import (
+	"fmt"
+	"time"
+)
+
+// countdownWarnings are the remaining times before closing the inactive terminal
+// at which the users are warned (only those shorter than the expiration window are used).
+var countdownWarnings = []time.Duration{5 * time.Minute, time.Minute, 10 * time.Second}
+
+// countdown tracks the inactivity of the terminal, which is renewed on any activity.
+type countdown struct {
+	expirationWindow time.Duration
+	warnings         []time.Duration
+
+	lastActivity time.Time
+	nextWarning  int
+}
+
+func newCountdown(expirationWindow time.Duration) *countdown {
+	result := &countdown{
+		expirationWindow: expirationWindow,
+	}
+
+	for _, warning := range countdownWarnings {
+		if warning < expirationWindow {
+			result.warnings = append(result.warnings, warning)
+		}
+	}
+
+	return result
+}
+
+// Update returns the warning message to emit (if any) and whether the terminal is expired.
+func (countdown *countdown) Update(lastActivity time.Time, now time.Time) (string, bool) {
+	var message string
+
+	if lastActivity.After(countdown.lastActivity) {
+		if countdown.nextWarning != 0 {
+			message = "Terminal activity detected, the inactivity countdown was reset."
+		}
+
+		countdown.lastActivity = lastActivity
+		countdown.nextWarning = 0
+	}
+
+	remaining := countdown.expirationWindow - now.Sub(countdown.lastActivity)
+	if remaining <= 0 {
+		return "", true
+	}
+
+	// Only warn once about the nearest deadline when multiple thresholds were crossed at once
+	var crossed bool
+
+	for countdown.nextWarning < len(countdown.warnings) && remaining <= countdown.warnings[countdown.nextWarning] {
+		countdown.nextWarning++
+		crossed = true
+	}
+
+	if crossed {
+		message = fmt.Sprintf("Terminal will be closed in %s due to inactivity, type anything to keep it open.",
+			remaining.Round(time.Second))
+	}
+
+	return message, false
+}
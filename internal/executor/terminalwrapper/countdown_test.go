@@ -0,0 +1,49 @@
+package terminalwrapper
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCountdown(t *testing.T) {
+	start := time.Now()
+
+	countdown := newCountdown(15 * time.Minute)
+
+	message, expired := countdown.Update(start, start.Add(time.Minute))
+	assert.Empty(t, message)
+	assert.False(t, expired)
+
+	message, expired = countdown.Update(start, start.Add(10*time.Minute))
+	assert.Equal(t, "Terminal will be closed in 5m0s due to inactivity, type anything to keep it open.", message)
+	assert.False(t, expired)
+
+	message, _ = countdown.Update(start, start.Add(11*time.Minute))
+	assert.Empty(t, message)
+
+	// Activity renews the window
+	message, expired = countdown.Update(start.Add(12*time.Minute), start.Add(12*time.Minute))
+	assert.Equal(t, "Terminal activity detected, the inactivity countdown was reset.", message)
+	assert.False(t, expired)
+
+	message, expired = countdown.Update(start.Add(12*time.Minute), start.Add(26*time.Minute+55*time.Second))
+	assert.Equal(t, "Terminal will be closed in 5s due to inactivity, type anything to keep it open.", message)
+	assert.False(t, expired)
+
+	message, expired = countdown.Update(start.Add(12*time.Minute), start.Add(27*time.Minute))
+	assert.Empty(t, message)
+	assert.True(t, expired)
+}
+
+func TestCountdownShortWindow(t *testing.T) {
+	start := time.Now()
+
+	countdown := newCountdown(30 * time.Second)
+
+	message, _ := countdown.Update(start, start.Add(15*time.Second))
+	assert.Empty(t, message)
+
+	message, _ = countdown.Update(start, start.Add(20*time.Second))
+	assert.Equal(t, "Terminal will be closed in 10s due to inactivity, type anything to keep it open.", message)
+}
@@ -0,0 +1,92 @@
+package filetransfer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Client talks to the agent started with "cirrus-ci-agent -file-transfer" in a terminal session.
+type Client struct {
+	conn *conn
+}
+
+// NewClient waits for the agent to become ready, skipping the preceding
+// terminal output (e.g. the echo of the command that has started it).
+func NewClient(rw io.ReadWriter) (*Client, error) {
+	conn := newConn(rw)
+
+	for {
+		fields, err := conn.readFields()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(fields) == 2 && fields[0] == "HELLO" && fields[1] == Version {
+			return &Client{conn: conn}, nil
+		}
+	}
+}
+
+// Download retrieves the remote file, returning its permissions.
+func (client *Client) Download(remotePath string, w io.Writer) (os.FileMode, error) {
+	if err := client.conn.send("GET %s", encodePath(remotePath)); err != nil {
+		return 0, err
+	}
+
+	fields, err := client.conn.readFields()
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case fields[0] == "FILE" && len(fields) == 3:
+		mode, err := strconv.ParseUint(fields[2], 8, 32)
+		if err != nil {
+			return 0, fmt.Errorf("%w: invalid mode %q", ErrProtocol, fields[2])
+		}
+
+		writeErr, protocolErr := client.conn.receiveContents(w)
+		if protocolErr != nil {
+			return 0, protocolErr
+		}
+
+		return os.FileMode(mode), writeErr
+	case fields[0] == "ERROR":
+		return 0, fmt.Errorf("%w: %s", ErrRemote, strings.Join(fields[1:], " "))
+	default:
+		return 0, fmt.Errorf("%w: unexpected %q in response to GET", ErrProtocol, fields[0])
+	}
+}
+
+// Upload writes the contents of r into the remote file with the specified permissions.
+func (client *Client) Upload(remotePath string, mode os.FileMode, r io.Reader, size int64) error {
+	if err := client.conn.send("PUT %s %o %d", encodePath(remotePath), mode.Perm(), size); err != nil {
+		return err
+	}
+
+	if err := client.conn.sendContents(r); err != nil {
+		return err
+	}
+
+	fields, err := client.conn.readFields()
+	if err != nil {
+		return err
+	}
+
+	switch fields[0] {
+	case "OK":
+		return nil
+	case "ERROR":
+		return fmt.Errorf("%w: %s", ErrRemote, strings.Join(fields[1:], " "))
+	default:
+		return fmt.Errorf("%w: unexpected %q in response to PUT", ErrProtocol, fields[0])
+	}
+}
+
+// Close asks the agent to stop serving the requests.
+func (client *Client) Close() error {
+	return client.conn.send("QUIT")
+}
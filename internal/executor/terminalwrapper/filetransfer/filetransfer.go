@@ -0,0 +1,155 @@
+// Package filetransfer implements the scp-like file transfer over a terminal session.
+//
+// Similarly to the port forwarding, it's started by running "cirrus-ci-agent -file-transfer"
+// in the terminal and speaks a line-based protocol on the standard input and output:
+//
+//	GET <base64 path>                   the agent responds with FILE <size> <mode>,
+//	                                    a number of DATA lines and END <sha256>
+//	PUT <base64 path> <mode> <size>     followed by a number of DATA lines and END <sha256>,
+//	                                    the agent responds with OK
+//	DATA <base64 payload>
+//	ERROR <message>                     sent instead of a response when the request fails
+//	HELLO <version>                     sent by the agent once it's ready
+package filetransfer
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+)
+
+const (
+	Version      = "filetransfer/1"
+	maxChunkSize = 16 * 1024
+)
+
+var (
+	ErrProtocol = errors.New("file transfer protocol error")
+	ErrRemote   = errors.New("file transfer failed on the remote side")
+)
+
+// conn is a line-oriented connection shared by the server and the client.
+type conn struct {
+	scanner *bufio.Scanner
+	writer  io.Writer
+}
+
+func newConn(rw io.ReadWriter) *conn {
+	scanner := bufio.NewScanner(rw)
+	scanner.Buffer(make([]byte, 0, 64*1024), 2*maxChunkSize)
+
+	return &conn{scanner: scanner, writer: rw}
+}
+
+func (conn *conn) readFields() ([]string, error) {
+	for conn.scanner.Scan() {
+		// Terminals in the cooked mode might add the carriage returns
+		line := strings.TrimRight(conn.scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		return strings.Fields(line), nil
+	}
+
+	if err := conn.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+func (conn *conn) send(format string, args ...interface{}) error {
+	_, err := fmt.Fprintf(conn.writer, format+"\n", args...)
+
+	return err
+}
+
+func (conn *conn) sendError(err error) error {
+	// Keep the message on a single line
+	message := strings.Join(strings.Fields(err.Error()), " ")
+
+	return conn.send("ERROR %s", message)
+}
+
+// sendContents sends the DATA lines followed by the END line with the checksum.
+func (conn *conn) sendContents(r io.Reader) error {
+	hasher := sha256.New()
+	buf := make([]byte, maxChunkSize)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			hasher.Write(buf[:n])
+
+			if err := conn.send("DATA %s", base64.StdEncoding.EncodeToString(buf[:n])); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return conn.send("END %s", hex.EncodeToString(hasher.Sum(nil)))
+}
+
+// receiveContents writes the DATA lines to w until the END line and verifies the checksum,
+// the protocol errors are returned separately to distinguish them from the failures of w.
+func (conn *conn) receiveContents(w io.Writer) (writeErr error, protocolErr error) {
+	var hasher hash.Hash = sha256.New()
+
+	for {
+		fields, err := conn.readFields()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case fields[0] == "DATA" && len(fields) == 2:
+			payload, err := base64.StdEncoding.DecodeString(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid DATA payload: %v", ErrProtocol, err)
+			}
+
+			hasher.Write(payload)
+
+			// Keep consuming the lines even if writing fails to stay in sync with the other side
+			if writeErr == nil {
+				_, writeErr = w.Write(payload)
+			}
+		case fields[0] == "END" && len(fields) == 2:
+			if writeErr == nil && fields[1] != hex.EncodeToString(hasher.Sum(nil)) {
+				writeErr = fmt.Errorf("%w: checksum mismatch", ErrProtocol)
+			}
+
+			return writeErr, nil
+		case fields[0] == "ERROR":
+			return nil, fmt.Errorf("%w: %s", ErrRemote, strings.Join(fields[1:], " "))
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q while receiving the file", ErrProtocol, fields[0])
+		}
+	}
+}
+
+func encodePath(path string) string {
+	return base64.StdEncoding.EncodeToString([]byte(path))
+}
+
+func decodePath(encoded string) (string, error) {
+	path, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid path: %v", ErrProtocol, err)
+	}
+
+	return string(path), nil
+}
@@ -0,0 +1,85 @@
+package filetransfer_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/filetransfer"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func newClient(t *testing.T) *filetransfer.Client {
+	clientSide, serverSide := net.Pipe()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		// Simulate the terminal echoing the command that has started the agent
+		_, _ = serverSide.Write([]byte("$ cirrus-ci-agent -file-transfer\r\n"))
+		serveErr <- filetransfer.Serve(serverSide)
+	}()
+
+	client, err := filetransfer.NewClient(clientSide)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, client.Close())
+		require.NoError(t, <-serveErr)
+	})
+
+	return client
+}
+
+func TestUploadAndDownload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file with spaces.bin")
+
+	contents := make([]byte, 100*1024)
+	_, err := rand.Read(contents)
+	require.NoError(t, err)
+
+	client := newClient(t)
+
+	require.NoError(t, client.Upload(path, 0755, bytes.NewReader(contents), int64(len(contents))))
+
+	onDisk, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, contents, onDisk)
+
+	var downloaded bytes.Buffer
+	mode, err := client.Download(path, &downloaded)
+	require.NoError(t, err)
+	require.Equal(t, contents, downloaded.Bytes())
+	if runtime.GOOS != "windows" {
+		require.Equal(t, os.FileMode(0755), mode)
+	}
+}
+
+func TestDownloadMissingFile(t *testing.T) {
+	client := newClient(t)
+
+	_, err := client.Download(filepath.Join(t.TempDir(), "missing"), io.Discard)
+	require.ErrorIs(t, err, filetransfer.ErrRemote)
+
+	// The session is still usable afterwards
+	path := filepath.Join(t.TempDir(), "present")
+	require.NoError(t, os.WriteFile(path, []byte("contents"), 0600))
+
+	var downloaded bytes.Buffer
+	_, err = client.Download(path, &downloaded)
+	require.NoError(t, err)
+	require.Equal(t, "contents", downloaded.String())
+}
+
+func TestUploadToMissingDirectory(t *testing.T) {
+	client := newClient(t)
+
+	path := filepath.Join(t.TempDir(), "missing", "file")
+	err := client.Upload(path, 0644, bytes.NewReader([]byte("contents")), 8)
+	require.ErrorIs(t, err, filetransfer.ErrRemote)
+	require.NoFileExists(t, path)
+}
@@ -0,0 +1,139 @@
+package filetransfer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/rawstdio"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ServeStdio serves the file transfer requests of the client that has started
+// "$CIRRUS_AGENT_BINARY -file-transfer", switching the terminal to the raw mode
+// for the binary-safe transfers.
+func ServeStdio() error {
+	stdio, restore, err := rawstdio.Open()
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	return Serve(stdio)
+}
+
+// Serve serves the file transfer requests until the other side disconnects.
+func Serve(rw io.ReadWriter) error {
+	conn := newConn(rw)
+
+	if err := conn.send("HELLO %s", Version); err != nil {
+		return err
+	}
+
+	for {
+		fields, err := conn.readFields()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case fields[0] == "GET" && len(fields) == 2:
+			err = serveGet(conn, fields[1])
+		case fields[0] == "PUT" && len(fields) == 4:
+			err = servePut(conn, fields[1], fields[2])
+		case fields[0] == "QUIT":
+			return nil
+		default:
+			err = conn.sendError(fmt.Errorf("%w: unknown request %q", ErrProtocol, fields[0]))
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func serveGet(conn *conn, encodedPath string) error {
+	path, err := decodePath(encodedPath)
+	if err != nil {
+		return conn.sendError(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return conn.sendError(err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return conn.sendError(err)
+	}
+	if !info.Mode().IsRegular() {
+		return conn.sendError(fmt.Errorf("%s is not a regular file", path))
+	}
+
+	if err := conn.send("FILE %d %o", info.Size(), info.Mode().Perm()); err != nil {
+		return err
+	}
+
+	return conn.sendContents(file)
+}
+
+func servePut(conn *conn, encodedPath string, rawMode string) error {
+	var file *os.File
+	var setupErr error
+
+	path, err := decodePath(encodedPath)
+	if err != nil {
+		setupErr = err
+	}
+
+	mode, err := strconv.ParseUint(rawMode, 8, 32)
+	if err != nil && setupErr == nil {
+		setupErr = fmt.Errorf("%w: invalid mode %q", ErrProtocol, rawMode)
+	}
+
+	// Write to a temporary file first to not leave a partially written file behind
+	if setupErr == nil {
+		file, setupErr = os.CreateTemp(filepath.Dir(path), ".cirrus-upload-*")
+	}
+
+	var w io.Writer = io.Discard
+	if file != nil {
+		w = file
+
+		defer func() {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}()
+	}
+
+	writeErr, protocolErr := conn.receiveContents(w)
+	if protocolErr != nil {
+		return protocolErr
+	}
+
+	if setupErr != nil {
+		return conn.sendError(setupErr)
+	}
+	if writeErr != nil {
+		return conn.sendError(writeErr)
+	}
+
+	if err := file.Chmod(os.FileMode(mode).Perm()); err != nil {
+		return conn.sendError(err)
+	}
+	if err := file.Close(); err != nil {
+		return conn.sendError(err)
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return conn.sendError(err)
+	}
+
+	return conn.send("OK")
+}
@@ -0,0 +1,319 @@
+// Package portforward implements forwarding of the TCP ports over a terminal session.
+//
+// The terminal channel only carries the shell's I/O, so the forwarding is done by running
+// "cirrus-ci-agent -port-forward" in the terminal, which then speaks a line-based protocol
+// on its standard input and output. Lines are used (instead of binary frames) so that
+// the protocol survives the text-oriented terminal transports:
+//
+//	OPEN <stream> <port>            connect to the receiver's localhost:<port>
+//	LISTEN <port> <remote port>     listen on the receiver's localhost:<port> and forward
+//	                                the accepted connections to the sender's <remote port>
+//	DATA <stream> <base64 payload>
+//	CLOSE <stream> [reason]
+//	HELLO <version>                 sent by the agent once it's ready
+//
+// Both sides of the session are symmetric, the stream identifiers allocated by
+// the initiator are odd and the ones allocated by the other side are even.
+package portforward
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const maxChunkSize = 16 * 1024
+
+var ErrProtocol = errors.New("port forwarding protocol error")
+
+type Session struct {
+	rw io.ReadWriter
+
+	writeMtx sync.Mutex
+
+	mtx       sync.Mutex
+	streams   map[uint32]net.Conn
+	listeners []net.Listener
+	nextID    uint32
+}
+
+func NewSession(rw io.ReadWriter, initiator bool) *Session {
+	session := &Session{
+		rw:      rw,
+		streams: map[uint32]net.Conn{},
+		nextID:  2,
+	}
+
+	if initiator {
+		session.nextID = 1
+	}
+
+	return session
+}
+
+// Run processes the incoming frames until the other side disconnects or the context is cancelled.
+func (session *Session) Run(ctx context.Context) error {
+	defer session.closeAll()
+
+	go func() {
+		<-ctx.Done()
+		session.closeAll()
+	}()
+
+	scanner := bufio.NewScanner(session.rw)
+	scanner.Buffer(make([]byte, 0, 64*1024), 2*maxChunkSize)
+
+	for scanner.Scan() {
+		// Terminals in the cooked mode might add the carriage returns
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		if err := session.handle(ctx, strings.Fields(line)); err != nil {
+			return err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return scanner.Err()
+}
+
+func (session *Session) handle(ctx context.Context, fields []string) error {
+	switch fields[0] {
+	case "HELLO":
+		// Sent by the agent once it's ready to speak the protocol
+	case "OPEN":
+		if len(fields) != 3 {
+			return fmt.Errorf("%w: OPEN requires a stream and a port", ErrProtocol)
+		}
+
+		id, err := parseUint32(fields[1])
+		if err != nil {
+			return err
+		}
+		port, err := parsePort(fields[2])
+		if err != nil {
+			return err
+		}
+
+		dialer := net.Dialer{Timeout: 10 * time.Second}
+
+		conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		if err != nil {
+			return session.send("CLOSE %d %s", id, err)
+		}
+
+		session.register(id, conn)
+	case "LISTEN":
+		if len(fields) != 3 {
+			return fmt.Errorf("%w: LISTEN requires a port and a remote port", ErrProtocol)
+		}
+
+		port, err := parsePort(fields[1])
+		if err != nil {
+			return err
+		}
+		remotePort, err := parsePort(fields[2])
+		if err != nil {
+			return err
+		}
+
+		listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+		if err != nil {
+			// Not fatal for the session, the other side simply won't get any connections
+			return nil
+		}
+
+		session.mtx.Lock()
+		session.listeners = append(session.listeners, listener)
+		session.mtx.Unlock()
+
+		go session.accept(listener, remotePort)
+	case "DATA":
+		if len(fields) != 3 {
+			return fmt.Errorf("%w: DATA requires a stream and a payload", ErrProtocol)
+		}
+
+		id, err := parseUint32(fields[1])
+		if err != nil {
+			return err
+		}
+		payload, err := base64.StdEncoding.DecodeString(fields[2])
+		if err != nil {
+			return fmt.Errorf("%w: invalid DATA payload: %v", ErrProtocol, err)
+		}
+
+		session.mtx.Lock()
+		conn, ok := session.streams[id]
+		session.mtx.Unlock()
+
+		// The stream might've been already closed on our side
+		if !ok {
+			return nil
+		}
+
+		if _, err := conn.Write(payload); err != nil {
+			session.closeStream(id)
+
+			return session.send("CLOSE %d %s", id, err)
+		}
+	case "CLOSE":
+		if len(fields) < 2 {
+			return fmt.Errorf("%w: CLOSE requires a stream", ErrProtocol)
+		}
+
+		id, err := parseUint32(fields[1])
+		if err != nil {
+			return err
+		}
+
+		session.closeStream(id)
+	default:
+		return fmt.Errorf("%w: unknown frame %q", ErrProtocol, fields[0])
+	}
+
+	return nil
+}
+
+// Forward forwards the connection to the other side's localhost:<remotePort>.
+func (session *Session) Forward(conn net.Conn, remotePort int) error {
+	session.mtx.Lock()
+	id := session.nextID
+	session.nextID += 2
+	session.mtx.Unlock()
+
+	// Register the stream before sending the OPEN frame to not miss the CLOSE frame
+	// in response, but only start reading from it afterwards to not send the DATA
+	// frames before the OPEN frame
+	session.mtx.Lock()
+	session.streams[id] = conn
+	session.mtx.Unlock()
+
+	if err := session.send("OPEN %d %d", id, remotePort); err != nil {
+		session.closeStream(id)
+
+		return err
+	}
+
+	go session.pump(id, conn)
+
+	return nil
+}
+
+// Listen asks the other side to listen on its localhost:<port>
+// and forward the accepted connections to our localhost:<localPort>.
+func (session *Session) Listen(port int, localPort int) error {
+	return session.send("LISTEN %d %d", port, localPort)
+}
+
+func (session *Session) accept(listener net.Listener, remotePort int) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		if err := session.Forward(conn, remotePort); err != nil {
+			return
+		}
+	}
+}
+
+func (session *Session) register(id uint32, conn net.Conn) {
+	session.mtx.Lock()
+	session.streams[id] = conn
+	session.mtx.Unlock()
+
+	go session.pump(id, conn)
+}
+
+// pump sends the data read from the stream's connection to the other side.
+func (session *Session) pump(id uint32, conn net.Conn) {
+	buf := make([]byte, maxChunkSize)
+
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			if err := session.send("DATA %d %s", id, base64.StdEncoding.EncodeToString(buf[:n])); err != nil {
+				session.closeStream(id)
+
+				return
+			}
+		}
+		if err != nil {
+			// Only notify the other side if it wasn't the one closing the stream
+			if session.closeStream(id) {
+				_ = session.send("CLOSE %d", id)
+			}
+
+			return
+		}
+	}
+}
+
+func (session *Session) closeStream(id uint32) bool {
+	session.mtx.Lock()
+	conn, ok := session.streams[id]
+	delete(session.streams, id)
+	session.mtx.Unlock()
+
+	if ok {
+		_ = conn.Close()
+	}
+
+	return ok
+}
+
+func (session *Session) closeAll() {
+	session.mtx.Lock()
+	defer session.mtx.Unlock()
+
+	for id, conn := range session.streams {
+		_ = conn.Close()
+		delete(session.streams, id)
+	}
+
+	for _, listener := range session.listeners {
+		_ = listener.Close()
+	}
+	session.listeners = nil
+}
+
+func (session *Session) send(format string, args ...interface{}) error {
+	session.writeMtx.Lock()
+	defer session.writeMtx.Unlock()
+
+	_, err := fmt.Fprintf(session.rw, format+"\n", args...)
+
+	return err
+}
+
+func parseUint32(s string) (uint32, error) {
+	value, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid stream identifier %q", ErrProtocol, s)
+	}
+
+	return uint32(value), nil
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.ParseUint(s, 10, 16)
+	if err != nil || port == 0 {
+		return 0, fmt.Errorf("%w: invalid port %q", ErrProtocol, s)
+	}
+
+	return int(port), nil
+}
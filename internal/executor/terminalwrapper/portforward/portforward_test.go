@@ -0,0 +1,111 @@
+package portforward_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/portforward"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func startEchoServer(t *testing.T) int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+func startSessions(t *testing.T) (*portforward.Session, *portforward.Session) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	userSide, agentSide := net.Pipe()
+
+	user := portforward.NewSession(userSide, true)
+	agent := portforward.NewSession(agentSide, false)
+
+	go func() { _ = user.Run(ctx) }()
+	go func() { _ = agent.Run(ctx) }()
+
+	return user, agent
+}
+
+func requireEcho(t *testing.T, conn net.Conn) {
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	_, err := conn.Write([]byte("Hello, World!"))
+	require.NoError(t, err)
+
+	buf := make([]byte, len("Hello, World!"))
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, "Hello, World!", string(buf))
+}
+
+func TestForward(t *testing.T) {
+	echoPort := startEchoServer(t)
+	user, _ := startSessions(t)
+
+	// The user connects to a port on their side, which is forwarded to the task's echo server
+	userConn, forwardedConn := net.Pipe()
+	require.NoError(t, user.Forward(forwardedConn, echoPort))
+
+	requireEcho(t, userConn)
+}
+
+func TestListen(t *testing.T) {
+	echoPort := startEchoServer(t)
+	user, _ := startSessions(t)
+
+	// Find a free port for the task side to listen on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	// The task connects to a port on its side, which is forwarded to the user's echo server
+	require.NoError(t, user.Listen(port, echoPort))
+
+	var conn net.Conn
+	require.Eventually(t, func() bool {
+		conn, err = net.Dial("tcp", listener.Addr().String())
+		return err == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	defer conn.Close()
+
+	requireEcho(t, conn)
+}
+
+func TestForwardToClosedPort(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	require.NoError(t, listener.Close())
+
+	user, _ := startSessions(t)
+
+	userConn, forwardedConn := net.Pipe()
+	require.NoError(t, user.Forward(forwardedConn, port))
+
+	// The connection is closed once the other side fails to connect
+	_ = userConn.SetDeadline(time.Now().Add(10 * time.Second))
+	_, err = userConn.Read(make([]byte, 1))
+	require.ErrorIs(t, err, io.EOF)
+}
@@ -0,0 +1,26 @@
+package portforward
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/rawstdio"
+)
+
+const Version = "portforward/1"
+
+// ServeStdio announces the protocol version to the client that has started
+// "$CIRRUS_AGENT_BINARY -port-forward" and then serves its port forwarding session.
+func ServeStdio(ctx context.Context) error {
+	stdio, restore, err := rawstdio.Open()
+	if err != nil {
+		return err
+	}
+	defer restore()
+
+	session := NewSession(stdio, false)
+
+	if err := session.send("HELLO %s", Version); err != nil {
+		return err
+	}
+
+	return session.Run(ctx)
+}
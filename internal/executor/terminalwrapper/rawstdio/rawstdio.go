@@ -0,0 +1,30 @@
+// Package rawstdio contains the helpers for the protocols spoken over the standard input
+// and output of the agent when it's run from within a terminal session.
+package rawstdio
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+var ErrInsideTmux = errors.New("this doesn't work inside of tmux, " +
+	"disable the tmux-backed terminal sessions with CIRRUS_TERMINAL_TMUX=false")
+
+type Stdio struct {
+	io.Reader
+	io.Writer
+}
+
+// Open checks that the standard input and output can carry the protocol and switches
+// the terminal (if any) to the raw mode, returning a function to restore it.
+func Open() (*Stdio, func(), error) {
+	// tmux re-renders the output, which garbles the protocol
+	if _, ok := os.LookupEnv("TMUX"); ok {
+		return nil, nil, ErrInsideTmux
+	}
+
+	restore := MakeRaw(os.Stdin)
+
+	return &Stdio{Reader: os.Stdin, Writer: os.Stdout}, restore, nil
+}
@@ -0,0 +1,61 @@
+//go:build !windows
+// +build !windows
+
+package rawstdio
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// MakeRaw disables the echo and the line discipline of the terminal (if any)
+// so that the frames pass through unmodified, returning a function to restore them.
+func MakeRaw(file *os.File) func() {
+	info, err := file.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return func() {}
+	}
+
+	saveCmd := exec.Command("stty", "-g")
+	saveCmd.Stdin = file
+
+	saved, err := saveCmd.Output()
+	if err != nil {
+		return func() {}
+	}
+
+	rawCmd := exec.Command("stty", "raw", "-echo")
+	rawCmd.Stdin = file
+
+	if err := rawCmd.Run(); err != nil {
+		return func() {}
+	}
+
+	return func() {
+		restoreCmd := exec.Command("stty", strings.TrimSpace(string(saved)))
+		restoreCmd.Stdin = file
+		_ = restoreCmd.Run()
+	}
+}
+
+// DisableEcho disables the echo of the terminal (if any), returning a function to restore it.
+func DisableEcho(file *os.File) func() {
+	info, err := file.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		return func() {}
+	}
+
+	noEchoCmd := exec.Command("stty", "-echo")
+	noEchoCmd.Stdin = file
+
+	if err := noEchoCmd.Run(); err != nil {
+		return func() {}
+	}
+
+	return func() {
+		echoCmd := exec.Command("stty", "echo")
+		echoCmd.Stdin = file
+		_ = echoCmd.Run()
+	}
+}
@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package rawstdio
+
+import "os"
+
+func MakeRaw(file *os.File) func() {
+	return func() {}
+}
+
+func DisableEcho(file *os.File) func() {
+	return func() {}
+}
@@ -0,0 +1,121 @@
+// Package recorder records the terminal sessions in the asciinema v2 format[1].
+//
+// [1]: https://docs.asciinema.org/manual/asciicast/v2/
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+type header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Writer writes the asciicast v2 output events.
+type Writer struct {
+	mtx   sync.Mutex
+	w     io.Writer
+	start time.Time
+	now   func() time.Time
+
+	// pending holds an incomplete UTF-8 sequence from the end of the previous write
+	pending []byte
+}
+
+func NewWriter(w io.Writer, width int, height int, env map[string]string) (*Writer, error) {
+	writer := &Writer{
+		w:   w,
+		now: time.Now,
+	}
+	writer.start = writer.now()
+
+	headerBytes, err := json.Marshal(&header{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: writer.start.Unix(),
+		Env:       env,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := fmt.Fprintf(w, "%s\n", headerBytes); err != nil {
+		return nil, err
+	}
+
+	return writer, nil
+}
+
+// Write records the terminal output, implementing the io.Writer.
+func (writer *Writer) Write(data []byte) (int, error) {
+	writer.mtx.Lock()
+	defer writer.mtx.Unlock()
+
+	buf := append(writer.pending, data...)
+
+	// Don't split the multi-byte characters between the events, otherwise
+	// these would be replaced by the JSON encoder with U+FFFD
+	complete := len(buf) - incompleteSuffixLength(buf)
+	writer.pending = append([]byte{}, buf[complete:]...)
+
+	if complete == 0 {
+		return len(data), nil
+	}
+
+	return len(data), writer.writeEvent("o", string(buf[:complete]))
+}
+
+// Resize records the terminal size change.
+func (writer *Writer) Resize(width int, height int) error {
+	writer.mtx.Lock()
+	defer writer.mtx.Unlock()
+
+	return writer.writeEvent("r", fmt.Sprintf("%dx%d", width, height))
+}
+
+func (writer *Writer) writeEvent(code string, data string) error {
+	event, err := json.Marshal([]interface{}{
+		writer.now().Sub(writer.start).Seconds(),
+		code,
+		data,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(writer.w, "%s\n", event)
+
+	return err
+}
+
+// incompleteSuffixLength returns the length of the trailing bytes
+// that might be the beginning of a multi-byte UTF-8 sequence.
+func incompleteSuffixLength(buf []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(buf); i++ {
+		b := buf[len(buf)-i]
+
+		// Continuation byte, look further
+		if b&0xC0 == 0x80 {
+			continue
+		}
+
+		// A start byte: incomplete if the sequence needs more bytes than we have
+		if b >= 0xC0 && !utf8.FullRune(buf[len(buf)-i:]) {
+			return i
+		}
+
+		return 0
+	}
+
+	return 0
+}
@@ -0,0 +1,56 @@
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	writer, err := NewWriter(&buf, 80, 24, map[string]string{"TERM": "xterm"})
+	require.NoError(t, err)
+
+	start := writer.start
+	writer.now = func() time.Time {
+		return start.Add(1500 * time.Millisecond)
+	}
+
+	_, err = writer.Write([]byte("hello\r\n"))
+	require.NoError(t, err)
+
+	// "é" split between the writes
+	_, err = writer.Write([]byte{'a', 0xC3})
+	require.NoError(t, err)
+	_, err = writer.Write([]byte{0xA9, 'b'})
+	require.NoError(t, err)
+
+	require.NoError(t, writer.Resize(120, 40))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 5)
+	var header header
+	require.NoError(t, json.Unmarshal(lines[0], &header))
+	require.Equal(t, 2, header.Version)
+	require.Equal(t, 80, header.Width)
+	require.Equal(t, 24, header.Height)
+	require.Equal(t, start.Unix(), header.Timestamp)
+	require.Equal(t, map[string]string{"TERM": "xterm"}, header.Env)
+
+	require.JSONEq(t, `[1.5, "o", "hello\r\n"]`, string(lines[1]))
+	require.JSONEq(t, `[1.5, "o", "a"]`, string(lines[2]))
+	require.JSONEq(t, `[1.5, "o", "éb"]`, string(lines[3]))
+	require.JSONEq(t, `[1.5, "r", "120x40"]`, string(lines[4]))
+}
+
+func TestIncompleteSuffixLength(t *testing.T) {
+	require.Equal(t, 0, incompleteSuffixLength([]byte("abc")))
+	require.Equal(t, 0, incompleteSuffixLength([]byte("é")))
+	require.Equal(t, 1, incompleteSuffixLength([]byte{'a', 0xC3}))
+	require.Equal(t, 2, incompleteSuffixLength([]byte{'a', 0xE2, 0x82}))
+	require.Equal(t, 0, incompleteSuffixLength([]byte{0xE2, 0x82, 0xAC}))
+	require.Equal(t, 0, incompleteSuffixLength(nil))
+}
@@ -0,0 +1,103 @@
+//go:build !windows
+// +build !windows
+
+package recorder
+
+import (
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/rawstdio"
+	"github.com/creack/pty"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+// Run runs the command (or the user's shell if none is specified) in a new pseudo-terminal
+// attached to the current one and records its output into a new file in the recordings
+// directory. The exit code of the command is returned.
+func Run(recordingsDir string, command []string) (int, error) {
+	if len(command) == 0 {
+		shell, ok := os.LookupEnv("SHELL")
+		if !ok {
+			shell = "/bin/sh"
+		}
+
+		command = []string{shell}
+	}
+
+	if err := os.MkdirAll(recordingsDir, 0700); err != nil {
+		return 0, err
+	}
+
+	recordingName := fmt.Sprintf("session-%s-%d.cast", time.Now().UTC().Format("20060102T150405Z"), os.Getpid())
+
+	recordingFile, err := os.OpenFile(filepath.Join(recordingsDir, recordingName), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0600)
+	if err != nil {
+		return 0, err
+	}
+	defer recordingFile.Close()
+
+	width, height := 80, 24
+	if size, err := pty.GetsizeFull(os.Stdin); err == nil {
+		width, height = int(size.Cols), int(size.Rows)
+	}
+
+	recording, err := NewWriter(recordingFile, width, height, map[string]string{
+		"SHELL": os.Getenv("SHELL"),
+		"TERM":  os.Getenv("TERM"),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	cmd := exec.Command(command[0], command[1:]...)
+
+	ptmx, err := pty.StartWithSize(cmd, &pty.Winsize{Cols: uint16(width), Rows: uint16(height)})
+	if err != nil {
+		return 0, err
+	}
+	defer ptmx.Close()
+
+	// Propagate the terminal size changes
+	sigwinch := make(chan os.Signal, 1)
+	signal.Notify(sigwinch, syscall.SIGWINCH)
+	defer signal.Stop(sigwinch)
+
+	go func() {
+		for range sigwinch {
+			if err := pty.InheritSize(os.Stdin, ptmx); err != nil {
+				continue
+			}
+
+			if size, err := pty.GetsizeFull(ptmx); err == nil {
+				_ = recording.Resize(int(size.Cols), int(size.Rows))
+			}
+		}
+	}()
+
+	restore := rawstdio.MakeRaw(os.Stdin)
+	defer restore()
+
+	go func() {
+		_, _ = io.Copy(ptmx, os.Stdin)
+	}()
+
+	// Returns once the command exits and the pseudo-terminal is closed
+	_, _ = io.Copy(io.MultiWriter(os.Stdout, recording), ptmx)
+
+	if err := cmd.Wait(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode(), nil
+		}
+
+		return 0, err
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,10 @@
+//go:build windows
+// +build windows
+
+package recorder
+
+import "errors"
+
+func Run(recordingsDir string, command []string) (int, error) {
+	return 0, errors.New("recording the terminal sessions is not supported on Windows")
+}
@@ -0,0 +1,264 @@
+// Package restrictedshell implements a minimal shell for the read-only terminal sessions.
+//
+// Only the allow-listed commands can be run and their arguments are passed as is,
+// without any shell interpretation (no pipes, redirections, globbing, variable expansion, etc.).
+package restrictedshell
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// EnvCirrusTerminalAllowedCommands overrides the comma-separated list of commands
+// that can be run in the read-only terminal sessions
+const EnvCirrusTerminalAllowedCommands = "CIRRUS_TERMINAL_ALLOWED_COMMANDS"
+
+// DefaultAllowedCommands only allow viewing the logs and the state of the system.
+//
+// Note that the commands like less, find or vim are deliberately not included
+// since they can be used to spawn an unrestricted shell.
+var DefaultAllowedCommands = []string{"cat", "df", "du", "grep", "head", "ls", "ps", "stat", "tail", "uptime", "wc"}
+
+var ErrUnbalancedQuotes = errors.New("unbalanced quotes")
+
+const prompt = "read-only$ "
+
+type Shell struct {
+	allowed map[string]struct{}
+	in      *bufio.Reader
+	out     io.Writer
+	dir     string
+}
+
+func New(allowed []string, in io.Reader, out io.Writer) *Shell {
+	shell := &Shell{
+		allowed: map[string]struct{}{},
+		in:      bufio.NewReader(in),
+		out:     out,
+	}
+
+	for _, command := range allowed {
+		shell.allowed[command] = struct{}{}
+	}
+
+	return shell
+}
+
+// AllowedCommandsFromEnvironment returns the commands allow-listed
+// by EnvCirrusTerminalAllowedCommands or the DefaultAllowedCommands.
+func AllowedCommandsFromEnvironment() []string {
+	value, ok := os.LookupEnv(EnvCirrusTerminalAllowedCommands)
+	if !ok {
+		return DefaultAllowedCommands
+	}
+
+	var result []string
+
+	for _, command := range strings.Split(value, ",") {
+		command = strings.TrimSpace(command)
+		if command == "" {
+			continue
+		}
+
+		result = append(result, command)
+	}
+
+	return result
+}
+
+// RunStdio runs the shell in place of the session's regular shell, allowing
+// the commands from AllowedCommandsFromEnvironment.
+func RunStdio() error {
+	// Ctrl+C should only interrupt the currently running command
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	return New(AllowedCommandsFromEnvironment(), os.Stdin, os.Stdout).Run()
+}
+
+// Run reads and executes the commands until the input is exhausted or the "exit" command is received.
+func (shell *Shell) Run() error {
+	_, _ = fmt.Fprintf(shell.out, "This terminal session is read-only, type \"help\" to list the available commands.\n")
+
+	for {
+		_, _ = fmt.Fprint(shell.out, prompt)
+
+		line, err := shell.in.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if strings.TrimSpace(line) != "" && shell.Execute(line) {
+			return nil
+		}
+
+		if errors.Is(err, io.EOF) {
+			_, _ = fmt.Fprintln(shell.out)
+
+			return nil
+		}
+	}
+}
+
+// Execute executes a single command line and returns true when the shell should exit.
+func (shell *Shell) Execute(line string) bool {
+	args, err := SplitArgs(strings.TrimRight(line, "\r\n"))
+	if err != nil {
+		_, _ = fmt.Fprintf(shell.out, "%v\n", err)
+
+		return false
+	}
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "exit":
+		return true
+	case "help":
+		_, _ = fmt.Fprintf(shell.out, "Available commands: cd, exit, help, %s\n",
+			strings.Join(shell.allowedCommands(), ", "))
+
+		return false
+	case "cd":
+		shell.changeDirectory(args[1:])
+
+		return false
+	}
+
+	if _, ok := shell.allowed[args[0]]; !ok || strings.ContainsRune(args[0], os.PathSeparator) {
+		_, _ = fmt.Fprintf(shell.out, "%s: command is not allowed in the read-only terminal session\n", args[0])
+
+		return false
+	}
+
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		_, _ = fmt.Fprintf(shell.out, "%s: command not found\n", args[0])
+
+		return false
+	}
+
+	// The commands have no access to the input to avoid
+	// them competing with the shell for the user's input
+	cmd := exec.Command(path, args[1:]...)
+	cmd.Dir = shell.dir
+	cmd.Stdout = shell.out
+	cmd.Stderr = shell.out
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			_, _ = fmt.Fprintf(shell.out, "%s: %v\n", args[0], err)
+		}
+	}
+
+	return false
+}
+
+func (shell *Shell) changeDirectory(args []string) {
+	var dir string
+
+	switch len(args) {
+	case 0:
+		home, err := os.UserHomeDir()
+		if err != nil {
+			_, _ = fmt.Fprintf(shell.out, "cd: %v\n", err)
+
+			return
+		}
+		dir = home
+	case 1:
+		dir = args[0]
+		if shell.dir != "" && !filepath.IsAbs(dir) {
+			dir = filepath.Join(shell.dir, dir)
+		}
+	default:
+		_, _ = fmt.Fprintln(shell.out, "cd: too many arguments")
+
+		return
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		_, _ = fmt.Fprintf(shell.out, "cd: %v\n", err)
+
+		return
+	}
+	if !info.IsDir() {
+		_, _ = fmt.Fprintf(shell.out, "cd: %s: not a directory\n", dir)
+
+		return
+	}
+
+	shell.dir = dir
+}
+
+func (shell *Shell) allowedCommands() []string {
+	var result []string
+
+	for command := range shell.allowed {
+		result = append(result, command)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// SplitArgs splits the command line into arguments, honoring the single and double quotes
+// and the backslash escapes outside of the single quotes.
+func SplitArgs(line string) ([]string, error) {
+	var result []string
+	var current strings.Builder
+	var inArg, escaped bool
+	var quote rune
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				result = append(result, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, ErrUnbalancedQuotes
+	}
+
+	if inArg {
+		result = append(result, current.String())
+	}
+
+	return result, nil
+}
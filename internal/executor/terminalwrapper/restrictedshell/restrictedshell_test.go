@@ -0,0 +1,62 @@
+//go:build !windows
+// +build !windows
+
+package restrictedshell_test
+
+import (
+	"bytes"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/restrictedshell"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSplitArgs(t *testing.T) {
+	args, err := restrictedshell.SplitArgs(`tail -n 10 "build log.txt" 'it''s' a\ b`)
+	require.NoError(t, err)
+	require.Equal(t, []string{"tail", "-n", "10", "build log.txt", "its", "a b"}, args)
+
+	args, err = restrictedshell.SplitArgs("   ")
+	require.NoError(t, err)
+	require.Empty(t, args)
+
+	_, err = restrictedshell.SplitArgs(`cat "unterminated`)
+	require.ErrorIs(t, err, restrictedshell.ErrUnbalancedQuotes)
+}
+
+func TestShell(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "build.log"), []byte("compilation failed\n"), 0600))
+
+	input := strings.Join([]string{
+		"cd " + dir,
+		"cat build.log",
+		"rm build.log",
+		"/bin/cat build.log",
+		"cat missing.log | rm *",
+		"help",
+		"exit",
+		"cat build.log",
+	}, "\n") + "\n"
+
+	var output bytes.Buffer
+
+	shell := restrictedshell.New([]string{"cat"}, strings.NewReader(input), &output)
+	require.NoError(t, shell.Run())
+
+	require.Contains(t, output.String(), "compilation failed\n")
+	require.Contains(t, output.String(), "rm: command is not allowed")
+	require.Contains(t, output.String(), "/bin/cat: command is not allowed")
+	require.Contains(t, output.String(), "Available commands: cd, exit, help, cat\n")
+	require.Equal(t, 1, strings.Count(output.String(), "compilation failed"))
+	require.FileExists(t, filepath.Join(dir, "build.log"))
+}
+
+func TestAllowedCommandsFromEnvironment(t *testing.T) {
+	require.Equal(t, restrictedshell.DefaultAllowedCommands, restrictedshell.AllowedCommandsFromEnvironment())
+
+	t.Setenv(restrictedshell.EnvCirrusTerminalAllowedCommands, "tail, journalctl,,")
+	require.Equal(t, []string{"tail", "journalctl"}, restrictedshell.AllowedCommandsFromEnvironment())
+}
@@ -0,0 +1,380 @@
+package terminalwrapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/terminalwrapper/approval"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvCirrusTerminalTmux set to "false" disables running the terminal shells inside of a tmux session
+	EnvCirrusTerminalTmux = "CIRRUS_TERMINAL_TMUX"
+
+	// EnvCirrusTerminalRecord set to "true" records the terminal sessions
+	EnvCirrusTerminalRecord = "CIRRUS_TERMINAL_RECORD"
+
+	// EnvCirrusTerminalReadOnly set to "true" only allows running the allow-listed commands
+	// in the terminal sessions (see restrictedshell package)
+	EnvCirrusTerminalReadOnly = "CIRRUS_TERMINAL_READ_ONLY"
+
+	// EnvCirrusTerminalApprovalToken requires the terminal sessions to enter this token
+	// before the shell becomes available
+	EnvCirrusTerminalApprovalToken = "CIRRUS_TERMINAL_APPROVAL_TOKEN"
+
+	// envGated is only set in the terminal host's shell environment and makes the shell shims
+	// (see gate) start the session through the access restrictions, it's unset before that,
+	// so the nested shells and the task's scripts use the shims as a plain shell
+	envGated = "CIRRUS_TERMINAL_GATED"
+
+	// envRecorderActive prevents the shell spawned by the recorder from starting another recorder
+	envRecorderActive = "CIRRUS_TERMINAL_RECORDER_ACTIVE"
+)
+
+var ErrTmuxUnavailable = errors.New("tmux is not available")
+
+// ShellStartup customizes the startup of the terminal shells.
+//
+// The terminal host always spawns a plain shell, so the customization is done from
+// the shell's startup: the ENV variable is used by sh, PROMPT_COMMAND by Bash
+// and ZDOTDIR by Zsh.
+//
+// The access restrictions can't rely on the shell's startup since the user's rc files run
+// first and an interrupt skips the rest of the PROMPT_COMMAND. Instead, the terminal host's
+// lookup of the shell in the agent's PATH is pointed to the shims that apply the restrictions
+// before the shell (if any) is started.
+type ShellStartup struct {
+	configDir string
+
+	// tmux makes the shells attach to a shared tmux session, so that a dropped
+	// connection can be re-attached without losing state and multiple operators
+	// can attach to the same debugging session
+	tmuxPath    string
+	tmuxSession string
+
+	// The agent binary is used to run the helper modes below
+	agentBinary string
+
+	// The agent's -record-terminal mode wraps the shell (or the tmux client)
+	// to record the session in the asciinema format
+	recordingsDir string
+
+	// The agent's -approve-terminal mode asks for the approval token
+	// before the shell becomes available
+	approval bool
+
+	// The agent's -restricted-shell mode replaces the shell
+	readOnly bool
+
+	// The shells register their TTYs, so that the agent can notify the users
+	// (e.g. that the terminal is about to be closed due to inactivity)
+	notifications bool
+
+	// The agent's PATH before the shims were prepended to it
+	originalPath string
+	gated        bool
+}
+
+func NewShellStartup() (*ShellStartup, error) {
+	if runtime.GOOS == "windows" {
+		return nil, errors.New("customizing the terminal shell startup is not supported on Windows")
+	}
+
+	configDir, err := os.MkdirTemp("", "cirrus-terminal-")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShellStartup{configDir: configDir}, nil
+}
+
+func (startup *ShellStartup) EnableTmux(sessionName string) error {
+	tmuxPath, err := exec.LookPath("tmux")
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrTmuxUnavailable, err)
+	}
+
+	startup.tmuxPath = tmuxPath
+	startup.tmuxSession = sessionName
+
+	return nil
+}
+
+func (startup *ShellStartup) EnableRecording(agentBinary string, recordingsDir string) {
+	startup.agentBinary = agentBinary
+	startup.recordingsDir = recordingsDir
+}
+
+func (startup *ShellStartup) EnableApproval(agentBinary string, token string) error {
+	if err := approval.WriteTokenHash(startup.tokenHashPath(), token); err != nil {
+		return err
+	}
+
+	startup.agentBinary = agentBinary
+	startup.approval = true
+
+	return nil
+}
+
+// EnableReadOnly replaces the shell with the restricted shell,
+// which also means that the tmux won't be used.
+func (startup *ShellStartup) EnableReadOnly(agentBinary string) {
+	startup.agentBinary = agentBinary
+	startup.readOnly = true
+}
+
+// EnableNotifications makes the shells register their TTYs for the Notify() to work.
+func (startup *ShellStartup) EnableNotifications() {
+	startup.notifications = true
+}
+
+// Notify writes the message to the TTYs of the terminal shells.
+func (startup *ShellStartup) Notify(message string) {
+	if startup == nil || !startup.notifications {
+		return
+	}
+
+	ttys, err := os.ReadFile(startup.ttysPath())
+	if err != nil {
+		return
+	}
+
+	seen := map[string]struct{}{}
+
+	for _, tty := range strings.Split(string(ttys), "\n") {
+		if !strings.HasPrefix(tty, "/dev/") {
+			continue
+		}
+		if _, ok := seen[tty]; ok {
+			continue
+		}
+		seen[tty] = struct{}{}
+
+		// The TTY might be already gone along with the session
+		file, err := os.OpenFile(tty, os.O_WRONLY, 0)
+		if err != nil {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(file, "\r\n*** %s ***\r\n", message)
+		_ = file.Close()
+	}
+}
+
+func (startup *ShellStartup) ttysPath() string {
+	return filepath.Join(startup.configDir, "ttys")
+}
+
+func (startup *ShellStartup) tokenHashPath() string {
+	return filepath.Join(startup.configDir, "approval-token.sha256")
+}
+
+func (startup *ShellStartup) rcPath() string {
+	return filepath.Join(startup.configDir, "cirrus-terminal.sh")
+}
+
+func (startup *ShellStartup) shimsDir() string {
+	return filepath.Join(startup.configDir, "bin")
+}
+
+func (startup *ShellStartup) restricted() bool {
+	return startup.approval || startup.readOnly
+}
+
+// gatedCommand asks for the approval (if enabled) and then runs either the restricted
+// shell (recording it if enabled, since it doesn't run the startup script) or the shell.
+func (startup *ShellStartup) gatedCommand(shell string) []string {
+	command := []string{shell}
+
+	if startup.readOnly {
+		command = []string{startup.agentBinary, "-restricted-shell"}
+
+		if startup.recordingsDir != "" {
+			command = append([]string{startup.agentBinary, "-record-terminal", startup.recordingsDir}, command...)
+		}
+	}
+
+	if startup.approval {
+		command = append([]string{startup.agentBinary, "-approve-terminal", startup.tokenHashPath()}, command...)
+	}
+
+	return command
+}
+
+// shim runs the gated command in place of the shell the terminal host is about to start,
+// and the named shell (if it exists) otherwise.
+func (startup *ShellStartup) shim(name string, realPath string) string {
+	var sb strings.Builder
+
+	shell := realPath
+	if shell == "" {
+		// The terminal host falls back to /bin/sh when the preferred shells are not found
+		shell = "/bin/sh"
+	}
+
+	var quotedCommand []string
+	for _, arg := range startup.gatedCommand(shell) {
+		quotedCommand = append(quotedCommand, shellQuote(arg))
+	}
+
+	sb.WriteString("#!/bin/sh\n")
+	fmt.Fprintf(&sb, "if [ -n \"$%s\" ]; then unset %s; exec %s; fi\n", envGated, envGated,
+		strings.Join(quotedCommand, " "))
+
+	if realPath != "" {
+		fmt.Fprintf(&sb, "exec %s \"$@\"\n", shellQuote(realPath))
+	} else {
+		fmt.Fprintf(&sb, "echo %s >&2\nexit 127\n", shellQuote(name+": not found"))
+	}
+
+	return sb.String()
+}
+
+// gate makes the terminal host start the gated command instead of the shell by prepending
+// the shims of the shells it looks for (Zsh on macOS and Bash) to the agent's PATH.
+func (startup *ShellStartup) gate() error {
+	if err := os.MkdirAll(startup.shimsDir(), 0700); err != nil {
+		return err
+	}
+
+	for _, name := range []string{"bash", "zsh"} {
+		// Resolve the shells before the shims are in the PATH
+		realPath, _ := exec.LookPath(name)
+
+		shimPath := filepath.Join(startup.shimsDir(), name)
+		if err := os.WriteFile(shimPath, []byte(startup.shim(name, realPath)), 0700); err != nil {
+			return err
+		}
+	}
+
+	if !startup.gated {
+		startup.originalPath = os.Getenv("PATH")
+		startup.gated = true
+	}
+
+	return os.Setenv("PATH", startup.shimsDir()+string(os.PathListSeparator)+startup.originalPath)
+}
+
+// script replaces the shell with the recorder and/or a tmux client attached
+// to the session (creating it if necessary).
+func (startup *ShellStartup) script() string {
+	var sb strings.Builder
+
+	// Only register the outermost shell's TTY since the output of the recorder
+	// and the tmux is displayed there anyway
+	if startup.notifications {
+		fmt.Fprintf(&sb, "if [ -z \"$TMUX\" ] && [ -z \"$%s\" ] && [ -z \"$cirrus_terminal_tty\" ]; "+
+			"then cirrus_terminal_tty=$(tty) && echo \"$cirrus_terminal_tty\" >> %s; fi\n",
+			envRecorderActive, shellQuote(startup.ttysPath()))
+	}
+
+	var tmuxCommand string
+	if startup.tmuxPath != "" && !startup.readOnly {
+		tmuxCommand = fmt.Sprintf("%s -L %s new-session -A -s %s", shellQuote(startup.tmuxPath),
+			shellQuote(startup.tmuxSession), shellQuote(startup.tmuxSession))
+	}
+
+	if startup.recordingsDir != "" && !startup.readOnly {
+		recorderCommand := fmt.Sprintf("%s -record-terminal %s", shellQuote(startup.agentBinary),
+			shellQuote(startup.recordingsDir))
+
+		// Without tmux, the recorder spawns a new shell, which will skip this
+		if tmuxCommand != "" {
+			recorderCommand += " " + tmuxCommand
+		}
+
+		fmt.Fprintf(&sb, "if [ -z \"$%s\" ]; then %s=1; export %s; exec %s; fi\n",
+			envRecorderActive, envRecorderActive, envRecorderActive, recorderCommand)
+	}
+
+	if tmuxCommand != "" {
+		fmt.Fprintf(&sb, "if [ -z \"$TMUX\" ]; then exec %s; fi\n", tmuxCommand)
+	}
+
+	return sb.String()
+}
+
+// ShellEnv returns the shell environment amended to run the startup script.
+func (startup *ShellStartup) ShellEnv(shellEnv []string) ([]string, error) {
+	if startup.tmuxPath == "" && startup.recordingsDir == "" && !startup.restricted() && !startup.notifications {
+		return shellEnv, nil
+	}
+
+	if startup.restricted() {
+		if err := startup.gate(); err != nil {
+			return nil, err
+		}
+	}
+
+	script := startup.script()
+
+	if err := os.WriteFile(startup.rcPath(), []byte(script), 0600); err != nil {
+		return nil, err
+	}
+
+	zshrc := script + "[ -f \"$HOME/.zshrc\" ] && . \"$HOME/.zshrc\"\n"
+	if err := os.WriteFile(filepath.Join(startup.configDir, ".zshrc"), []byte(zshrc), 0600); err != nil {
+		return nil, err
+	}
+
+	if len(shellEnv) == 0 {
+		shellEnv = os.Environ()
+	}
+
+	result := make([]string, 0, len(shellEnv)+4)
+
+	for _, item := range shellEnv {
+		if strings.HasPrefix(item, "ENV=") || strings.HasPrefix(item, "PROMPT_COMMAND=") ||
+			strings.HasPrefix(item, "ZDOTDIR=") {
+			continue
+		}
+
+		// Only gate the sessions when restricted and never reveal the token
+		if strings.HasPrefix(item, envGated+"=") || strings.HasPrefix(item, EnvCirrusTerminalApprovalToken+"=") {
+			continue
+		}
+
+		result = append(result, item)
+	}
+
+	if startup.restricted() {
+		result = append(result, envGated+"=1")
+	}
+
+	return append(result,
+		"ENV="+startup.rcPath(),
+		"PROMPT_COMMAND=. "+shellQuote(startup.rcPath()),
+		"ZDOTDIR="+startup.configDir,
+	), nil
+}
+
+// Close terminates the tmux server along with the sessions and cleans up the configuration.
+func (startup *ShellStartup) Close() error {
+	if startup == nil {
+		return nil
+	}
+
+	if startup.tmuxPath != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		// The server might've not been started at all if nobody has attached
+		_ = exec.CommandContext(ctx, startup.tmuxPath, "-L", startup.tmuxSession, "kill-server").Run()
+	}
+
+	if startup.gated {
+		_ = os.Setenv("PATH", startup.originalPath)
+	}
+
+	return os.RemoveAll(startup.configDir)
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,160 @@
+//go:build !windows
+// +build !windows
+
+package terminalwrapper
+
+import (
+	"github.com/creack/pty"
+	"github.com/stretchr/testify/require"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestShellQuote(t *testing.T) {
+	require.Equal(t, `'simple'`, shellQuote("simple"))
+	require.Equal(t, `'it'\''s'`, shellQuote("it's"))
+}
+
+func TestShellStartupTmux(t *testing.T) {
+	startup := &ShellStartup{configDir: t.TempDir(), tmuxPath: "/usr/bin/tmux", tmuxSession: "cirrus-task-42"}
+
+	shellEnv, err := startup.ShellEnv([]string{"FOO=bar", "PROMPT_COMMAND=history -a", "ZDOTDIR=/home/user"})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"FOO=bar",
+		"ENV=" + startup.rcPath(),
+		"PROMPT_COMMAND=. " + shellQuote(startup.rcPath()),
+		"ZDOTDIR=" + startup.configDir,
+	}, shellEnv)
+	require.FileExists(t, startup.rcPath())
+
+	require.Equal(t, "if [ -z \"$TMUX\" ]; then exec '/usr/bin/tmux' -L 'cirrus-task-42' "+
+		"new-session -A -s 'cirrus-task-42'; fi\n", startup.script())
+}
+
+func TestShellStartupRecording(t *testing.T) {
+	startup := &ShellStartup{configDir: t.TempDir()}
+	startup.EnableRecording("/usr/local/bin/cirrus-ci-agent", "/tmp/recordings")
+
+	require.Equal(t, "if [ -z \"$CIRRUS_TERMINAL_RECORDER_ACTIVE\" ]; then CIRRUS_TERMINAL_RECORDER_ACTIVE=1; "+
+		"export CIRRUS_TERMINAL_RECORDER_ACTIVE; exec '/usr/local/bin/cirrus-ci-agent' -record-terminal "+
+		"'/tmp/recordings'; fi\n", startup.script())
+}
+
+func TestShellStartupApprovalAndReadOnly(t *testing.T) {
+	t.Setenv("PATH", os.Getenv("PATH"))
+
+	startup := &ShellStartup{configDir: t.TempDir(), tmuxPath: "/usr/bin/tmux", tmuxSession: "cirrus-task-42"}
+	require.NoError(t, startup.EnableApproval("/usr/local/bin/cirrus-ci-agent", "s3cr3t"))
+	startup.EnableReadOnly("/usr/local/bin/cirrus-ci-agent")
+
+	require.FileExists(t, startup.tokenHashPath())
+	require.Equal(t, []string{"/usr/local/bin/cirrus-ci-agent", "-approve-terminal", startup.tokenHashPath(),
+		"/usr/local/bin/cirrus-ci-agent", "-restricted-shell"}, startup.gatedCommand("/bin/bash"))
+
+	// The restrictions are applied before the shell starts, not from its startup
+	require.Empty(t, startup.script())
+
+	shellEnv, err := startup.ShellEnv([]string{"FOO=bar", "CIRRUS_TERMINAL_GATED=",
+		"CIRRUS_TERMINAL_APPROVAL_TOKEN=s3cr3t"})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"FOO=bar",
+		"CIRRUS_TERMINAL_GATED=1",
+		"ENV=" + startup.rcPath(),
+		"PROMPT_COMMAND=. " + shellQuote(startup.rcPath()),
+		"ZDOTDIR=" + startup.configDir,
+	}, shellEnv)
+
+	// The terminal host will find the shim instead of the shell
+	bashPath, err := exec.LookPath("bash")
+	require.NoError(t, err)
+	require.Equal(t, startup.shimsDir(), filepath.Dir(bashPath))
+
+	require.NoError(t, startup.Close())
+	bashPath, err = exec.LookPath("bash")
+	if err == nil {
+		require.NotEqual(t, startup.shimsDir(), filepath.Dir(bashPath))
+	}
+}
+
+func TestShellStartupShim(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not installed")
+	}
+
+	startup := &ShellStartup{configDir: t.TempDir()}
+	require.NoError(t, startup.EnableApproval("/bin/echo", "s3cr3t"))
+
+	shimPath := filepath.Join(t.TempDir(), "bash")
+	require.NoError(t, os.WriteFile(shimPath, []byte(startup.shim("bash", "/bin/echo")), 0700))
+
+	// Started by the terminal host
+	cmd := exec.Command(shimPath)
+	cmd.Env = []string{"CIRRUS_TERMINAL_GATED=1"}
+	output, err := cmd.Output()
+	require.NoError(t, err)
+	require.Equal(t, "-approve-terminal "+startup.tokenHashPath()+" /bin/echo\n", string(output))
+
+	// Started by anything else
+	cmd = exec.Command(shimPath, "hello")
+	output, err = cmd.Output()
+	require.NoError(t, err)
+	require.Equal(t, "hello\n", string(output))
+
+	// The shell doesn't exist
+	require.NoError(t, os.WriteFile(shimPath, []byte(startup.shim("bash", "")), 0700))
+	cmd = exec.Command(shimPath)
+	require.Error(t, cmd.Run())
+	require.Equal(t, 127, cmd.ProcessState.ExitCode())
+}
+
+func TestShellStartupNotifications(t *testing.T) {
+	startup := &ShellStartup{configDir: t.TempDir()}
+	startup.EnableNotifications()
+
+	require.Equal(t, "if [ -z \"$TMUX\" ] && [ -z \"$CIRRUS_TERMINAL_RECORDER_ACTIVE\" ] && "+
+		"[ -z \"$cirrus_terminal_tty\" ]; then cirrus_terminal_tty=$(tty) && "+
+		"echo \"$cirrus_terminal_tty\" >> '"+startup.ttysPath()+"'; fi\n", startup.script())
+
+	ptmx, tty, err := pty.Open()
+	require.NoError(t, err)
+	defer ptmx.Close()
+	defer tty.Close()
+
+	ttys := tty.Name() + "\n" + tty.Name() + "\n/dev/nonexistent\nnot a tty\n"
+	require.NoError(t, os.WriteFile(startup.ttysPath(), []byte(ttys), 0600))
+
+	startup.Notify("Terminal will be closed in 10s")
+
+	buf := make([]byte, 4096)
+	n, err := ptmx.Read(buf)
+	require.NoError(t, err)
+	require.Contains(t, string(buf[:n]), "*** Terminal will be closed in 10s ***")
+	require.Equal(t, 1, strings.Count(string(buf[:n]), "***")/2)
+}
+
+func TestShellStartupNothingEnabled(t *testing.T) {
+	startup := &ShellStartup{configDir: t.TempDir()}
+
+	shellEnv, err := startup.ShellEnv([]string{"FOO=bar"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"FOO=bar"}, shellEnv)
+}
+
+func TestShellStartupClose(t *testing.T) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux is not installed")
+	}
+
+	startup, err := NewShellStartup()
+	require.NoError(t, err)
+	require.NoError(t, startup.EnableTmux("cirrus-task-test"))
+	_, err = startup.ShellEnv(nil)
+	require.NoError(t, err)
+	require.NoError(t, startup.Close())
+	require.NoDirExists(t, startup.configDir)
+}
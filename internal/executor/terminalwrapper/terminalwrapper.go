@@ -9,25 +9,31 @@ import (
 	"github.com/cirruslabs/cirrus-ci-agent/api"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
 	"github.com/cirruslabs/terminal/pkg/host"
-	"github.com/cirruslabs/terminal/pkg/host/session"
 	"math"
 	"time"
 )
 
+// MaxTerminalHosts limits the number of independent terminal hosts per task.
+const MaxTerminalHosts = 4
+
 type Wrapper struct {
 	ctx                context.Context
 	taskIdentification *api.TaskIdentification
 	operationChan      chan Operation
-	terminalHost       *host.TerminalHost
+	terminalHosts      []*host.TerminalHost
 	expirationWindow   time.Duration
+	notify             func(message string)
 }
 
+// New launches numHosts independent terminal hosts, each registering with its own
+// locator, so that multiple engineers can debug the same task simultaneously.
 func New(
 	ctx context.Context,
 	taskIdentification *api.TaskIdentification,
 	serverAddress string,
 	expirationWindow time.Duration,
 	shellEnv []string,
+	numHosts int,
 ) *Wrapper {
 	wrapper := &Wrapper{
 		ctx:                ctx,
@@ -36,18 +42,60 @@ func New(
 		expirationWindow:   expirationWindow,
 	}
 
+	if numHosts < 1 {
+		numHosts = 1
+	}
+	if numHosts > MaxTerminalHosts {
+		numHosts = MaxTerminalHosts
+	}
+
+	for i := 0; i < numHosts; i++ {
+		terminalHost, err := wrapper.newTerminalHost(serverAddress, shellEnv)
+		if err != nil {
+			wrapper.operationChan <- &LogOperation{Message: fmt.Sprintf("Failed to initialize a terminal host: %v", err)}
+			continue
+		}
+
+		wrapper.terminalHosts = append(wrapper.terminalHosts, terminalHost)
+	}
+
+	for i, terminalHost := range wrapper.terminalHosts {
+		terminalHost := terminalHost
+
+		go func() {
+			_ = retry.Do(
+				func() error {
+					subCtx, cancel := context.WithCancel(ctx)
+					defer cancel()
+
+					return terminalHost.Run(subCtx)
+				},
+				retry.OnRetry(func(n uint, err error) {
+					wrapper.operationChan <- &LogOperation{Message: fmt.Sprintf("Terminal host failed: %v", err)}
+				}),
+				retry.Context(ctx),
+				retry.Delay(5*time.Second), retry.MaxDelay(5*time.Second),
+				retry.Attempts(math.MaxUint32), retry.LastErrorOnly(true),
+			)
+		}()
+
+		go wrapper.watchSessions(i+1, terminalHost)
+	}
+
+	return wrapper
+}
+
+func (wrapper *Wrapper) newTerminalHost(serverAddress string, shellEnv []string) (*host.TerminalHost, error) {
 	// A trusted secret that grants ability to spawn shells on the terminal host we start below
 	trustedSecret, err := generateTrustedSecret()
 	if err != nil {
-		wrapper.operationChan <- &LogOperation{Message: fmt.Sprintf("Unable to generate a trusted secret needed to"+
-			" initialize a terminal host: %v", err)}
-		return wrapper
+		return nil, fmt.Errorf("unable to generate a trusted secret: %w", err)
 	}
 
 	// A callback that will be called once the terminal host connects and registers on the terminal server
 	locatorCallback := func(locator string) error {
-		_, err := client.CirrusClient.ReportTerminalAttached(ctx, &api.ReportTerminalAttachedRequest{
-			TaskIdentification: taskIdentification,
+		_, err := client.CirrusClient.ReportTerminalAttached(wrapper.ctx, &api.ReportTerminalAttachedRequest{
+			TaskIdentification: wrapper.taskIdentification,
 			Locator:            locator,
 			TrustedSecret:      trustedSecret,
 		})
@@ -80,36 +128,56 @@ func New(
 		terminalHostOpts = append(terminalHostOpts, host.WithServerAddress(serverAddress))
 	}
 
-	wrapper.terminalHost, err = host.New(terminalHostOpts...)
-	if err != nil {
-		wrapper.operationChan <- &LogOperation{Message: fmt.Sprintf("Failed to initialize a terminal host: %v", err)}
-		return wrapper
-	}
+	return host.New(terminalHostOpts...)
+}
 
-	go func() {
-		_ = retry.Do(
-			func() error {
-				subCtx, cancel := context.WithCancel(ctx)
-				defer cancel()
+// watchSessions logs the sessions being opened and closed on the terminal host,
+// so that it's clear who was debugging the task and when.
+func (wrapper *Wrapper) watchSessions(index int, terminalHost *host.TerminalHost) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
 
-				return wrapper.terminalHost.Run(subCtx)
-			},
-			retry.OnRetry(func(n uint, err error) {
-				wrapper.operationChan <- &LogOperation{Message: fmt.Sprintf("Terminal host failed: %v", err)}
-			}),
-			retry.Context(ctx),
-			retry.Delay(5*time.Second), retry.MaxDelay(5*time.Second),
-			retry.Attempts(math.MaxUint32), retry.LastErrorOnly(true),
-		)
-	}()
+	var numSessions int
 
-	return wrapper
+	for {
+		select {
+		case <-ticker.C:
+			newNumSessions := terminalHost.NumSessions()
+
+			if message := sessionsChangedMessage(index, numSessions, newNumSessions); message != "" {
+				wrapper.operationChan <- &LogOperation{Message: message}
+			}
+
+			numSessions = newNumSessions
+		case <-wrapper.ctx.Done():
+			return
+		}
+	}
+}
+
+func sessionsChangedMessage(index int, numSessions int, newNumSessions int) string {
+	switch {
+	case newNumSessions > numSessions:
+		return fmt.Sprintf("Terminal #%d: %d session(s) opened, %d session(s) are now open.",
+			index, newNumSessions-numSessions, newNumSessions)
+	case newNumSessions < numSessions:
+		return fmt.Sprintf("Terminal #%d: %d session(s) closed, %d session(s) are now open.",
+			index, numSessions-newNumSessions, newNumSessions)
+	default:
+		return ""
+	}
+}
+
+// SetNotifier configures the function used to deliver the messages
+// to the terminal sessions, must be called before Wait().
+func (wrapper *Wrapper) SetNotifier(notify func(message string)) {
+	wrapper.notify = notify
 }
 
 func (wrapper *Wrapper) Wait() chan Operation {
 	go func() {
-		// Might happen when we fail to initialize the terminal host
-		if wrapper.terminalHost == nil {
+		// Might happen when we fail to initialize the terminal hosts
+		if len(wrapper.terminalHosts) == 0 {
 			wrapper.operationChan <- &ExitOperation{Success: false}
 
 			return
@@ -120,47 +188,49 @@ func (wrapper *Wrapper) Wait() chan Operation {
 			return
 		}
 
-		// Wait for the terminal to be inactive for a period of wrapper.expirationWindow.Seconds() seconds
-		for {
-			lastActivityBeforeWait := max(wrapper.terminalHost.LastRegistration(),
-				wrapper.terminalHost.LastActivity())
-
-			// Notify the user that the countdown has started
-			message := fmt.Sprintf("Waiting for the terminal session to be inactive for at least %.1f seconds...",
-				wrapper.expirationWindow.Seconds())
-			wrapper.operationChan <- &LogOperation{Message: message}
-
-			// Notify the server that the countdown has started
-			_, err := client.CirrusClient.ReportTerminalLifecycle(wrapper.ctx, &api.ReportTerminalLifecycleRequest{
-				TaskIdentification: wrapper.taskIdentification,
-				Lifecycle: &api.ReportTerminalLifecycleRequest_Expiring_{
-					Expiring: &api.ReportTerminalLifecycleRequest_Expiring{},
-				},
-			})
-			if err != nil {
-				wrapper.operationChan <- &LogOperation{
-					Message: fmt.Sprintf("Failed to send lifecycle notification (expiring): %v", err),
-				}
+		// Notify the user that the countdown has started
+		message := fmt.Sprintf("Waiting for the terminal session to be inactive for at least %.1f seconds...",
+			wrapper.expirationWindow.Seconds())
+		wrapper.operationChan <- &LogOperation{Message: message}
+
+		// Notify the server that the countdown has started
+		_, err := client.CirrusClient.ReportTerminalLifecycle(wrapper.ctx, &api.ReportTerminalLifecycleRequest{
+			TaskIdentification: wrapper.taskIdentification,
+			Lifecycle: &api.ReportTerminalLifecycleRequest_Expiring_{
+				Expiring: &api.ReportTerminalLifecycleRequest_Expiring{},
+			},
+		})
+		if err != nil {
+			wrapper.operationChan <- &LogOperation{
+				Message: fmt.Sprintf("Failed to send lifecycle notification (expiring): %v", err),
 			}
+		}
 
+		// Wait for the terminal to be inactive for a period of wrapper.expirationWindow,
+		// which is renewed on any activity, warning the users before closing it
+		countdownStart := time.Now()
+		countdown := newCountdown(wrapper.expirationWindow)
+
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
 			select {
-			case <-time.After(wrapper.expirationWindow):
-				numActiveSessions := wrapper.terminalHost.NumSessionsFunc(func(session *session.Session) bool {
-					return session.LastActivity().After(lastActivityBeforeWait)
-				})
+			case now := <-ticker.C:
+				message, expired := countdown.Update(max(countdownStart, wrapper.lastActivity()), now)
+				if message != "" {
+					wrapper.operationChan <- &LogOperation{Message: message}
+
+					if wrapper.notify != nil {
+						wrapper.notify(message)
+					}
+				}
 
-				if numActiveSessions == 0 {
+				if expired {
 					wrapper.operationChan <- &ExitOperation{Success: true}
 
 					return
 				}
-
-				message := fmt.Sprintf("Waited %.1f seconds, but there are still %d terminal sessions open "+
-					"and %d of them are active.", wrapper.expirationWindow.Seconds(), wrapper.terminalHost.NumSessions(),
-					numActiveSessions)
-				wrapper.operationChan <- &LogOperation{Message: message}
-
-				continue
 			case <-wrapper.ctx.Done():
 				wrapper.operationChan <- &ExitOperation{Success: false}
 
@@ -183,8 +253,7 @@ func (wrapper *Wrapper) waitForConnection() bool {
 	for {
 		select {
 		case <-ticker.C:
-			defaultTime := time.Time{}
-			if wrapper.terminalHost.LastConnection() != defaultTime {
+			if wrapper.Connected() {
 				return true
 			}
 		case <-wrapper.ctx.Done():
@@ -194,6 +263,27 @@ func (wrapper *Wrapper) waitForConnection() bool {
 	}
 }
 
+// Connected returns true once any of the terminal hosts has established a connection to the terminal server.
+func (wrapper *Wrapper) Connected() bool {
+	for _, terminalHost := range wrapper.terminalHosts {
+		if !terminalHost.LastConnection().IsZero() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (wrapper *Wrapper) lastActivity() time.Time {
+	var times []time.Time
+
+	for _, terminalHost := range wrapper.terminalHosts {
+		times = append(times, terminalHost.LastRegistration(), terminalHost.LastActivity())
+	}
+
+	return max(times...)
+}
+
 func generateTrustedSecret() (string, error) {
 	buf := make([]byte, 32)
 
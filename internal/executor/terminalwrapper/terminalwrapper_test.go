@@ -15,3 +15,9 @@ func TestTrustedSecretIsLargeEnough(t *testing.T) {
 
 	assert.Len(t, trustedSecret, trustedSecretHexadecimalStringLength)
 }
+
+func TestSessionsChangedMessage(t *testing.T) {
+	assert.Equal(t, "", sessionsChangedMessage(1, 2, 2))
+	assert.Equal(t, "Terminal #1: 2 session(s) opened, 2 session(s) are now open.", sessionsChangedMessage(1, 0, 2))
+	assert.Equal(t, "Terminal #2: 1 session(s) closed, 0 session(s) are now open.", sessionsChangedMessage(2, 1, 0))
+}
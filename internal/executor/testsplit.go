@@ -0,0 +1,164 @@
+package executor
+
+import (
+	"fmt"
+	"github.com/bmatcuk/doublestar"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/testsplit"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvCirrusTestSplitFiles contains the glob patterns (relative to the CIRRUS_WORKING_DIR)
+	// of the test files, packages or directories to split between the parallel tasks.
+	EnvCirrusTestSplitFiles = "CIRRUS_TEST_SPLIT_FILES"
+
+	// EnvCirrusTestSplitTimings contains the glob patterns of the JUnit XML reports
+	// from the previous runs (e.g. restored from the cache) to balance the split with.
+	EnvCirrusTestSplitTimings = "CIRRUS_TEST_SPLIT_TIMINGS"
+
+	// EnvCirrusTestSplit is set to the space-separated tests assigned to the current task
+	// and EnvCirrusTestSplitFile to the path of the file that lists them one per line.
+	EnvCirrusTestSplit     = "CIRRUS_TEST_SPLIT"
+	EnvCirrusTestSplitFile = "CIRRUS_TEST_SPLIT_FILE"
+)
+
+// splitTests computes the current task's share of the tests once,
+// before the first script, when the test files are already in place.
+func (executor *Executor) splitTests(logs io.Writer) error {
+	if executor.testSplitDone {
+		return nil
+	}
+
+	rawPatterns, ok := executor.env.Lookup(EnvCirrusTestSplitFiles)
+	if !ok {
+		return nil
+	}
+	executor.testSplitDone = true
+
+	workingDir := executor.env.Get("CIRRUS_WORKING_DIR")
+
+	items, err := globRelative(workingDir, splitPatterns(rawPatterns))
+	if err != nil {
+		return err
+	}
+
+	timings, err := testsplit.LoadJUnit(absolutePatterns(workingDir, splitPatterns(executor.env.Get(EnvCirrusTestSplitTimings))))
+	if err != nil {
+		return fmt.Errorf("failed to load the timing data: %w", err)
+	}
+
+	index, total, err := executor.nodeIndexAndTotal()
+	if err != nil {
+		return err
+	}
+
+	split := testsplit.Split(items, timings, index, total)
+
+	file, err := os.CreateTemp("", "cirrus-test-split-")
+	if err != nil {
+		return err
+	}
+	if _, err := file.WriteString(strings.Join(split, "\n")); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	executor.env.Set(EnvCirrusTestSplit, strings.Join(split, " "))
+	executor.env.Set(EnvCirrusTestSplitFile, file.Name())
+
+	_, _ = fmt.Fprintf(logs, "Test split: node %d of %d runs %d of %d tests (estimated %v of %v, "+
+		"timing data for %d entries)\n", index+1, total, len(split), len(items),
+		sumDurations(testsplit.Estimate(split, timings)), sumDurations(testsplit.Estimate(items, timings)),
+		len(timings))
+
+	return nil
+}
+
+func (executor *Executor) nodeIndexAndTotal() (int, int, error) {
+	rawTotal, ok := executor.env.Lookup("CIRRUS_NODE_TOTAL")
+	if !ok {
+		return 0, 1, nil
+	}
+
+	total, err := strconv.Atoi(rawTotal)
+	if err != nil || total < 1 {
+		return 0, 0, fmt.Errorf("invalid CIRRUS_NODE_TOTAL %q", rawTotal)
+	}
+
+	index, err := strconv.Atoi(executor.env.Get("CIRRUS_NODE_INDEX"))
+	if err != nil || index < 0 || index >= total {
+		return 0, 0, fmt.Errorf("invalid CIRRUS_NODE_INDEX %q for CIRRUS_NODE_TOTAL %d",
+			executor.env.Get("CIRRUS_NODE_INDEX"), total)
+	}
+
+	return index, total, nil
+}
+
+func splitPatterns(rawPatterns string) []string {
+	return strings.FieldsFunc(rawPatterns, func(r rune) bool {
+		return r == ',' || r == '\n' || r == ' '
+	})
+}
+
+func absolutePatterns(workingDir string, patterns []string) []string {
+	var result []string
+
+	for _, pattern := range patterns {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(workingDir, pattern)
+		}
+
+		result = append(result, pattern)
+	}
+
+	return result
+}
+
+// globRelative returns the sorted and de-duplicated paths matching the patterns, relative to the workingDir.
+func globRelative(workingDir string, patterns []string) ([]string, error) {
+	seen := map[string]struct{}{}
+	var result []string
+
+	for _, pattern := range absolutePatterns(workingDir, patterns) {
+		paths, err := doublestar.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			if relPath, err := filepath.Rel(workingDir, path); err == nil {
+				path = filepath.ToSlash(relPath)
+			}
+
+			if _, ok := seen[path]; ok {
+				continue
+			}
+			seen[path] = struct{}{}
+
+			result = append(result, path)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+func sumDurations(durations []time.Duration) time.Duration {
+	var result time.Duration
+
+	for _, duration := range durations {
+		result += duration
+	}
+
+	return result.Round(time.Second)
+}
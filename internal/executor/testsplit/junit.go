@@ -0,0 +1,140 @@
+package testsplit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/bmatcuk/doublestar"
+	"io"
+	"os"
+	"strconv"
+	"time"
+)
+
+type junitTestSuites struct {
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name   string           `xml:"name,attr"`
+	File   string           `xml:"file,attr"`
+	Time   string           `xml:"time,attr"`
+	Cases  []junitTestCase  `xml:"testcase"`
+	Suites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestCase struct {
+	Name      string `xml:"name,attr"`
+	ClassName string `xml:"classname,attr"`
+	File      string `xml:"file,attr"`
+	Time      string `xml:"time,attr"`
+}
+
+// LoadJUnit collects the timings from the JUnit XML reports matching the glob patterns.
+func LoadJUnit(patterns []string) (Timings, error) {
+	result := Timings{}
+
+	for _, pattern := range patterns {
+		paths, err := doublestar.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			file, err := os.Open(path)
+			if err != nil {
+				return nil, err
+			}
+
+			timings, err := ParseJUnit(file)
+			_ = file.Close()
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+			}
+
+			result.merge(timings)
+		}
+	}
+
+	return result, nil
+}
+
+// ParseJUnit parses the JUnit XML report, keying the timings by the test case's file
+// (when reported), class name or the test suite's file or name, in that order.
+func ParseJUnit(r io.Reader) (Timings, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// The root element is either <testsuites> or a single <testsuite>
+	var suites junitTestSuites
+	if err := xml.Unmarshal(content, &suites); err != nil {
+		return nil, err
+	}
+
+	if len(suites.Suites) == 0 {
+		var suite junitTestSuite
+		if err := xml.Unmarshal(content, &suite); err != nil {
+			return nil, err
+		}
+		suites.Suites = []junitTestSuite{suite}
+	}
+
+	result := Timings{}
+
+	for _, suite := range suites.Suites {
+		result.addSuite(suite)
+	}
+
+	return result, nil
+}
+
+func (timings Timings) addSuite(suite junitTestSuite) {
+	suiteKey := suite.File
+	if suiteKey == "" {
+		suiteKey = suite.Name
+	}
+
+	// Suites without the test cases only carry their own duration
+	if len(suite.Cases) == 0 && len(suite.Suites) == 0 {
+		if suiteKey != "" {
+			timings[suiteKey] += parseSeconds(suite.Time)
+		}
+
+		return
+	}
+
+	for _, testCase := range suite.Cases {
+		key := testCase.File
+		if key == "" {
+			key = testCase.ClassName
+		}
+		if key == "" {
+			key = suiteKey
+		}
+		if key == "" {
+			continue
+		}
+
+		timings[key] += parseSeconds(testCase.Time)
+	}
+
+	for _, nested := range suite.Suites {
+		timings.addSuite(nested)
+	}
+}
+
+func (timings Timings) merge(other Timings) {
+	for key, duration := range other {
+		timings[key] += duration
+	}
+}
+
+func parseSeconds(text string) time.Duration {
+	seconds, err := strconv.ParseFloat(text, 64)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
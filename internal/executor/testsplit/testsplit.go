@@ -0,0 +1,150 @@
+// Package testsplit deterministically partitions the tests between the parallel tasks
+// so that each of them takes roughly the same time, based on the historical timing data.
+package testsplit
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultDuration is assumed for all tests when there's no timing data at all
+const defaultDuration = time.Second
+
+// Timings maps the test (file, package, class, etc.) to its historical duration.
+type Timings map[string]time.Duration
+
+// Split returns the items that belong to the node with the specified index (zero-based)
+// out of total, preserving their original order.
+//
+// The items are distributed greedily, longest first, to the least loaded node, with
+// the items that have no timing data assumed to take the average time of the rest.
+func Split(items []string, timings Timings, index int, total int) []string {
+	if total <= 1 {
+		return items
+	}
+
+	durations := Estimate(items, timings)
+
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		left, right := order[i], order[j]
+
+		if durations[left] != durations[right] {
+			return durations[left] > durations[right]
+		}
+
+		return items[left] < items[right]
+	})
+
+	loads := make([]time.Duration, total)
+	assignments := make([]int, len(items))
+
+	for _, item := range order {
+		node := 0
+
+		for candidate := 1; candidate < total; candidate++ {
+			if loads[candidate] < loads[node] {
+				node = candidate
+			}
+		}
+
+		loads[node] += durations[item]
+		assignments[item] = node
+	}
+
+	var result []string
+
+	for i, item := range items {
+		if assignments[i] == index {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// Estimate returns the expected durations of the items.
+func Estimate(items []string, timings Timings) []time.Duration {
+	result := make([]time.Duration, len(items))
+
+	var known []time.Duration
+	var knownTotal time.Duration
+
+	for i, item := range items {
+		duration, ok := timings.lookup(item)
+		if !ok {
+			result[i] = -1
+			continue
+		}
+
+		result[i] = duration
+		known = append(known, duration)
+		knownTotal += duration
+	}
+
+	fallback := defaultDuration
+	if len(known) != 0 {
+		fallback = knownTotal / time.Duration(len(known))
+	}
+
+	for i := range result {
+		if result[i] < 0 {
+			result[i] = fallback
+		}
+	}
+
+	return result
+}
+
+// lookup sums the durations of all timing entries that refer to the item: the same path
+// (ignoring the extension and separators), anything nested in it (e.g. the test classes
+// inside of a file) and the entries that are a suffix of it or vice versa (e.g. the
+// fully-qualified package name vs. the package directory).
+func (timings Timings) lookup(item string) (time.Duration, bool) {
+	normalizedItem := normalize(item)
+
+	var result time.Duration
+	var found bool
+
+	for key, duration := range timings {
+		normalizedKey := normalize(key)
+
+		if normalizedKey == normalizedItem ||
+			strings.HasPrefix(normalizedKey, normalizedItem+"/") ||
+			strings.HasSuffix(normalizedKey, "/"+normalizedItem) ||
+			strings.HasSuffix(normalizedItem, "/"+normalizedKey) {
+			result += duration
+			found = true
+		}
+	}
+
+	return result, found
+}
+
+func normalize(name string) string {
+	name = strings.TrimPrefix(strings.ReplaceAll(name, "\\", "/"), "./")
+	name = strings.TrimSuffix(name, "/...")
+
+	// Strip the extension, unless it's a part of a directory name
+	if idx := strings.LastIndexByte(name, '.'); idx > strings.LastIndexByte(name, '/') {
+		if isFileExtension(name[idx+1:]) {
+			name = name[:idx]
+		}
+	}
+
+	return strings.Trim(strings.ReplaceAll(name, ".", "/"), "/")
+}
+
+func isFileExtension(ext string) bool {
+	switch strings.ToLower(ext) {
+	case "go", "py", "js", "jsx", "ts", "tsx", "mjs", "cjs", "rb", "java", "kt", "scala", "cs", "php", "rs", "swift":
+		return true
+	default:
+		return false
+	}
+}
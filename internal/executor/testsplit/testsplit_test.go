@@ -0,0 +1,113 @@
+package testsplit_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/testsplit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSplitBalanced(t *testing.T) {
+	items := []string{"a_test.go", "b_test.go", "c_test.go", "d_test.go", "e_test.go"}
+	timings := testsplit.Timings{
+		"a_test.go": 10 * time.Second,
+		"b_test.go": 7 * time.Second,
+		"c_test.go": 5 * time.Second,
+		"d_test.go": 4 * time.Second,
+		"e_test.go": 2 * time.Second,
+	}
+
+	first := testsplit.Split(items, timings, 0, 2)
+	second := testsplit.Split(items, timings, 1, 2)
+
+	// 10+4 vs. 7+5+2
+	assert.Equal(t, []string{"a_test.go", "d_test.go"}, first)
+	assert.Equal(t, []string{"b_test.go", "c_test.go", "e_test.go"}, second)
+}
+
+func TestSplitCoversEverythingExactlyOnce(t *testing.T) {
+	var items []string
+	for _, name := range strings.Split("abcdefghijklmnopqrstuvwxyz", "") {
+		items = append(items, "tests/test_"+name+".py")
+	}
+
+	seen := map[string]int{}
+
+	for index := 0; index < 4; index++ {
+		split := testsplit.Split(items, nil, index, 4)
+		assert.NotEmpty(t, split)
+
+		// The split is deterministic
+		assert.Equal(t, split, testsplit.Split(items, nil, index, 4))
+
+		for _, item := range split {
+			seen[item]++
+		}
+	}
+
+	assert.Len(t, seen, len(items))
+	for item, count := range seen {
+		assert.Equal(t, 1, count, item)
+	}
+}
+
+func TestSplitSingleNode(t *testing.T) {
+	items := []string{"a", "b"}
+	assert.Equal(t, items, testsplit.Split(items, nil, 0, 1))
+}
+
+func TestEstimateMatching(t *testing.T) {
+	timings := testsplit.Timings{
+		// pytest
+		"tests.test_api.TestUsers":    3 * time.Second,
+		"tests.test_api.TestProjects": 2 * time.Second,
+		// go-junit-report
+		"github.com/cirruslabs/cirrus-ci-agent/internal/network": 4 * time.Second,
+		// Java
+		"com.example.FooTest": 6 * time.Second,
+	}
+
+	assert.Equal(t, []time.Duration{
+		5 * time.Second,
+		4 * time.Second,
+		6 * time.Second,
+		// Unknown, so the average of the above
+		5 * time.Second,
+	}, testsplit.Estimate([]string{
+		"tests/test_api.py",
+		"./internal/network/...",
+		"src/test/java/com/example/FooTest.java",
+		"tests/test_unknown.py",
+	}, timings))
+}
+
+func TestParseJUnit(t *testing.T) {
+	timings, err := testsplit.ParseJUnit(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="pytest" time="3.5">
+    <testcase classname="tests.test_api" name="test_a" time="1.25"/>
+    <testcase classname="tests.test_api" name="test_b" time="2.25"/>
+  </testsuite>
+  <testsuite name="src/app.test.js" time="4">
+    <testcase classname="app renders" name="renders" file="src/app.test.js" time="4"/>
+  </testsuite>
+  <testsuite name="github.com/example/pkg" time="2.5"/>
+</testsuites>
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, testsplit.Timings{
+		"tests.test_api":         3500 * time.Millisecond,
+		"src/app.test.js":        4 * time.Second,
+		"github.com/example/pkg": 2500 * time.Millisecond,
+	}, timings)
+
+	// Single <testsuite> root
+	timings, err = testsplit.ParseJUnit(strings.NewReader(`<testsuite name="suite">
+  <testcase classname="FooTest" name="test" time="1"/>
+</testsuite>`))
+	require.NoError(t, err)
+	assert.Equal(t, testsplit.Timings{"FooTest": time.Second}, timings)
+}
@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"bytes"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSplitTests(t *testing.T) {
+	workingDir := t.TempDir()
+
+	require.NoError(t, os.Mkdir(filepath.Join(workingDir, "tests"), 0700))
+
+	for _, name := range []string{"a_test.py", "b_test.py", "c_test.py", "helpers.py"} {
+		require.NoError(t, os.WriteFile(filepath.Join(workingDir, "tests", name), nil, 0600))
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "junit.xml"), []byte(`<testsuite name="pytest">
+  <testcase classname="tests.a_test" name="test" time="10"/>
+  <testcase classname="tests.b_test" name="test" time="1"/>
+  <testcase classname="tests.c_test" name="test" time="1"/>
+</testsuite>`), 0600))
+
+	var splits []string
+
+	for _, index := range []string{"0", "1"} {
+		executor := &Executor{env: environment.New(map[string]string{
+			"CIRRUS_WORKING_DIR":      workingDir,
+			"CIRRUS_NODE_INDEX":       index,
+			"CIRRUS_NODE_TOTAL":       "2",
+			EnvCirrusTestSplitFiles:   "tests/*_test.py",
+			EnvCirrusTestSplitTimings: "junit.xml",
+		})}
+
+		var logs bytes.Buffer
+		require.NoError(t, executor.splitTests(&logs))
+		assert.Contains(t, logs.String(), "Test split: node ")
+
+		listing, err := os.ReadFile(executor.env.Get(EnvCirrusTestSplitFile))
+		require.NoError(t, err)
+		assert.Equal(t, executor.env.Get(EnvCirrusTestSplit), strings.ReplaceAll(string(listing), "\n", " "))
+
+		splits = append(splits, executor.env.Get(EnvCirrusTestSplit))
+	}
+
+	assert.Equal(t, []string{"tests/a_test.py", "tests/b_test.py tests/c_test.py"}, splits)
+}
+
+func TestSplitTestsInvalidNodeIndex(t *testing.T) {
+	executor := &Executor{env: environment.New(map[string]string{
+		"CIRRUS_WORKING_DIR":    t.TempDir(),
+		"CIRRUS_NODE_INDEX":     "2",
+		"CIRRUS_NODE_TOTAL":     "2",
+		EnvCirrusTestSplitFiles: "*_test.go",
+	})}
+
+	require.Error(t, executor.splitTests(&bytes.Buffer{}))
+}
@@ -0,0 +1,149 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/toolinstaller"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+)
+
+const (
+	// PluginInstallTools is the built-in plugin that installs the tools named in its properties
+	// (e.g. "go: 1.20.1", "node: 18.14.0", "python: 3.11.2+20230116" or "terraform: 1.4.0")
+	// from their official release archives and prepends them to the PATH for the following steps.
+	//
+	// The downloaded archives are stored in the task's cache, and the installed tools
+	// are kept in the CIRRUS_TOOLCHAINS_DIR to be reused by the following tasks on the persistent workers.
+	PluginInstallTools = "install_tools"
+
+	// EnvCirrusToolchainsDir is where the PluginInstallTools installs the tools,
+	// defaults to the "cirrus-ci/toolchains" in the user's cache directory.
+	EnvCirrusToolchainsDir = "CIRRUS_TOOLCHAINS_DIR"
+)
+
+func (executor *Executor) installTools(
+	ctx context.Context,
+	logUploader *LogUploader,
+	command *api.Command,
+) bool {
+	var tools []string
+	for key := range command.Properties {
+		if key != PropertyPlugin {
+			tools = append(tools, key)
+		}
+	}
+	sort.Strings(tools)
+
+	if len(tools) == 0 {
+		_, _ = fmt.Fprintln(logUploader, "No tools to install, specify them as the properties, e.g. \"go: 1.20.1\"")
+
+		return false
+	}
+
+	toolchainsDir, err := executor.toolchainsDir()
+	if err != nil {
+		_, _ = fmt.Fprintf(logUploader, "Failed to determine the toolchains directory: %v\n", err)
+
+		return false
+	}
+
+	for _, tool := range tools {
+		version := executor.env.ExpandText(command.Properties[tool])
+
+		binDir, err := executor.installTool(ctx, logUploader, toolchainsDir, tool, version)
+		if err != nil {
+			_, _ = fmt.Fprintf(logUploader, "Failed to install %s %s: %v\n", tool, version, err)
+
+			return false
+		}
+
+		path := executor.env.Get("PATH")
+		if path == "" {
+			path = os.Getenv("PATH")
+		}
+		executor.env.Set("PATH", binDir+string(os.PathListSeparator)+path)
+	}
+
+	return true
+}
+
+func (executor *Executor) installTool(
+	ctx context.Context,
+	logUploader *LogUploader,
+	toolchainsDir string,
+	tool string,
+	version string,
+) (string, error) {
+	release, err := toolinstaller.Resolve(tool, version, runtime.GOOS, runtime.GOARCH)
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(toolchainsDir, tool, version, runtime.GOOS+"-"+runtime.GOARCH)
+	binDir := filepath.Join(dir, release.BinDir)
+
+	if toolinstaller.Installed(dir) {
+		_, _ = fmt.Fprintf(logUploader, "Using the previously installed %s %s\n", tool, version)
+
+		return binDir, nil
+	}
+
+	archive, err := os.CreateTemp("", "cirrus-toolchain-")
+	if err != nil {
+		return "", err
+	}
+	_ = archive.Close()
+	defer os.Remove(archive.Name())
+
+	cacheKey := fmt.Sprintf("toolchain-%s-%s-%s-%s", tool, version, runtime.GOOS, runtime.GOARCH)
+
+	var cacheHit bool
+
+	if executor.httpCacheHost != "" {
+		cacheHit, err = executor.downloadCacheFile(ctx, cacheKey, archive.Name())
+		if err != nil {
+			_, _ = fmt.Fprintf(logUploader, "Failed to retrieve %s %s from the cache: %v\n", tool, version, err)
+		}
+	}
+
+	if cacheHit {
+		_, _ = fmt.Fprintf(logUploader, "Installing %s %s from the cache...\n", tool, version)
+	} else {
+		_, _ = fmt.Fprintf(logUploader, "Downloading %s %s from %s...\n", tool, version, release.URL)
+
+		if err := toolinstaller.Download(ctx, httpClient, release, archive.Name()); err != nil {
+			return "", err
+		}
+
+		if executor.httpCacheHost != "" {
+			if err := executor.uploadCacheFile(ctx, cacheKey, archive.Name()); err != nil {
+				_, _ = fmt.Fprintf(logUploader, "Failed to store %s %s in the cache: %v\n", tool, version, err)
+			}
+		}
+	}
+
+	if err := toolinstaller.Install(release, archive.Name(), dir); err != nil {
+		return "", err
+	}
+
+	_, _ = fmt.Fprintf(logUploader, "Installed %s %s into %s\n", tool, version, dir)
+
+	return binDir, nil
+}
+
+func (executor *Executor) toolchainsDir() (string, error) {
+	if dir := executor.env.Get(EnvCirrusToolchainsDir); dir != "" {
+		return dir, nil
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(cacheDir, "cirrus-ci", "toolchains"), nil
+}
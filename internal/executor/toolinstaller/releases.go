@@ -0,0 +1,149 @@
+package toolinstaller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Tools returns the names of the supported tools.
+func Tools() []string {
+	var result []string
+
+	for name := range resolvers {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// Resolve returns the release archive of the tool's version for the platform
+// (in the GOOS and GOARCH terms).
+func Resolve(tool string, version string, goos string, goarch string) (*Release, error) {
+	resolver, ok := resolvers[tool]
+	if !ok {
+		return nil, fmt.Errorf("%w %q, supported tools are: %s", ErrUnknownTool, tool, strings.Join(Tools(), ", "))
+	}
+
+	// The version becomes a part of the installation path
+	if version == "" || strings.ContainsAny(version, "/\\") || strings.Contains(version, "..") {
+		return nil, fmt.Errorf("invalid %s version %q", tool, version)
+	}
+
+	release, err := resolver(strings.TrimPrefix(version, "v"), goos, goarch)
+	if err != nil {
+		return nil, err
+	}
+
+	release.Tool = tool
+	release.Version = version
+
+	return release, nil
+}
+
+var resolvers = map[string]func(version string, goos string, goarch string) (*Release, error){
+	"go":        resolveGo,
+	"node":      resolveNode,
+	"python":    resolvePython,
+	"terraform": resolveTerraform,
+}
+
+func resolveGo(version string, goos string, goarch string) (*Release, error) {
+	format := FormatTarGz
+	if goos == "windows" {
+		format = FormatZip
+	}
+
+	url := fmt.Sprintf("https://go.dev/dl/go%s.%s-%s.%s", version, goos, goarch, format)
+
+	return &Release{
+		URL:         url,
+		Format:      format,
+		ChecksumURL: url + ".sha256",
+		StripPrefix: "go",
+		BinDir:      "bin",
+	}, nil
+}
+
+func resolveNode(version string, goos string, goarch string) (*Release, error) {
+	platform, ok := map[string]string{"linux": "linux", "darwin": "darwin", "windows": "win"}[goos]
+	if !ok {
+		return nil, fmt.Errorf("%w for node: %s", ErrUnsupportedPlatform, goos)
+	}
+
+	arch, ok := map[string]string{"amd64": "x64", "arm64": "arm64"}[goarch]
+	if !ok {
+		return nil, fmt.Errorf("%w for node: %s", ErrUnsupportedPlatform, goarch)
+	}
+
+	format, binDir := FormatTarGz, "bin"
+	if goos == "windows" {
+		format, binDir = FormatZip, "."
+	}
+
+	name := fmt.Sprintf("node-v%s-%s-%s", version, platform, arch)
+
+	return &Release{
+		URL:          fmt.Sprintf("https://nodejs.org/dist/v%s/%s.%s", version, name, format),
+		Format:       format,
+		ChecksumURL:  fmt.Sprintf("https://nodejs.org/dist/v%s/SHASUMS256.txt", version),
+		ChecksumName: name + "." + format,
+		StripPrefix:  name,
+		BinDir:       binDir,
+	}, nil
+}
+
+// resolvePython uses the relocatable builds from the python-build-standalone project since python.org
+// only ships the installers, so the version should include the project's release tag: "3.11.2+20230116".
+func resolvePython(version string, goos string, goarch string) (*Release, error) {
+	parts := strings.SplitN(version, "+", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("python version should include the python-build-standalone release tag "+
+			"(e.g. \"3.11.2+20230116\"), got %q", version)
+	}
+
+	arch, ok := map[string]string{"amd64": "x86_64", "arm64": "aarch64"}[goarch]
+	if !ok {
+		return nil, fmt.Errorf("%w for python: %s", ErrUnsupportedPlatform, goarch)
+	}
+
+	var triple string
+	binDir := "bin"
+
+	switch goos {
+	case "linux":
+		triple = arch + "-unknown-linux-gnu"
+	case "darwin":
+		triple = arch + "-apple-darwin"
+	case "windows":
+		triple = arch + "-pc-windows-msvc-shared"
+		binDir = "."
+	default:
+		return nil, fmt.Errorf("%w for python: %s", ErrUnsupportedPlatform, goos)
+	}
+
+	url := fmt.Sprintf("https://github.com/indygreg/python-build-standalone/releases/download/%s/"+
+		"cpython-%s+%s-%s-install_only.tar.gz", parts[1], parts[0], parts[1], triple)
+
+	return &Release{
+		URL:         url,
+		Format:      FormatTarGz,
+		ChecksumURL: url + ".sha256",
+		StripPrefix: "python",
+		BinDir:      binDir,
+	}, nil
+}
+
+func resolveTerraform(version string, goos string, goarch string) (*Release, error) {
+	name := fmt.Sprintf("terraform_%s_%s_%s.zip", version, goos, goarch)
+
+	return &Release{
+		URL:          fmt.Sprintf("https://releases.hashicorp.com/terraform/%s/%s", version, name),
+		Format:       FormatZip,
+		ChecksumURL:  fmt.Sprintf("https://releases.hashicorp.com/terraform/%s/terraform_%s_SHA256SUMS", version, version),
+		ChecksumName: name,
+		BinDir:       ".",
+	}, nil
+}
@@ -0,0 +1,324 @@
+// Package toolinstaller installs the requested versions of the popular tools
+// from their official release archives into a toolchain directory.
+package toolinstaller
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	FormatTarGz = "tar.gz"
+	FormatZip   = "zip"
+
+	// completeMarker is created in the installation directory once it's fully populated
+	completeMarker = ".cirrus-complete"
+)
+
+var (
+	ErrUnknownTool         = errors.New("unknown tool")
+	ErrUnsupportedPlatform = errors.New("unsupported platform")
+	ErrChecksumMismatch    = errors.New("checksum mismatch")
+)
+
+// Release is a tool's release archive for a specific platform.
+type Release struct {
+	Tool    string
+	Version string
+
+	URL    string
+	Format string
+
+	// ChecksumURL points either to a file with just the archive's SHA-256 or to a list
+	// of the checksums in the sha256sum format, in which case the ChecksumName is set
+	ChecksumURL  string
+	ChecksumName string
+
+	// StripPrefix is the archive's top-level directory
+	StripPrefix string
+
+	// BinDir is the directory with the executables relative to the installation directory
+	BinDir string
+}
+
+// Installed returns true if the release was fully installed into the dir.
+func Installed(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, completeMarker))
+
+	return err == nil
+}
+
+// Download downloads the release archive into the path and verifies its checksum.
+func Download(ctx context.Context, client *http.Client, release *Release, archivePath string) error {
+	checksums, err := get(ctx, client, release.ChecksumURL)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the checksum: %w", err)
+	}
+
+	body, err := stream(ctx, client, release.URL)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	file, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+
+	if _, err := io.Copy(io.MultiWriter(file, hash), body); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return Verify(hash.Sum(nil), checksums, release.ChecksumName)
+}
+
+// Verify checks the SHA-256 digest against the checksum file.
+func Verify(digest []byte, checksums []byte, name string) error {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if name != "" && (len(fields) < 2 || strings.TrimPrefix(fields[1], "*") != name) {
+			continue
+		}
+
+		expected, err := hex.DecodeString(fields[0])
+		if err != nil {
+			return fmt.Errorf("malformed checksum %q", fields[0])
+		}
+
+		if !bytes.Equal(expected, digest) {
+			return fmt.Errorf("%w: expected %x, got %x", ErrChecksumMismatch, expected, digest)
+		}
+
+		return nil
+	}
+
+	if name == "" {
+		return errors.New("empty checksum file")
+	}
+
+	return fmt.Errorf("no checksum for %s", name)
+}
+
+// Install extracts the archive into the dir, replacing whatever is already there.
+func Install(release *Release, archivePath string, dir string) error {
+	if err := os.MkdirAll(filepath.Dir(dir), 0755); err != nil {
+		return err
+	}
+
+	// Extract next to the dir first to never leave a partially installed tool in place
+	tmpDir, err := os.MkdirTemp(filepath.Dir(dir), filepath.Base(dir)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	switch release.Format {
+	case FormatTarGz:
+		err = extractTarGz(archivePath, release.StripPrefix, tmpDir)
+	case FormatZip:
+		err = extractZip(archivePath, release.StripPrefix, tmpDir)
+	default:
+		err = fmt.Errorf("unsupported archive format %q", release.Format)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to extract %s: %w", release.URL, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpDir, completeMarker), nil, 0600); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpDir, dir)
+}
+
+// entryPath returns the path of the archive entry inside of the dir, or false if the entry
+// should be skipped because it's outside of the stripped prefix or tries to escape the dir.
+func entryPath(name string, stripPrefix string, dir string) (string, bool) {
+	name = path.Clean(strings.TrimPrefix(strings.ReplaceAll(name, "\\", "/"), "./"))
+
+	if stripPrefix != "" {
+		if !strings.HasPrefix(name, stripPrefix+"/") {
+			return "", false
+		}
+
+		name = strings.TrimPrefix(name, stripPrefix+"/")
+	}
+
+	if name == "." || name == ".." || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+		return "", false
+	}
+
+	return filepath.Join(dir, filepath.FromSlash(name)), true
+}
+
+func extractTarGz(archivePath string, stripPrefix string, dir string) error {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, ok := entryPath(header.Name, stripPrefix, dir)
+		if !ok {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeFile(target, tarReader, os.FileMode(header.Mode).Perm()); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+
+			if err := os.Symlink(header.Linkname, target); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func extractZip(archivePath string, stripPrefix string, dir string) error {
+	zipReader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return err
+	}
+	defer zipReader.Close()
+
+	for _, zipFile := range zipReader.File {
+		target, ok := entryPath(zipFile.Name, stripPrefix, dir)
+		if !ok {
+			continue
+		}
+
+		if zipFile.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		reader, err := zipFile.Open()
+		if err != nil {
+			return err
+		}
+
+		mode := zipFile.Mode().Perm()
+		if mode == 0 {
+			mode = 0755
+		}
+
+		err = writeFile(target, reader, mode)
+		_ = reader.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeFile(target string, reader io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, reader); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func get(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	body, err := stream(ctx, client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(io.LimitReader(body, 1024*1024))
+}
+
+func stream(ctx context.Context, client *http.Client, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+
+		return nil, fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,134 @@
+package toolinstaller
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	release, err := Resolve("go", "1.20.1", "linux", "amd64")
+	require.NoError(t, err)
+	assert.Equal(t, "https://go.dev/dl/go1.20.1.linux-amd64.tar.gz", release.URL)
+	assert.Equal(t, "https://go.dev/dl/go1.20.1.linux-amd64.tar.gz.sha256", release.ChecksumURL)
+
+	release, err = Resolve("node", "v18.14.0", "windows", "amd64")
+	require.NoError(t, err)
+	assert.Equal(t, "https://nodejs.org/dist/v18.14.0/node-v18.14.0-win-x64.zip", release.URL)
+	assert.Equal(t, "node-v18.14.0-win-x64.zip", release.ChecksumName)
+
+	release, err = Resolve("python", "3.11.2+20230116", "darwin", "arm64")
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/indygreg/python-build-standalone/releases/download/20230116/"+
+		"cpython-3.11.2+20230116-aarch64-apple-darwin-install_only.tar.gz", release.URL)
+
+	_, err = Resolve("python", "3.11.2", "darwin", "arm64")
+	require.Error(t, err)
+
+	_, err = Resolve("go", "../../etc", "linux", "amd64")
+	require.Error(t, err)
+
+	_, err = Resolve("ruby", "3.2.1", "linux", "amd64")
+	require.ErrorIs(t, err, ErrUnknownTool)
+}
+
+func TestVerify(t *testing.T) {
+	digest := sha256.Sum256([]byte("archive"))
+
+	require.NoError(t, Verify(digest[:], []byte(fmt.Sprintf("%x\n", digest)), ""))
+	require.NoError(t, Verify(digest[:], []byte(fmt.Sprintf("%x  other.zip\n%x  tool.zip\n",
+		sha256.Sum256(nil), digest)), "tool.zip"))
+	require.ErrorIs(t, Verify(digest[:], []byte(fmt.Sprintf("%x  tool.zip\n", sha256.Sum256(nil))), "tool.zip"),
+		ErrChecksumMismatch)
+	require.Error(t, Verify(digest[:], []byte(fmt.Sprintf("%x  other.zip\n", digest)), "tool.zip"))
+}
+
+func TestDownloadAndInstallTarGz(t *testing.T) {
+	var archive bytes.Buffer
+
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, contents := range map[string]string{
+		"go/bin/go":   "binary",
+		"go/VERSION":  "go1.20.1",
+		"../escape":   "evil",
+		"outside-dir": "ignored",
+	} {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0755,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tarWriter.Write([]byte(contents))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/go.tar.gz":
+			_, _ = writer.Write(archive.Bytes())
+		case "/go.tar.gz.sha256":
+			_, _ = fmt.Fprintf(writer, "%x", sha256.Sum256(archive.Bytes()))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	release := &Release{
+		URL:         server.URL + "/go.tar.gz",
+		Format:      FormatTarGz,
+		ChecksumURL: server.URL + "/go.tar.gz.sha256",
+		StripPrefix: "go",
+		BinDir:      "bin",
+	}
+
+	archivePath := filepath.Join(t.TempDir(), "go.tar.gz")
+	require.NoError(t, Download(context.Background(), http.DefaultClient, release, archivePath))
+
+	dir := filepath.Join(t.TempDir(), "toolchains", "go", "1.20.1")
+	require.False(t, Installed(dir))
+	require.NoError(t, Install(release, archivePath, dir))
+	require.True(t, Installed(dir))
+
+	binary, err := os.ReadFile(filepath.Join(dir, "bin", "go"))
+	require.NoError(t, err)
+	assert.Equal(t, "binary", string(binary))
+	assert.NoFileExists(t, filepath.Join(dir, "outside-dir"))
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(dir), "escape"))
+}
+
+func TestInstallZip(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "terraform.zip")
+
+	file, err := os.Create(archivePath)
+	require.NoError(t, err)
+	zipWriter := zip.NewWriter(file)
+	writer, err := zipWriter.Create("terraform")
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("binary"))
+	require.NoError(t, err)
+	require.NoError(t, zipWriter.Close())
+	require.NoError(t, file.Close())
+
+	dir := filepath.Join(t.TempDir(), "terraform")
+	require.NoError(t, Install(&Release{Format: FormatZip, BinDir: "."}, archivePath, dir))
+
+	binary, err := os.ReadFile(filepath.Join(dir, "terraform"))
+	require.NoError(t, err)
+	assert.Equal(t, "binary", string(binary))
+}
@@ -0,0 +1,64 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/toolinventory"
+	"log"
+	"os"
+	"strings"
+)
+
+// EnvCirrusToolInventory set to "true" detects the versions of the common toolchains
+// present on the runner and reports them as an annotation.
+const EnvCirrusToolInventory = "CIRRUS_TOOL_INVENTORY"
+
+func (executor *Executor) reportToolInventory(ctx context.Context) {
+	if executor.env.Get(EnvCirrusToolInventory) != "true" {
+		return
+	}
+
+	env := append(os.Environ(), EnvMapAsSlice(executor.env.Items())...)
+
+	versions := toolinventory.Detect(ctx, toolinventory.DefaultTools, env)
+	if len(versions) == 0 {
+		return
+	}
+
+	log.Printf("Detected tool versions:\n%s", toolinventory.Format(versions))
+
+	rawDetails, err := json.Marshal(versions)
+	if err != nil {
+		log.Printf("Failed to serialize the tool versions: %v", err)
+
+		return
+	}
+
+	_, err = client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: executor.taskIdentification,
+		Annotations: []*api.Annotation{
+			{
+				Type:       api.Annotation_GENERIC,
+				Level:      api.Annotation_NOTICE,
+				Message:    formatToolInventoryMessage(versions),
+				RawDetails: string(rawDetails),
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("Failed to report the tool versions: %v", err)
+	}
+}
+
+func formatToolInventoryMessage(versions []toolinventory.Version) string {
+	var tools []string
+
+	for _, version := range versions {
+		tools = append(tools, fmt.Sprintf("%s %s", version.Name, version.Version))
+	}
+
+	return "Tool versions: " + strings.Join(tools, ", ")
+}
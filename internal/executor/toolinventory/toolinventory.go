@@ -0,0 +1,119 @@
+// Package toolinventory detects the versions of the common toolchains present on the runner,
+// which helps to explain the build breakages caused by the image updates.
+package toolinventory
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const detectTimeout = 10 * time.Second
+
+var versionRegex = regexp.MustCompile(`\d+\.\d+(\.\d+)?`)
+
+type Tool struct {
+	Name    string
+	Command []string
+}
+
+// DefaultTools are the toolchains detected by default.
+var DefaultTools = []Tool{
+	{Name: "go", Command: []string{"go", "version"}},
+	{Name: "node", Command: []string{"node", "--version"}},
+	{Name: "python", Command: []string{"python3", "--version"}},
+	{Name: "python2", Command: []string{"python2", "--version"}},
+	{Name: "docker", Command: []string{"docker", "--version"}},
+	{Name: "xcode", Command: []string{"xcodebuild", "-version"}},
+}
+
+type Version struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Path    string `json:"path"`
+}
+
+// Detect runs the tools found in the PATH of the env (formatted as KEY=VALUE)
+// and returns the versions of the tools that were successfully detected.
+func Detect(ctx context.Context, tools []Tool, env []string) []Version {
+	var result []Version
+
+	for _, tool := range tools {
+		version, err := detect(ctx, tool, env)
+		if err != nil {
+			continue
+		}
+
+		result = append(result, *version)
+	}
+
+	return result
+}
+
+func detect(ctx context.Context, tool Tool, env []string) (*Version, error) {
+	path, err := lookPath(tool.Command[0], env)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, detectTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, path, tool.Command[1:]...)
+	cmd.Env = env
+
+	// Python 2 prints its version to the standard error
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	version := versionRegex.FindString(string(output))
+	if version == "" {
+		return nil, fmt.Errorf("no version found in the %q output", strings.Join(tool.Command, " "))
+	}
+
+	return &Version{
+		Name:    tool.Name,
+		Version: version,
+		Path:    path,
+	}, nil
+}
+
+func lookPath(name string, env []string) (string, error) {
+	var pathList string
+
+	for _, item := range env {
+		splits := strings.SplitN(item, "=", 2)
+		if len(splits) == 2 && strings.EqualFold(splits[0], "PATH") {
+			pathList = splits[1]
+		}
+	}
+
+	for _, dir := range filepath.SplitList(pathList) {
+		if dir == "" {
+			continue
+		}
+
+		if path, err := exec.LookPath(filepath.Join(dir, name)); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", exec.ErrNotFound, name)
+}
+
+// Format formats the versions as a human-readable list.
+func Format(versions []Version) string {
+	var sb strings.Builder
+
+	for _, version := range versions {
+		fmt.Fprintf(&sb, "%s %s (%s)\n", version.Name, version.Version, version.Path)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,35 @@
+//go:build !windows
+// +build !windows
+
+package toolinventory_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/toolinventory"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	dir := t.TempDir()
+
+	writeTool := func(name string, script string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"+script+"\n"), 0700))
+	}
+
+	writeTool("go", "echo go version go1.20.1 linux/amd64")
+	writeTool("python2", "echo Python 2.7.18 >&2")
+	writeTool("docker", "exit 1")
+	writeTool("node", "echo no version here")
+
+	versions := toolinventory.Detect(context.Background(), toolinventory.DefaultTools, []string{"PATH=" + dir})
+	require.Equal(t, []toolinventory.Version{
+		{Name: "go", Version: "1.20.1", Path: filepath.Join(dir, "go")},
+		{Name: "python2", Version: "2.7.18", Path: filepath.Join(dir, "python2")},
+	}, versions)
+
+	require.Equal(t, "go 1.20.1 ("+filepath.Join(dir, "go")+")\npython2 2.7.18 ("+filepath.Join(dir, "python2")+")\n",
+		toolinventory.Format(versions))
+}
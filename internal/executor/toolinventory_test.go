@@ -0,0 +1,14 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/toolinventory"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFormatToolInventoryMessage(t *testing.T) {
+	require.Equal(t, "Tool versions: go 1.20.1, node 18.16.0", formatToolInventoryMessage([]toolinventory.Version{
+		{Name: "go", Version: "1.20.1", Path: "/usr/local/go/bin/go"},
+		{Name: "node", Version: "18.16.0", Path: "/usr/bin/node"},
+	}))
+}
@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/processtree"
+	"github.com/dustin/go-humanize"
+	"log"
+	"strings"
+	"time"
+)
+
+// EnvCirrusProcessTreeMetrics set to "false" disables the per-process resource
+// attribution of the script instructions.
+const EnvCirrusProcessTreeMetrics = "CIRRUS_PROCESS_TREE_METRICS"
+
+const (
+	processTreeSamplingInterval = 5 * time.Second
+	numTopConsumers             = 5
+)
+
+// sampleProcessTree samples the script's process tree until the context is cancelled,
+// the first sample is taken after the sampling interval so that the short scripts
+// (which are not interesting anyway) produce no report.
+func sampleProcessTree(ctx context.Context, sampler *processtree.Sampler) {
+	ticker := time.NewTicker(processTreeSamplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := sampler.Sample(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to sample the process tree: %v", err)
+
+				return
+			}
+		}
+	}
+}
+
+func formatTopConsumers(sampler *processtree.Sampler) string {
+	topByMemory := sampler.TopByMemory(numTopConsumers)
+	if len(topByMemory) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("\nTop memory consumers:")
+	for _, consumer := range topByMemory {
+		fmt.Fprintf(&sb, "\n  %s (PID %d): %s", consumer.Name, consumer.PID, humanize.Bytes(consumer.MaxRSS))
+	}
+
+	sb.WriteString("\nTop CPU consumers:")
+	for _, consumer := range sampler.TopByCPU(numTopConsumers) {
+		cpuTime := time.Duration(consumer.CPUSeconds * float64(time.Second)).Round(10 * time.Millisecond)
+		fmt.Fprintf(&sb, "\n  %s (PID %d): %s", consumer.Name, consumer.PID, cpuTime)
+	}
+
+	sb.WriteString("\n")
+
+	return sb.String()
+}
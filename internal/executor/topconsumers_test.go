@@ -0,0 +1,11 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/processtree"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFormatTopConsumersEmpty(t *testing.T) {
+	require.Empty(t, formatTopConsumers(processtree.New(1)))
+}
@@ -0,0 +1,159 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/otlptrace"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// stepSpan tracks the state needed to annotate the command's span once it finishes.
+type stepSpan struct {
+	span                *otlptrace.Span
+	command             *api.Command
+	bytesUploadedBefore uint64
+	cacheAttemptsBefore map[string]*api.CacheRetrievalAttempt
+}
+
+// initializeTracer enables the OpenTelemetry trace export when OTEL_EXPORTER_OTLP_ENDPOINT
+// (or the other standard OTEL_* variables) is set in the task's or agent's environment.
+func (executor *Executor) initializeTracer(ctx context.Context) {
+	tracer, err := otlptrace.NewFromEnvironment(func(key string) (string, bool) {
+		if value, ok := executor.env.Lookup(key); ok {
+			return value, true
+		}
+
+		return os.LookupEnv(key)
+	}, fmt.Sprintf("task %d", executor.taskIdentification.TaskId))
+	if err != nil {
+		message := fmt.Sprintf("Failed to initialize the OpenTelemetry trace export: %v", err)
+		log.Println(message)
+		executor.reportWarning(ctx, client.ProblemAgent, message)
+
+		return
+	}
+	if tracer == nil {
+		return
+	}
+
+	tracer.Root().SetAttribute("cirrus.task.id", executor.taskIdentification.TaskId)
+	for _, key := range []string{"CIRRUS_REPO_FULL_NAME", "CIRRUS_BRANCH", "CIRRUS_CHANGE_IN_REPO",
+		"CIRRUS_BUILD_ID", "CIRRUS_TASK_NAME"} {
+		if value, ok := executor.env.Lookup(key); ok {
+			tracer.Root().SetAttribute(strings.ToLower(strings.ReplaceAll(key, "_", ".")), value)
+		}
+	}
+
+	executor.tracer = tracer
+}
+
+func (executor *Executor) startStepSpan(command *api.Command) *stepSpan {
+	if executor.tracer == nil {
+		return nil
+	}
+
+	cacheAttemptsBefore := map[string]*api.CacheRetrievalAttempt{}
+	for key, attempt := range executor.cacheAttempts.ToProto() {
+		cacheAttemptsBefore[key] = attempt
+	}
+
+	span := executor.tracer.Start(command.Name)
+	span.SetAttribute("cirrus.command.name", command.Name)
+	span.SetAttribute("cirrus.command.instruction", instructionName(command))
+
+	return &stepSpan{
+		span:                span,
+		command:             command,
+		bytesUploadedBefore: executor.bytesUploaded(),
+		cacheAttemptsBefore: cacheAttemptsBefore,
+	}
+}
+
+func (executor *Executor) endStepSpan(stepSpan *stepSpan, stepResult *StepResult) {
+	if stepSpan == nil {
+		return
+	}
+
+	span := stepSpan.span
+
+	// The step requested to terminate the execution (e.g. ExitInstruction)
+	if stepResult == nil {
+		span.End(true)
+
+		return
+	}
+
+	span.SetAttribute("cirrus.command.signaled_to_exit", stepResult.SignaledToExit)
+
+	if _, ok := stepSpan.command.Instruction.(*api.Command_ScriptInstruction); ok {
+		span.SetAttribute("process.exit_code", stepResult.ExitCode)
+	}
+
+	if bytesUploaded := executor.bytesUploaded() - stepSpan.bytesUploadedBefore; bytesUploaded != 0 {
+		span.SetAttribute("cirrus.artifacts.bytes_uploaded", bytesUploaded)
+	}
+
+	if _, ok := stepSpan.command.Instruction.(*api.Command_CacheInstruction); ok {
+		for key, attempt := range executor.cacheAttempts.ToProto() {
+			if stepSpan.cacheAttemptsBefore[key] == attempt {
+				continue
+			}
+
+			span.SetAttribute("cirrus.cache.key", key)
+			span.SetAttribute("cirrus.cache.hit", attempt.GetHit() != nil)
+		}
+	}
+
+	span.End(stepResult.Success)
+}
+
+// shutdownTracer exports the collected spans.
+func (executor *Executor) shutdownTracer(ctx context.Context, success bool) {
+	if executor.tracer == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	if err := executor.tracer.Shutdown(ctx, success); err != nil {
+		log.Printf("Failed to export the OpenTelemetry trace: %v", err)
+	}
+}
+
+func instructionName(command *api.Command) string {
+	switch command.Instruction.(type) {
+	case *api.Command_CloneInstruction:
+		return "clone"
+	case *api.Command_FileInstruction:
+		return "file"
+	case *api.Command_ScriptInstruction:
+		return "script"
+	case *api.Command_BackgroundScriptInstruction:
+		return "background_script"
+	case *api.Command_CacheInstruction:
+		return "cache"
+	case *api.Command_UploadCacheInstruction:
+		return "upload_cache"
+	case *api.Command_ArtifactsInstruction:
+		return "artifacts"
+	case *api.Command_WaitForTerminalInstruction:
+		return "wait_for_terminal"
+	case *api.Command_ExitInstruction:
+		return "exit"
+	default:
+		return "unknown"
+	}
+}
+
+func (executor *Executor) bytesUploaded() uint64 {
+	executor.uploadsMtx.Lock()
+	defer executor.uploadsMtx.Unlock()
+
+	return executor.artifactsBytesUploaded
+}
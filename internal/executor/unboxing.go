@@ -0,0 +1,180 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/azkvunboxer"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/gcpsmunboxer"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/opunboxer"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/secretresolver"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
+	"log"
+	"os"
+	"time"
+)
+
+// secretUnboxers holds the secret manager clients, which are lazily
+// initialized when the first value boxed for them is encountered.
+type secretUnboxers struct {
+	vault *vaultunboxer.VaultUnboxer
+	gcpsm *gcpsmunboxer.GCPSMUnboxer
+	azkv  *azkvunboxer.AZKVUnboxer
+	op    *opunboxer.OPUnboxer
+}
+
+// unboxEnvironment replaces the boxed values (e.g. VAULT[...]) in the environment with
+// the secrets they point to and marks the latter as sensitive to mask them in the logs.
+func (executor *Executor) unboxEnvironment(ctx context.Context, unboxers *secretUnboxers) error {
+	unresolved := map[string]string{}
+
+	for key, value := range executor.env.Items() {
+		unboxedValue, err := executor.unbox(ctx, unboxers, value)
+		if err != nil {
+			return err
+		}
+
+		if unboxedValue == nil {
+			if secretresolver.LooksBoxed(value) {
+				unresolved[key] = value
+			}
+
+			continue
+		}
+
+		executor.env.Set(key, *unboxedValue)
+		executor.env.AddSensitiveValues(*unboxedValue)
+	}
+
+	// Let the external secret resolver handle the rest
+	resolver := executor.secretResolver()
+	if resolver == nil || len(unresolved) == 0 {
+		return nil
+	}
+
+	resolved, err := resolver.Resolve(ctx, unresolved)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range resolved {
+		executor.env.Set(key, value)
+		executor.env.AddSensitiveValues(value)
+	}
+
+	return nil
+}
+
+// secretResolver returns the external secret resolver configured
+// with the CIRRUS_SECRET_RESOLVER variable (if any).
+func (executor *Executor) secretResolver() secretresolver.Resolver {
+	path, ok := executor.env.Lookup(secretresolver.EnvCirrusSecretResolver)
+	if !ok {
+		path = os.Getenv(secretresolver.EnvCirrusSecretResolver)
+	}
+
+	if path == "" {
+		return nil
+	}
+
+	return secretresolver.NewPlugin(path, append(os.Environ(), EnvMapAsSlice(executor.env.Items())...))
+}
+
+// unbox returns nil if the value is not boxed.
+func (executor *Executor) unbox(ctx context.Context, unboxers *secretUnboxers, value string) (*string, error) {
+	vaultBoxedValue, err := vaultunboxer.NewBoxedValue(value)
+	if err == nil {
+		if unboxers.vault == nil {
+			unboxers.vault, err = vaultunboxer.NewFromEnvironment(ctx, executor.env)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize a Vault client: %v", err)
+			}
+		}
+
+		unboxedValue, err := unboxers.vault.Unbox(ctx, vaultBoxedValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unbox a Vault-boxed value %s: %v", value, err)
+		}
+
+		return &unboxedValue, nil
+	} else if !errors.Is(err, vaultunboxer.ErrNotABoxedValue) {
+		return nil, fmt.Errorf("failed to parse a Vault-boxed value %s: %v", value, err)
+	}
+
+	gcpsmBoxedValue, err := gcpsmunboxer.NewBoxedValue(value)
+	if err == nil {
+		if unboxers.gcpsm == nil {
+			unboxers.gcpsm, err = gcpsmunboxer.NewFromEnvironment(executor.env)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize a GCP Secret Manager client: %v", err)
+			}
+		}
+
+		unboxedValue, err := unboxers.gcpsm.Unbox(ctx, gcpsmBoxedValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unbox a GCP Secret Manager-boxed value %s: %v", value, err)
+		}
+
+		return &unboxedValue, nil
+	} else if !errors.Is(err, gcpsmunboxer.ErrNotABoxedValue) {
+		return nil, fmt.Errorf("failed to parse a GCP Secret Manager-boxed value %s: %v", value, err)
+	}
+
+	azkvBoxedValue, err := azkvunboxer.NewBoxedValue(value)
+	if err == nil {
+		if unboxers.azkv == nil {
+			unboxers.azkv, err = azkvunboxer.NewFromEnvironment(executor.env)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize an Azure Key Vault client: %v", err)
+			}
+		}
+
+		unboxedValue, err := unboxers.azkv.Unbox(ctx, azkvBoxedValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unbox an Azure Key Vault-boxed value %s: %v", value, err)
+		}
+
+		return &unboxedValue, nil
+	} else if !errors.Is(err, azkvunboxer.ErrNotABoxedValue) {
+		return nil, fmt.Errorf("failed to parse an Azure Key Vault-boxed value %s: %v", value, err)
+	}
+
+	opBoxedValue, err := opunboxer.NewBoxedValue(value)
+	if err == nil {
+		if unboxers.op == nil {
+			unboxers.op, err = opunboxer.NewFromEnvironment(executor.env)
+			if err != nil {
+				return nil, fmt.Errorf("failed to initialize a 1Password client: %v", err)
+			}
+		}
+
+		unboxedValue, err := unboxers.op.Unbox(ctx, opBoxedValue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unbox a 1Password-boxed value %s: %v", value, err)
+		}
+
+		return &unboxedValue, nil
+	} else if !errors.Is(err, opunboxer.ErrNotABoxedValue) {
+		return nil, fmt.Errorf("failed to parse a 1Password-boxed value %s: %v", value, err)
+	}
+
+	return nil, nil
+}
+
+// cleanupUnboxers is run when the task finishes (successfully or not) and uses
+// a separate context since the task's one might be already cancelled at that point.
+func (executor *Executor) cleanupUnboxers(unboxers *secretUnboxers) {
+	if unboxers.vault == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := unboxers.vault.RevokeLeases(ctx); err != nil {
+		message := fmt.Sprintf("Failed to revoke Vault leases: %v", err)
+		log.Println(message)
+		executor.reportWarning(ctx, client.ProblemSecrets, message)
+	}
+}
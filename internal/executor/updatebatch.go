@@ -0,0 +1,31 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/updatebatcher"
+	"log"
+	"time"
+)
+
+// EnvCirrusUpdateBatchWindow controls for how long the command status updates are batched
+// before being reported (e.g. "5s"), "0" reports each update right away.
+const EnvCirrusUpdateBatchWindow = "CIRRUS_UPDATE_BATCH_WINDOW"
+
+func (executor *Executor) updateBatchWindow() time.Duration {
+	value, ok := executor.env.Lookup(EnvCirrusUpdateBatchWindow)
+	if !ok {
+		return updatebatcher.DefaultWindow
+	}
+
+	if value == "0" {
+		return 0
+	}
+
+	window, err := time.ParseDuration(value)
+	if err != nil || window < 0 {
+		log.Printf("Ignoring invalid %s value %q", EnvCirrusUpdateBatchWindow, value)
+
+		return updatebatcher.DefaultWindow
+	}
+
+	return window
+}
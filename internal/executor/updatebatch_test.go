@@ -0,0 +1,23 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/updatebatcher"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestUpdateBatchWindow(t *testing.T) {
+	executor := &Executor{env: environment.New(map[string]string{})}
+	require.Equal(t, updatebatcher.DefaultWindow, executor.updateBatchWindow())
+
+	executor.env.Set(EnvCirrusUpdateBatchWindow, "0")
+	require.Zero(t, executor.updateBatchWindow())
+
+	executor.env.Set(EnvCirrusUpdateBatchWindow, "10s")
+	require.Equal(t, 10*time.Second, executor.updateBatchWindow())
+
+	executor.env.Set(EnvCirrusUpdateBatchWindow, "-1s")
+	require.Equal(t, updatebatcher.DefaultWindow, executor.updateBatchWindow())
+}
@@ -0,0 +1,16 @@
+package updatebatcher
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"time"
+)
+
+// NewProgressUpdate returns an intermediate update for a command that is still executing.
+func NewProgressUpdate(name string, elapsed time.Duration, percent int) *api.CommandResult {
+	return &api.CommandResult{
+		Name:            name,
+		Status:          api.Status_EXECUTING,
+		DurationInNanos: elapsed.Nanoseconds(),
+		Progress:        uint32(percent),
+	}
+}
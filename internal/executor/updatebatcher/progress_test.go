@@ -0,0 +1,26 @@
+package updatebatcher_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/updatebatcher"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"testing"
+	"time"
+)
+
+func TestProgressUpdate(t *testing.T) {
+	update := updatebatcher.NewProgressUpdate("build", time.Minute, 42)
+	require.Equal(t, api.Status_EXECUTING, update.Status)
+
+	// Survives the wire
+	wire, err := proto.Marshal(update)
+	require.NoError(t, err)
+
+	var received api.CommandResult
+	require.NoError(t, proto.Unmarshal(wire, &received))
+	require.Equal(t, "build", received.Name)
+	require.Equal(t, time.Minute.Nanoseconds(), received.DurationInNanos)
+
+	require.EqualValues(t, 42, received.Progress)
+}
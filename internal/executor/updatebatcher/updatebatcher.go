@@ -5,41 +5,166 @@ import (
 	"github.com/cirruslabs/cirrus-ci-agent/api"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
 	"log"
+	"sync"
+	"time"
 )
 
+// DefaultWindow is for how long the chatty updates (e.g. a quick succession
+// of short commands) are batched before being reported.
+const DefaultWindow = 2 * time.Second
+
 type UpdateBatcher struct {
+	report func(ctx context.Context, request *api.ReportCommandUpdatesRequest) error
+	window time.Duration
+
+	// flushMtx serializes the flushes so that the batches are reported in order,
+	// while mtx only guards the state and is never held during the RPC, so that
+	// queueing an update doesn't have to wait for a slow server
+	flushMtx sync.Mutex
+
+	mtx              sync.Mutex
 	updateHistory    []*api.CommandResult
 	unflushedUpdates []*api.CommandResult
+	firstUnflushed   time.Time
+	urgent           bool
+	failureQueued    bool
+
+	// A batch that failed to be reported is re-sent as is with the same idempotency key
+	// (since it might've actually reached the server) before reporting any new updates
+	pendingBatch    []*api.CommandResult
+	pendingBatchKey client.IdempotencyKey
 }
 
 func New() *UpdateBatcher {
 	return &UpdateBatcher{
+		report: func(ctx context.Context, request *api.ReportCommandUpdatesRequest) error {
+			_, err := client.CirrusClient.ReportCommandUpdates(ctx, request)
+			return err
+		},
+		window:           DefaultWindow,
 		updateHistory:    []*api.CommandResult{},
 		unflushedUpdates: []*api.CommandResult{},
 	}
 }
 
+// SetWindow configures the batching window, zero window disables the batching.
+func (ub *UpdateBatcher) SetWindow(window time.Duration) {
+	ub.mtx.Lock()
+	defer ub.mtx.Unlock()
+
+	ub.window = window
+}
+
 func (ub *UpdateBatcher) Queue(update *api.CommandResult) {
+	ub.mtx.Lock()
+	defer ub.mtx.Unlock()
+
+	if len(ub.unflushedUpdates) == 0 {
+		ub.firstUnflushed = time.Now()
+	}
+
 	ub.updateHistory = append(ub.updateHistory, update)
 	ub.unflushedUpdates = append(ub.unflushedUpdates, update)
+
+	// The first failure is important enough to be reported right away
+	if update.Status == api.Status_FAILED && !ub.failureQueued {
+		ub.failureQueued = true
+		ub.urgent = true
+	}
+}
+
+// MaybeFlush flushes the updates if there's an urgent one or the batching window has passed.
+func (ub *UpdateBatcher) MaybeFlush(ctx context.Context, taskIdentification *api.TaskIdentification) {
+	ub.flush(ctx, taskIdentification, true)
+}
+
+// Run periodically flushes the updates whose batching window has passed, so that
+// e.g. the EXECUTING status of a long command is reported without waiting for it to finish.
+func (ub *UpdateBatcher) Run(ctx context.Context, taskIdentification *api.TaskIdentification) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ub.MaybeFlush(ctx, taskIdentification)
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
 func (ub *UpdateBatcher) Flush(ctx context.Context, taskIdentification *api.TaskIdentification) {
+	ub.flush(ctx, taskIdentification, false)
+}
+
+func (ub *UpdateBatcher) shouldFlush(now time.Time) bool {
+	if len(ub.pendingBatch) != 0 {
+		return true
+	}
+
 	if len(ub.unflushedUpdates) == 0 {
-		return
+		return false
 	}
 
-	_, err := client.CirrusClient.ReportCommandUpdates(ctx, &api.ReportCommandUpdatesRequest{
-		TaskIdentification: taskIdentification,
-		Updates:            ub.unflushedUpdates,
-	})
-	if err != nil {
-		log.Printf("Failed to report command updates: %v\n", err)
-		return
+	return ub.urgent || now.Sub(ub.firstUnflushed) >= ub.window
+}
+
+func (ub *UpdateBatcher) flush(ctx context.Context, taskIdentification *api.TaskIdentification, onlyIfDue bool) {
+	ub.flushMtx.Lock()
+	defer ub.flushMtx.Unlock()
+
+	for {
+		batch, key, ok := ub.takeBatch(onlyIfDue)
+		if !ok {
+			return
+		}
+
+		err := ub.report(key.Attach(ctx), &api.ReportCommandUpdatesRequest{
+			TaskIdentification: taskIdentification,
+			Updates:            batch,
+		})
+		if err != nil {
+			log.Printf("Failed to report command updates: %v\n", err)
+			return
+		}
+
+		ub.mtx.Lock()
+		ub.pendingBatch = nil
+		ub.mtx.Unlock()
+
+		// Report the updates queued while the batch was being sent
+		onlyIfDue = false
 	}
-	ub.unflushedUpdates = ub.unflushedUpdates[:0]
+}
+
+// takeBatch returns the batch to report: either the pending batch that has failed
+// to be reported previously or the newly queued updates.
+func (ub *UpdateBatcher) takeBatch(onlyIfDue bool) ([]*api.CommandResult, client.IdempotencyKey, bool) {
+	ub.mtx.Lock()
+	defer ub.mtx.Unlock()
+
+	if onlyIfDue && !ub.shouldFlush(time.Now()) {
+		return nil, client.IdempotencyKey{}, false
+	}
+
+	if len(ub.pendingBatch) == 0 {
+		if len(ub.unflushedUpdates) == 0 {
+			return nil, client.IdempotencyKey{}, false
+		}
+
+		ub.pendingBatch = ub.unflushedUpdates
+		ub.pendingBatchKey = client.NewIdempotencyKey()
+		ub.unflushedUpdates = []*api.CommandResult{}
+		ub.urgent = false
+	}
+
+	return ub.pendingBatch, ub.pendingBatchKey, true
 }
 
 func (ub *UpdateBatcher) History() []*api.CommandResult {
+	ub.mtx.Lock()
+	defer ub.mtx.Unlock()
+
 	return ub.updateHistory
 }
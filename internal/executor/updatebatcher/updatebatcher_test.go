@@ -0,0 +1,160 @@
+package updatebatcher
+
+import (
+	"context"
+	"errors"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"testing"
+	"time"
+)
+
+func newTestBatcher(window time.Duration) (*UpdateBatcher, *[][]*api.CommandResult) {
+	var reported [][]*api.CommandResult
+
+	ub := New()
+	ub.SetWindow(window)
+	ub.report = func(ctx context.Context, request *api.ReportCommandUpdatesRequest) error {
+		reported = append(reported, append([]*api.CommandResult{}, request.Updates...))
+		return nil
+	}
+
+	return ub, &reported
+}
+
+func TestChattyUpdatesAreBatched(t *testing.T) {
+	ub, reported := newTestBatcher(time.Hour)
+
+	ub.Queue(&api.CommandResult{Name: "clone", Status: api.Status_EXECUTING})
+	ub.MaybeFlush(context.Background(), nil)
+	ub.Queue(&api.CommandResult{Name: "clone", Status: api.Status_COMPLETED})
+	ub.Queue(&api.CommandResult{Name: "build", Status: api.Status_EXECUTING})
+	ub.MaybeFlush(context.Background(), nil)
+	require.Empty(t, *reported)
+
+	ub.Flush(context.Background(), nil)
+	require.Len(t, *reported, 1)
+	require.Len(t, (*reported)[0], 3)
+	require.Len(t, ub.History(), 3)
+}
+
+func TestFirstFailureIsFlushedImmediately(t *testing.T) {
+	ub, reported := newTestBatcher(time.Hour)
+
+	ub.Queue(&api.CommandResult{Name: "build", Status: api.Status_FAILED})
+	ub.MaybeFlush(context.Background(), nil)
+	require.Len(t, *reported, 1)
+
+	// Subsequent failures are batched
+	ub.Queue(&api.CommandResult{Name: "test", Status: api.Status_FAILED})
+	ub.MaybeFlush(context.Background(), nil)
+	require.Len(t, *reported, 1)
+}
+
+func TestWindow(t *testing.T) {
+	ub, reported := newTestBatcher(0)
+
+	ub.Queue(&api.CommandResult{Name: "build", Status: api.Status_EXECUTING})
+	ub.MaybeFlush(context.Background(), nil)
+	require.Len(t, *reported, 1)
+
+	ub.SetWindow(time.Minute)
+	ub.Queue(&api.CommandResult{Name: "build", Status: api.Status_COMPLETED})
+	require.False(t, ub.shouldFlush(time.Now()))
+	require.True(t, ub.shouldFlush(time.Now().Add(time.Minute)))
+}
+
+func TestFailedBatchIsResentWithTheSameIdempotencyKey(t *testing.T) {
+	type attempt struct {
+		key     string
+		updates []string
+	}
+
+	var attempts []attempt
+	fail := true
+
+	ub := New()
+	ub.SetWindow(0)
+	ub.report = func(ctx context.Context, request *api.ReportCommandUpdatesRequest) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+
+		var updates []string
+		for _, update := range request.Updates {
+			updates = append(updates, update.Name)
+		}
+
+		attempts = append(attempts, attempt{key: md.Get(client.IdempotencyKeyHeader)[0], updates: updates})
+
+		if fail {
+			return errors.New("deadline exceeded")
+		}
+
+		return nil
+	}
+
+	ub.Queue(&api.CommandResult{Name: "clone", Status: api.Status_EXECUTING})
+	ub.Flush(context.Background(), nil)
+
+	fail = false
+	ub.Queue(&api.CommandResult{Name: "build", Status: api.Status_EXECUTING})
+	ub.Flush(context.Background(), nil)
+
+	require.Len(t, attempts, 3)
+	require.Equal(t, []string{"clone"}, attempts[0].updates)
+	require.Equal(t, attempts[0], attempts[1])
+	require.Equal(t, []string{"build"}, attempts[2].updates)
+	require.NotEqual(t, attempts[1].key, attempts[2].key)
+}
+
+func TestQueueIsNotBlockedBySlowReport(t *testing.T) {
+	reportStarted := make(chan struct{})
+	reportUnblocked := make(chan struct{})
+	var reported [][]string
+
+	ub := New()
+	ub.SetWindow(0)
+	ub.report = func(ctx context.Context, request *api.ReportCommandUpdatesRequest) error {
+		var updates []string
+		for _, update := range request.Updates {
+			updates = append(updates, update.Name)
+		}
+		reported = append(reported, updates)
+
+		if len(reported) == 1 {
+			close(reportStarted)
+			<-reportUnblocked
+		}
+
+		return nil
+	}
+
+	ub.Queue(&api.CommandResult{Name: "clone", Status: api.Status_EXECUTING})
+
+	flushed := make(chan struct{})
+	go func() {
+		ub.Flush(context.Background(), nil)
+		close(flushed)
+	}()
+
+	<-reportStarted
+
+	queued := make(chan struct{})
+	go func() {
+		ub.Queue(&api.CommandResult{Name: "build", Status: api.Status_EXECUTING})
+		close(queued)
+	}()
+
+	select {
+	case <-queued:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "Queue() is blocked by the report in progress")
+	}
+
+	close(reportUnblocked)
+	<-flushed
+
+	// The update queued during the report is sent right after it
+	require.Equal(t, [][]string{{"clone"}, {"build"}}, reported)
+}
@@ -0,0 +1,31 @@
+package vaultunboxer
+
+import (
+	"context"
+	"fmt"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+type AppRoleAuth struct {
+	RoleID   string
+	SecretID string
+	Path     string
+}
+
+func (appRoleAuth *AppRoleAuth) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	data := map[string]interface{}{
+		"role_id": appRoleAuth.RoleID,
+	}
+
+	// Secret ID is optional when the role is configured with bind_secret_id=false
+	if appRoleAuth.SecretID != "" {
+		data["secret_id"] = appRoleAuth.SecretID
+	}
+
+	if appRoleAuth.Path == "" {
+		appRoleAuth.Path = "approle"
+	}
+
+	return client.Logical().WriteWithContext(ctx, fmt.Sprintf("auth/%s/login", appRoleAuth.Path), data)
+}
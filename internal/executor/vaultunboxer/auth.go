@@ -0,0 +1,80 @@
+package vaultunboxer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	EnvCirrusVaultAuth     = "CIRRUS_VAULT_AUTH"
+	EnvCirrusVaultRoleID   = "CIRRUS_VAULT_ROLE_ID"
+	EnvCirrusVaultSecretID = "CIRRUS_VAULT_SECRET_ID"
+
+	EnvCirrusVaultKubernetesTokenPath = "CIRRUS_VAULT_KUBERNETES_TOKEN_PATH"
+	EnvCirrusVaultAWSServerID         = "CIRRUS_VAULT_AWS_SERVER_ID"
+
+	AuthJWT        = "jwt"
+	AuthAppRole    = "approle"
+	AuthKubernetes = "kubernetes"
+	AuthAWS        = "aws"
+)
+
+var ErrInvalidAuth = errors.New("invalid Vault authentication configuration")
+
+// NewAuthMethodFromEnvironment returns the Vault authentication method selected
+// by the CIRRUS_VAULT_AUTH variable or nil if no authentication is needed.
+func NewAuthMethodFromEnvironment(env *environment.Environment) (vault.AuthMethod, error) {
+	switch method := env.Get(EnvCirrusVaultAuth); method {
+	case "", AuthJWT:
+		jwtToken, ok := env.Lookup("CIRRUS_OIDC_TOKEN")
+		if !ok {
+			if method == AuthJWT {
+				return nil, fmt.Errorf("%w: %s authentication requires CIRRUS_OIDC_TOKEN to be set",
+					ErrInvalidAuth, AuthJWT)
+			}
+
+			return nil, nil
+		}
+
+		return &JWTAuth{
+			Token: jwtToken,
+			Role:  env.Get(EnvCirrusVaultRole),
+			Path:  env.Get(EnvCirrusVaultAuthPath),
+		}, nil
+	case AuthAppRole:
+		roleID, ok := env.Lookup(EnvCirrusVaultRoleID)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s authentication requires %s to be set",
+				ErrInvalidAuth, AuthAppRole, EnvCirrusVaultRoleID)
+		}
+
+		return &AppRoleAuth{
+			RoleID:   roleID,
+			SecretID: env.Get(EnvCirrusVaultSecretID),
+			Path:     env.Get(EnvCirrusVaultAuthPath),
+		}, nil
+	case AuthKubernetes:
+		role, ok := env.Lookup(EnvCirrusVaultRole)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s authentication requires %s to be set",
+				ErrInvalidAuth, AuthKubernetes, EnvCirrusVaultRole)
+		}
+
+		return &KubernetesAuth{
+			Role:      role,
+			Path:      env.Get(EnvCirrusVaultAuthPath),
+			TokenPath: env.Get(EnvCirrusVaultKubernetesTokenPath),
+		}, nil
+	case AuthAWS:
+		return &AWSAuth{
+			Role:     env.Get(EnvCirrusVaultRole),
+			Path:     env.Get(EnvCirrusVaultAuthPath),
+			ServerID: env.Get(EnvCirrusVaultAWSServerID),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported %s value %q", ErrInvalidAuth, EnvCirrusVaultAuth, method)
+	}
+}
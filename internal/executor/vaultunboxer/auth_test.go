@@ -0,0 +1,67 @@
+package vaultunboxer_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAuthMethodFromEnvironment(t *testing.T) {
+	// No authentication
+	auth, err := vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{}))
+	require.NoError(t, err)
+	require.Nil(t, auth)
+
+	// JWT authentication is the default
+	auth, err = vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{
+		"CIRRUS_OIDC_TOKEN": "token",
+	}))
+	require.NoError(t, err)
+	require.IsType(t, &vaultunboxer.JWTAuth{}, auth)
+
+	// AppRole authentication
+	auth, err = vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{
+		"CIRRUS_OIDC_TOKEN":      "token",
+		"CIRRUS_VAULT_AUTH":      "approle",
+		"CIRRUS_VAULT_ROLE_ID":   "role",
+		"CIRRUS_VAULT_SECRET_ID": "secret",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, &vaultunboxer.AppRoleAuth{RoleID: "role", SecretID: "secret"}, auth)
+
+	// AppRole authentication without a role ID
+	_, err = vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{
+		"CIRRUS_VAULT_AUTH": "approle",
+	}))
+	require.ErrorIs(t, err, vaultunboxer.ErrInvalidAuth)
+
+	// Kubernetes authentication
+	auth, err = vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{
+		"CIRRUS_VAULT_AUTH": "kubernetes",
+		"CIRRUS_VAULT_ROLE": "ci",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, &vaultunboxer.KubernetesAuth{Role: "ci"}, auth)
+
+	// Kubernetes authentication without a role
+	_, err = vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{
+		"CIRRUS_VAULT_AUTH": "kubernetes",
+	}))
+	require.ErrorIs(t, err, vaultunboxer.ErrInvalidAuth)
+
+	// AWS IAM authentication
+	auth, err = vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{
+		"CIRRUS_VAULT_AUTH":          "aws",
+		"CIRRUS_VAULT_ROLE":          "ci",
+		"CIRRUS_VAULT_AWS_SERVER_ID": "vault.example.com",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, &vaultunboxer.AWSAuth{Role: "ci", ServerID: "vault.example.com"}, auth)
+
+	// Unsupported authentication method
+	_, err = vaultunboxer.NewAuthMethodFromEnvironment(environment.New(map[string]string{
+		"CIRRUS_VAULT_AUTH": "ldap",
+	}))
+	require.ErrorIs(t, err, vaultunboxer.ErrInvalidAuth)
+}
@@ -0,0 +1,73 @@
+package vaultunboxer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cirruslabs/cirrus-ci-agent/internal/awssigv4"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	stsURL         = "https://sts.amazonaws.com/"
+	stsRegion      = "us-east-1"
+	stsRequestBody = "Action=GetCallerIdentity&Version=2011-06-15"
+)
+
+// AWSAuth authenticates using the Vault's AWS auth method of the "iam" type[1]
+// by presenting a signed sts:GetCallerIdentity request, which Vault then executes
+// on its own to learn the identity of the caller.
+//
+// [1]: https://developer.hashicorp.com/vault/docs/auth/aws#iam-auth-method
+type AWSAuth struct {
+	Role     string
+	Path     string
+	ServerID string
+}
+
+func (awsAuth *AWSAuth) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	credentials, err := awssigv4.DefaultCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, stsURL, bytes.NewReader([]byte(stsRequestBody)))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	// Protects against replaying the signed request to other Vault servers
+	if awsAuth.ServerID != "" {
+		request.Header.Set("X-Vault-AWS-IAM-Server-ID", awsAuth.ServerID)
+	}
+
+	awssigv4.Sign(request, []byte(stsRequestBody), credentials, stsRegion, "sts", time.Now())
+
+	headers, err := json.Marshal(request.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{
+		"iam_http_request_method": request.Method,
+		"iam_request_url":         base64.StdEncoding.EncodeToString([]byte(stsURL)),
+		"iam_request_body":        base64.StdEncoding.EncodeToString([]byte(stsRequestBody)),
+		"iam_request_headers":     base64.StdEncoding.EncodeToString(headers),
+	}
+
+	if awsAuth.Role != "" {
+		data["role"] = awsAuth.Role
+	}
+
+	if awsAuth.Path == "" {
+		awsAuth.Path = "aws"
+	}
+
+	return client.Logical().WriteWithContext(ctx, fmt.Sprintf("auth/%s/login", awsAuth.Path), data)
+}
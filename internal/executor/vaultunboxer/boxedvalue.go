@@ -3,11 +3,13 @@ package vaultunboxer
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
 type BoxedValue struct {
 	vaultPath string
+	version   string
 	dataPath  []string
 }
 
@@ -43,8 +45,23 @@ func NewBoxedValue(rawBoxedValue string) (*BoxedValue, error) {
 		}
 	}
 
+	// KV v2 secrets can be pinned to a specific version with a "?version=N" suffix
+	pathAndQuery := strings.SplitN(parts[0], "?", 2)
+
+	var version string
+
+	if len(pathAndQuery) == 2 {
+		query, err := url.ParseQuery(pathAndQuery[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to parse path parameters: %v", ErrInvalidBoxedValue, err)
+		}
+
+		version = query.Get("version")
+	}
+
 	return &BoxedValue{
-		vaultPath: parts[0],
+		vaultPath: pathAndQuery[0],
+		version:   version,
 		dataPath:  dataPath,
 	}, nil
 }
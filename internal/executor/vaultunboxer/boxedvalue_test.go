@@ -32,6 +32,10 @@ func TestInvalidBoxedValues(t *testing.T) {
 	// Value that contains a selector with empty elements
 	_, err = vaultunboxer.NewBoxedValue("VAULT[some/path some.]")
 	require.ErrorIs(t, err, vaultunboxer.ErrInvalidBoxedValue)
+
+	// Value with malformed path parameters
+	_, err = vaultunboxer.NewBoxedValue("VAULT[some/path?version=%zz some.path]")
+	require.ErrorIs(t, err, vaultunboxer.ErrInvalidBoxedValue)
 }
 
 func TestSelectorInvalidCombinations(t *testing.T) {
@@ -0,0 +1,61 @@
+package vaultunboxer_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVaultCache(t *testing.T) {
+	trials := []struct {
+		Name          string
+		CacheSetting  string
+		ExpectedReads []string
+	}{
+		{
+			Name:          "enabled",
+			ExpectedReads: []string{"", "2"},
+		},
+		{
+			Name:          "disabled",
+			CacheSetting:  "false",
+			ExpectedReads: []string{"", "", "2", "2"},
+		},
+	}
+
+	for _, trial := range trials {
+		t.Run(trial.Name, func(t *testing.T) {
+			ctx := context.Background()
+
+			fake := &fakeVault{leaseDuration: 3600}
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			unboxer, err := vaultunboxer.NewFromEnvironment(ctx, environment.New(map[string]string{
+				"CIRRUS_VAULT_URL":     server.URL,
+				"CIRRUS_VAULT_AUTH":    "approle",
+				"CIRRUS_VAULT_ROLE_ID": "role",
+				"CIRRUS_VAULT_CACHE":   trial.CacheSetting,
+			}))
+			require.NoError(t, err)
+
+			for _, rawBoxedValue := range []string{
+				"VAULT[secret/data/keys data.admin]",
+				"VAULT[secret/data/keys data.user]",
+				"VAULT[secret/data/keys?version=2 data.admin]",
+				"VAULT[secret/data/keys?version=2 data.user]",
+			} {
+				boxedValue, err := vaultunboxer.NewBoxedValue(rawBoxedValue)
+				require.NoError(t, err)
+
+				_, err = unboxer.Unbox(ctx, boxedValue)
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, trial.ExpectedReads, fake.keysReads)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package vaultunboxer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+const DefaultKubernetesServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+type KubernetesAuth struct {
+	Role      string
+	Path      string
+	TokenPath string
+}
+
+func (kubernetesAuth *KubernetesAuth) Login(ctx context.Context, client *vault.Client) (*vault.Secret, error) {
+	if kubernetesAuth.TokenPath == "" {
+		kubernetesAuth.TokenPath = DefaultKubernetesServiceAccountTokenPath
+	}
+
+	// Service account tokens are rotated by the kubelet, so always read the most recent one
+	jwt, err := os.ReadFile(kubernetesAuth.TokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Kubernetes service account token: %w", err)
+	}
+
+	data := map[string]interface{}{
+		"jwt":  strings.TrimSpace(string(jwt)),
+		"role": kubernetesAuth.Role,
+	}
+
+	if kubernetesAuth.Path == "" {
+		kubernetesAuth.Path = "kubernetes"
+	}
+
+	return client.Logical().WriteWithContext(ctx, fmt.Sprintf("auth/%s/login", kubernetesAuth.Path), data)
+}
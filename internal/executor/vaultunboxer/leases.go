@@ -0,0 +1,52 @@
+package vaultunboxer
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// RevokeLeases revokes the leases of the dynamic secrets (e.g. database
+// credentials) unboxed so far, limiting their lifetime to the task's one.
+func (unboxer *VaultUnboxer) RevokeLeases(ctx context.Context) error {
+	var numLeases int
+
+	for _, secret := range unboxer.secrets {
+		if secret.LeaseID != "" {
+			numLeases++
+		}
+	}
+
+	if numLeases == 0 {
+		return nil
+	}
+
+	if err := unboxer.ensureToken(ctx); err != nil {
+		return err
+	}
+
+	var numFailed int
+	var lastErr error
+
+	for key, secret := range unboxer.secrets {
+		if secret.LeaseID == "" {
+			continue
+		}
+
+		if err := unboxer.client.Sys().RevokeWithContext(ctx, secret.LeaseID); err != nil {
+			log.Printf("Failed to revoke Vault lease %s: %v", secret.LeaseID, err)
+			numFailed++
+			lastErr = err
+
+			continue
+		}
+
+		delete(unboxer.secrets, key)
+	}
+
+	if lastErr != nil {
+		return fmt.Errorf("failed to revoke %d Vault lease(s), last error: %w", numFailed, lastErr)
+	}
+
+	return nil
+}
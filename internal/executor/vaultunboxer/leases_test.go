@@ -0,0 +1,41 @@
+package vaultunboxer_test
+
+import (
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVaultDynamicSecrets(t *testing.T) {
+	ctx := context.Background()
+
+	fake := &fakeVault{leaseDuration: 3600}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	unboxer, err := vaultunboxer.NewFromEnvironment(ctx, environment.New(map[string]string{
+		"CIRRUS_VAULT_URL":     server.URL,
+		"CIRRUS_VAULT_AUTH":    "approle",
+		"CIRRUS_VAULT_ROLE_ID": "role",
+	}))
+	require.NoError(t, err)
+
+	// Both selectors should see the same credentials
+	for selector, expected := range map[string]string{
+		"VAULT[database/creds/readonly username]": "user-1",
+		"VAULT[database/creds/readonly password]": "password-1",
+	} {
+		boxedValue, err := vaultunboxer.NewBoxedValue(selector)
+		require.NoError(t, err)
+
+		value, err := unboxer.Unbox(ctx, boxedValue)
+		require.NoError(t, err)
+		require.Equal(t, expected, value)
+	}
+
+	require.NoError(t, unboxer.RevokeLeases(ctx))
+	require.Equal(t, []string{"database/creds/readonly/1"}, fake.revokedLeases)
+}
@@ -0,0 +1,61 @@
+package vaultunboxer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/avast/retry-go"
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	EnvCirrusVaultRetries = "CIRRUS_VAULT_RETRIES"
+
+	defaultRetries = 3
+)
+
+// withRetries retries the Vault request on transient errors (e.g. 5xx, including
+// the 503 of a sealed-but-recovering Vault, and connection resets) with a backoff.
+func (unboxer *VaultUnboxer) withRetries(ctx context.Context, what string, request func() error) error {
+	return retry.Do(request,
+		retry.Context(ctx),
+		retry.Attempts(unboxer.retries+1),
+		retry.Delay(time.Second),
+		retry.MaxDelay(15*time.Second),
+		retry.DelayType(retry.BackOffDelay),
+		retry.RetryIf(isTransient),
+		retry.OnRetry(func(n uint, err error) {
+			// retry-go calls this after the last attempt too
+			if n >= unboxer.retries {
+				log.Printf("Vault %s failed with a transient error, giving up after %d retries: %v",
+					what, unboxer.retries, err)
+
+				return
+			}
+
+			log.Printf("Vault %s failed with a transient error, retrying (%d/%d): %v",
+				what, n+1, unboxer.retries, err)
+		}),
+		retry.LastErrorOnly(true),
+	)
+}
+
+func isTransient(err error) bool {
+	var responseError *vault.ResponseError
+	if errors.As(err, &responseError) {
+		return responseError.StatusCode >= 500
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
@@ -0,0 +1,55 @@
+package vaultunboxer_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
+	"github.com/stretchr/testify/require"
+	"log"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestVaultRetries(t *testing.T) {
+	ctx := context.Background()
+
+	fake := &fakeVault{leaseDuration: 3600}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	unboxer, err := vaultunboxer.NewFromEnvironment(ctx, environment.New(map[string]string{
+		"CIRRUS_VAULT_URL":     server.URL,
+		"CIRRUS_VAULT_AUTH":    "approle",
+		"CIRRUS_VAULT_ROLE_ID": "role",
+		"CIRRUS_VAULT_CACHE":   "false",
+		"CIRRUS_VAULT_RETRIES": "1",
+	}))
+	require.NoError(t, err)
+
+	boxedValue, err := vaultunboxer.NewBoxedValue("VAULT[secret/data/keys data.admin]")
+	require.NoError(t, err)
+
+	// Single transient failure is retried
+	fake.keysFailures = 1
+
+	value, err := unboxer.Unbox(ctx, boxedValue)
+	require.NoError(t, err)
+	require.Equal(t, "secret key value", value)
+	require.Len(t, fake.keysReads, 2)
+
+	// ...but the retries are bounded
+	fake.keysFailures = 2
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	_, err = unboxer.Unbox(ctx, boxedValue)
+	require.Error(t, err)
+	require.Len(t, fake.keysReads, 4)
+	require.Contains(t, logs.String(), "retrying (1/1)")
+	require.Contains(t, logs.String(), "giving up after 1 retries")
+	require.NotContains(t, logs.String(), "retrying (2/1)")
+}
@@ -0,0 +1,82 @@
+package vaultunboxer
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/certifi/gocertifi"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+)
+
+const (
+	EnvCirrusVaultCACert     = "CIRRUS_VAULT_CA_CERT"
+	EnvCirrusVaultClientCert = "CIRRUS_VAULT_CLIENT_CERT"
+	EnvCirrusVaultClientKey  = "CIRRUS_VAULT_CLIENT_KEY"
+	EnvCirrusVaultSkipVerify = "CIRRUS_VAULT_SKIP_VERIFY"
+)
+
+// configureTLS configures the Vault client's TLS according to the CIRRUS_VAULT_* variables,
+// certificates and keys can be specified either as a path or as a PEM-encoded value.
+func configureTLS(tlsConfig *tls.Config, env *environment.Environment) error {
+	pool, err := gocertifi.CACerts()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	tlsConfig.RootCAs = pool
+
+	if rawCACert, ok := env.Lookup(EnvCirrusVaultCACert); ok {
+		caCert, err := readPEM(rawCACert)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", EnvCirrusVaultCACert, err)
+		}
+
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caCert) {
+			return fmt.Errorf("failed to read %s: no PEM-encoded certificates found", EnvCirrusVaultCACert)
+		}
+	}
+
+	rawClientCert, hasClientCert := env.Lookup(EnvCirrusVaultClientCert)
+	rawClientKey, hasClientKey := env.Lookup(EnvCirrusVaultClientKey)
+
+	if hasClientCert != hasClientKey {
+		return fmt.Errorf("both %s and %s should be set to use client certificate authentication",
+			EnvCirrusVaultClientCert, EnvCirrusVaultClientKey)
+	}
+
+	if hasClientCert {
+		clientCert, err := readPEM(rawClientCert)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", EnvCirrusVaultClientCert, err)
+		}
+
+		clientKey, err := readPEM(rawClientKey)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", EnvCirrusVaultClientKey, err)
+		}
+
+		keyPair, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return fmt.Errorf("failed to load Vault client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	// Only disable the verification when explicitly asked to
+	if env.Get(EnvCirrusVaultSkipVerify) == "true" {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	return nil
+}
+
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
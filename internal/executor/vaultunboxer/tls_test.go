@@ -0,0 +1,46 @@
+package vaultunboxer_test
+
+import (
+	"context"
+	"encoding/pem"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
+	"github.com/stretchr/testify/require"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVaultCustomCA(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewTLSServer(&fakeVault{leaseDuration: 3600})
+	defer server.Close()
+
+	env := map[string]string{
+		"CIRRUS_VAULT_URL":     server.URL,
+		"CIRRUS_VAULT_AUTH":    "approle",
+		"CIRRUS_VAULT_ROLE_ID": "role",
+	}
+
+	// Self-signed certificate is not trusted by default
+	_, err := vaultunboxer.NewFromEnvironment(ctx, environment.New(env))
+	require.Error(t, err)
+
+	// ...but is trusted when specified explicitly
+	env["CIRRUS_VAULT_CA_CERT"] = string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}))
+
+	_, err = vaultunboxer.NewFromEnvironment(ctx, environment.New(env))
+	require.NoError(t, err)
+}
+
+func TestVaultInvalidTLSConfiguration(t *testing.T) {
+	// Client certificate without a key
+	_, err := vaultunboxer.NewFromEnvironment(context.Background(), environment.New(map[string]string{
+		"CIRRUS_VAULT_URL":         "https://vault.example.com",
+		"CIRRUS_VAULT_CLIENT_CERT": "/nonexistent/cert.pem",
+	}))
+	require.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package vaultunboxer
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// Renew the token (or re-authenticate) when it has less than that left to live
+const tokenRenewalMargin = 30 * time.Second
+
+func (unboxer *VaultUnboxer) login(ctx context.Context) error {
+	var secret *vault.Secret
+
+	err := unboxer.withRetries(ctx, "login", func() (err error) {
+		secret, err = unboxer.client.Auth().Login(ctx, unboxer.auth)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	unboxer.trackToken(secret)
+
+	return nil
+}
+
+func (unboxer *VaultUnboxer) trackToken(secret *vault.Secret) {
+	ttl, err := secret.TokenTTL()
+	if err != nil || ttl == 0 {
+		// Non-expiring token or the TTL is unknown
+		unboxer.tokenExpiresAt = time.Time{}
+
+		return
+	}
+
+	unboxer.tokenExpiresAt = time.Now().Add(ttl)
+	unboxer.tokenRenewable, _ = secret.TokenIsRenewable()
+}
+
+// ensureToken renews the token that is about to expire, falling back
+// to re-authentication if the token is not renewable or has reached its max TTL.
+func (unboxer *VaultUnboxer) ensureToken(ctx context.Context) error {
+	if unboxer.tokenExpiresAt.IsZero() || time.Until(unboxer.tokenExpiresAt) > tokenRenewalMargin {
+		return nil
+	}
+
+	if unboxer.tokenRenewable {
+		secret, err := unboxer.client.Auth().Token().RenewSelfWithContext(ctx, 0)
+		if err == nil {
+			unboxer.trackToken(secret)
+
+			if time.Until(unboxer.tokenExpiresAt) > tokenRenewalMargin {
+				return nil
+			}
+		} else {
+			log.Printf("Failed to renew Vault token, re-authenticating: %v", err)
+		}
+	}
+
+	if unboxer.auth == nil {
+		return nil
+	}
+
+	return unboxer.login(ctx)
+}
+
+func isPermissionDenied(err error) bool {
+	var responseError *vault.ResponseError
+
+	return errors.As(err, &responseError) && responseError.StatusCode == http.StatusForbidden
+}
+
+// SetJWT updates the JWT used for (re-)authentication, e.g. when the CIRRUS_OIDC_TOKEN is refreshed.
+func (unboxer *VaultUnboxer) SetJWT(token string) {
+	if jwtAuth, ok := unboxer.auth.(*JWTAuth); ok {
+		jwtAuth.Token = token
+	}
+}
@@ -0,0 +1,121 @@
+package vaultunboxer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vaultunboxer"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeVault struct {
+	leaseDuration int
+	logins        int
+	validToken    string
+	issuedCreds   int
+	keysReads     []string
+	keysFailures  int
+	revokedLeases []string
+}
+
+func (fake *fakeVault) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	switch request.URL.Path {
+	case "/v1/auth/approle/login":
+		fake.logins++
+		fake.validToken = fmt.Sprintf("token-%d", fake.logins)
+
+		fmt.Fprintf(writer, `{"auth":{"client_token":%q,"lease_duration":%d,"renewable":false}}`,
+			fake.validToken, fake.leaseDuration)
+	case "/v1/secret/data/keys":
+		if request.Header.Get("X-Vault-Token") != fake.validToken {
+			writer.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(writer, `{"errors":["permission denied"]}`)
+
+			return
+		}
+
+		fake.keysReads = append(fake.keysReads, request.URL.Query().Get("version"))
+
+		if fake.keysFailures > 0 {
+			fake.keysFailures--
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(writer, `{"errors":["Vault is sealed"]}`)
+
+			return
+		}
+
+		fmt.Fprint(writer, `{"data":{"data":{"admin":"secret key value","user":"secret user value"}}}`)
+	case "/v1/database/creds/readonly":
+		fake.issuedCreds++
+
+		fmt.Fprintf(writer, `{"lease_id":"database/creds/readonly/%d","lease_duration":3600,`+
+			`"data":{"username":"user-%d","password":"password-%d"}}`,
+			fake.issuedCreds, fake.issuedCreds, fake.issuedCreds)
+	case "/v1/sys/leases/revoke":
+		var body struct {
+			LeaseID string `json:"lease_id"`
+		}
+
+		if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		fake.revokedLeases = append(fake.revokedLeases, body.LeaseID)
+		writer.WriteHeader(http.StatusNoContent)
+	default:
+		writer.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestVaultReauthentication(t *testing.T) {
+	ctx := context.Background()
+
+	fake := &fakeVault{leaseDuration: 3600}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	unboxer, err := vaultunboxer.NewFromEnvironment(ctx, environment.New(map[string]string{
+		"CIRRUS_VAULT_URL":     server.URL,
+		"CIRRUS_VAULT_AUTH":    "approle",
+		"CIRRUS_VAULT_ROLE_ID": "role",
+		// Make sure that each Unbox() hits the Vault
+		"CIRRUS_VAULT_CACHE": "false",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, 1, fake.logins)
+
+	selector, err := vaultunboxer.NewBoxedValue("VAULT[secret/data/keys data.admin]")
+	require.NoError(t, err)
+
+	// Token is still valid
+	value, err := unboxer.Unbox(ctx, selector)
+	require.NoError(t, err)
+	require.Equal(t, "secret key value", value)
+	require.Equal(t, 1, fake.logins)
+
+	// Token was revoked behind our back
+	fake.validToken = "revoked"
+
+	value, err = unboxer.Unbox(ctx, selector)
+	require.NoError(t, err)
+	require.Equal(t, "secret key value", value)
+	require.Equal(t, 2, fake.logins)
+
+	// Token is about to expire
+	fake.leaseDuration = 1
+	fake.validToken = "revoked"
+
+	_, err = unboxer.Unbox(ctx, selector)
+	require.NoError(t, err)
+	require.Equal(t, 3, fake.logins)
+
+	_, err = unboxer.Unbox(ctx, selector)
+	require.NoError(t, err)
+	require.Equal(t, 4, fake.logins)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/certifi/gocertifi"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
 	vault "github.com/hashicorp/vault/api"
 )
@@ -15,15 +17,31 @@ const (
 	EnvCirrusVaultAuthPath  = "CIRRUS_VAULT_AUTH_PATH"
 	EnvCirrusVaultNamespace = "CIRRUS_VAULT_NAMESPACE"
 	EnvCirrusVaultRole      = "CIRRUS_VAULT_ROLE"
+	EnvCirrusVaultCache     = "CIRRUS_VAULT_CACHE"
+	EnvCirrusVaultTimeout   = "CIRRUS_VAULT_TIMEOUT"
 )
 
 type VaultUnboxer struct {
 	client *vault.Client
+
+	// Used to renew the token or re-authenticate in long tasks
+	auth           vault.AuthMethod
+	tokenExpiresAt time.Time
+	tokenRenewable bool
+
+	// Secrets read so far, dynamic ones also need to be revoked once the task finishes
+	secrets      map[string]*vault.Secret
+	cacheEnabled bool
+
+	retries uint
 }
 
 func New(client *vault.Client) *VaultUnboxer {
 	return &VaultUnboxer{
-		client: client,
+		client:       client,
+		secrets:      map[string]*vault.Secret{},
+		cacheEnabled: true,
+		retries:      defaultRetries,
 	}
 }
 
@@ -31,8 +49,30 @@ func NewFromEnvironment(ctx context.Context, env *environment.Environment) (*Vau
 	config := vault.DefaultConfig()
 
 	tlsConfig := config.HttpClient.Transport.(*http.Transport).TLSClientConfig
-	pool, _ := gocertifi.CACerts()
-	tlsConfig.RootCAs = pool
+	if err := configureTLS(tlsConfig, env); err != nil {
+		return nil, err
+	}
+
+	if rawTimeout, ok := env.Lookup(EnvCirrusVaultTimeout); ok {
+		timeout, err := time.ParseDuration(rawTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", EnvCirrusVaultTimeout, err)
+		}
+		config.Timeout = timeout
+	}
+
+	// Retries are handled by the unboxer itself to log each of them
+	config.MaxRetries = 0
+
+	retries := uint(defaultRetries)
+
+	if rawRetries, ok := env.Lookup(EnvCirrusVaultRetries); ok {
+		parsedRetries, err := strconv.ParseUint(rawRetries, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", EnvCirrusVaultRetries, err)
+		}
+		retries = uint(parsedRetries)
+	}
 
 	client, err := vault.NewClient(config)
 	if err != nil {
@@ -50,34 +90,91 @@ func NewFromEnvironment(ctx context.Context, env *environment.Environment) (*Vau
 	}
 
 	if namespace, ok := env.Lookup(EnvCirrusVaultNamespace); ok {
+		// Vault expects namespaces in the "parent/child" form
+		namespace = strings.Trim(strings.TrimSpace(namespace), "/")
+		if namespace == "" {
+			return nil, fmt.Errorf("%s is set, but contains no namespace", EnvCirrusVaultNamespace)
+		}
+
 		client.SetNamespace(namespace)
 	}
 
-	if jwtToken, ok := env.Lookup("CIRRUS_OIDC_TOKEN"); ok {
-		auth := &JWTAuth{
-			Token: jwtToken,
-			Role:  env.Get(EnvCirrusVaultRole),
-			Path:  env.Get(EnvCirrusVaultAuthPath),
-		}
+	auth, err := NewAuthMethodFromEnvironment(env)
+	if err != nil {
+		return nil, err
+	}
 
-		_, err := client.Auth().Login(ctx, auth)
-		if err != nil {
+	unboxer := New(client)
+	unboxer.cacheEnabled = env.Get(EnvCirrusVaultCache) != "false"
+	unboxer.retries = retries
+
+	if auth != nil {
+		unboxer.auth = auth
+
+		if err := unboxer.login(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	return New(client), nil
+	return unboxer, nil
 }
 
 func (unboxer *VaultUnboxer) Unbox(ctx context.Context, selector *BoxedValue) (string, error) {
-	secret, err := unboxer.client.Logical().ReadWithContext(ctx, selector.vaultPath)
-	if err != nil {
-		return "", err
+	cacheKey := selector.vaultPath + "@" + selector.version
+
+	secret, ok := unboxer.secrets[cacheKey]
+	if !ok {
+		var err error
+
+		secret, err = unboxer.read(ctx, selector.vaultPath, selector.version)
+		if err != nil {
+			return "", err
+		}
+
+		// Dynamic secrets generate new credentials on each read, so make sure that
+		// the selectors referencing the same path see the same credentials even
+		// when the caching is disabled
+		if secret != nil && (unboxer.cacheEnabled || secret.LeaseID != "") {
+			unboxer.secrets[cacheKey] = secret
+		}
 	}
 
-	if secret.Data == nil {
+	if secret == nil || secret.Data == nil {
 		return "", fmt.Errorf("associated Vault secret contains no data")
 	}
 
 	return selector.Select(secret.Data)
 }
+
+func (unboxer *VaultUnboxer) read(ctx context.Context, path string, version string) (*vault.Secret, error) {
+	if err := unboxer.ensureToken(ctx); err != nil {
+		return nil, err
+	}
+
+	var data map[string][]string
+
+	if version != "" {
+		data = map[string][]string{
+			"version": {version},
+		}
+	}
+
+	var secret *vault.Secret
+
+	read := func() (err error) {
+		secret, err = unboxer.client.Logical().ReadWithDataWithContext(ctx, path, data)
+		return err
+	}
+
+	err := unboxer.withRetries(ctx, "read of "+path, read)
+	if err != nil && isPermissionDenied(err) && unboxer.auth != nil {
+		// The token might have been revoked or expired earlier than expected
+		if err := unboxer.login(ctx); err != nil {
+			return nil, err
+		}
+
+		err = unboxer.withRetries(ctx, "read of "+path, read)
+	}
+
+	return secret, err
+}
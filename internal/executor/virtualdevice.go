@@ -0,0 +1,106 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/virtualdevice"
+	"log"
+	"strconv"
+	"time"
+)
+
+const (
+	// EnvCirrusSimulator requests an iOS simulator of the specified device type, optionally
+	// followed by a comma and the runtime (e.g. "iPhone 15, iOS-17-0"). The simulator's UDID
+	// is exported as CIRRUS_SIMULATOR_UDID.
+	EnvCirrusSimulator = "CIRRUS_SIMULATOR"
+
+	// EnvCirrusEmulator requests an Android emulator for the specified system image, optionally
+	// followed by a comma and the device profile (e.g. "system-images;android-33;google_apis;x86_64, pixel_6").
+	// The emulator's serial is exported as CIRRUS_EMULATOR_SERIAL and ANDROID_SERIAL.
+	EnvCirrusEmulator = "CIRRUS_EMULATOR"
+
+	// EnvCirrusDeviceBootTimeout is the number of seconds to wait for the simulator or the emulator to boot.
+	EnvCirrusDeviceBootTimeout = "CIRRUS_DEVICE_BOOT_TIMEOUT"
+
+	EnvCirrusSimulatorUDID    = "CIRRUS_SIMULATOR_UDID"
+	EnvCirrusEmulatorSerial   = "CIRRUS_EMULATOR_SERIAL"
+	defaultDeviceBootTimeout  = 10 * time.Minute
+	virtualDeviceStopDeadline = time.Minute
+)
+
+// bootVirtualDevices boots the requested simulator and/or emulator, returning a function that stops them.
+func (executor *Executor) bootVirtualDevices(ctx context.Context) (func(), error) {
+	var devices []*virtualdevice.Device
+
+	stop := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), virtualDeviceStopDeadline)
+		defer cancel()
+
+		for _, device := range devices {
+			if err := device.Shutdown(ctx); err != nil {
+				log.Printf("Failed to shut down the virtual device %s: %v", device.ID, err)
+			}
+		}
+	}
+
+	if value := executor.env.Get(EnvCirrusSimulator); value != "" {
+		spec, err := virtualdevice.ParseSimulatorSpec(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", EnvCirrusSimulator, err)
+		}
+
+		log.Printf("Booting the %s simulator...", spec.DeviceType)
+
+		bootCtx, cancel := context.WithTimeout(ctx, executor.deviceBootTimeout())
+		device, err := virtualdevice.BootSimulator(bootCtx, spec, executor.env.Items())
+		cancel()
+		if err != nil {
+			stop()
+
+			return nil, fmt.Errorf("failed to boot the simulator requested via %s: %w", EnvCirrusSimulator, err)
+		}
+
+		log.Printf("Simulator %s has booted", device.ID)
+
+		devices = append(devices, device)
+		executor.env.Set(EnvCirrusSimulatorUDID, device.ID)
+	}
+
+	if value := executor.env.Get(EnvCirrusEmulator); value != "" {
+		spec, err := virtualdevice.ParseEmulatorSpec(value)
+		if err != nil {
+			stop()
+
+			return nil, fmt.Errorf("failed to parse %s: %w", EnvCirrusEmulator, err)
+		}
+
+		log.Printf("Booting the emulator for %s...", spec.SystemImage)
+
+		bootCtx, cancel := context.WithTimeout(ctx, executor.deviceBootTimeout())
+		device, err := virtualdevice.BootEmulator(bootCtx, spec, executor.env.Items())
+		cancel()
+		if err != nil {
+			stop()
+
+			return nil, fmt.Errorf("failed to boot the emulator requested via %s: %w", EnvCirrusEmulator, err)
+		}
+
+		log.Printf("Emulator %s has booted", device.ID)
+
+		devices = append(devices, device)
+		executor.env.Set(EnvCirrusEmulatorSerial, device.ID)
+		executor.env.Set("ANDROID_SERIAL", device.ID)
+	}
+
+	return stop, nil
+}
+
+func (executor *Executor) deviceBootTimeout() time.Duration {
+	timeout, err := strconv.Atoi(executor.env.Get(EnvCirrusDeviceBootTimeout))
+	if err != nil || timeout <= 0 {
+		return defaultDeviceBootTimeout
+	}
+
+	return time.Duration(timeout) * time.Second
+}
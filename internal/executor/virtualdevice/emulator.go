@@ -0,0 +1,184 @@
+package virtualdevice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	avdName       = "cirrus"
+	emulatorPort  = 5554
+	defaultDevice = "pixel"
+)
+
+var ErrInvalidEmulatorSpec = errors.New("expected the system image package optionally followed " +
+	"by a comma and the device profile (e.g. \"system-images;android-33;google_apis;x86_64, pixel_6\")")
+
+// EmulatorSpec describes the Android Virtual Device to create.
+type EmulatorSpec struct {
+	SystemImage string
+	Device      string
+}
+
+// ParseEmulatorSpec parses the "<system image>[,<device>]" specification.
+func ParseEmulatorSpec(s string) (EmulatorSpec, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) > 2 {
+		return EmulatorSpec{}, ErrInvalidEmulatorSpec
+	}
+
+	spec := EmulatorSpec{SystemImage: strings.TrimSpace(parts[0]), Device: defaultDevice}
+	if !strings.HasPrefix(spec.SystemImage, "system-images;") {
+		return EmulatorSpec{}, ErrInvalidEmulatorSpec
+	}
+
+	if len(parts) == 2 {
+		if device := strings.TrimSpace(parts[1]); device != "" {
+			spec.Device = device
+		}
+	}
+
+	return spec, nil
+}
+
+// sdkTool returns the path of the Android SDK tool, preferring the SDK pointed to by the environment.
+func sdkTool(env map[string]string, subdirs []string, name string) string {
+	for _, key := range []string{"ANDROID_HOME", "ANDROID_SDK_ROOT"} {
+		root, ok := env[key]
+		if !ok {
+			root, ok = os.LookupEnv(key)
+		}
+		if !ok || root == "" {
+			continue
+		}
+
+		for _, subdir := range subdirs {
+			candidate := filepath.Join(root, subdir, name)
+
+			if _, err := os.Stat(candidate); err == nil {
+				return candidate
+			}
+		}
+	}
+
+	return name
+}
+
+// lockedBuffer collects the emulator's output for the diagnostics.
+type lockedBuffer struct {
+	mtx sync.Mutex
+	buf bytes.Buffer
+}
+
+func (lb *lockedBuffer) Write(p []byte) (int, error) {
+	lb.mtx.Lock()
+	defer lb.mtx.Unlock()
+
+	return lb.buf.Write(p)
+}
+
+func (lb *lockedBuffer) String() string {
+	lb.mtx.Lock()
+	defer lb.mtx.Unlock()
+
+	return lb.buf.String()
+}
+
+// BootEmulator creates a new Android Virtual Device, starts a headless emulator
+// for it and waits for the Android to report that the boot has completed.
+func BootEmulator(ctx context.Context, spec EmulatorSpec, env map[string]string) (*Device, error) {
+	runner := newRunner(env)
+
+	avdmanager := sdkTool(env, []string{filepath.Join("cmdline-tools", "latest", "bin"), filepath.Join("tools", "bin")},
+		"avdmanager")
+	emulator := sdkTool(env, []string{"emulator"}, "emulator")
+	adb := sdkTool(env, []string{"platform-tools"}, "adb")
+
+	create := runner.command(ctx, avdmanager, "create", "avd", "--force", "--name", avdName,
+		"--package", spec.SystemImage, "--device", spec.Device)
+	// Answer "no" to the "Do you wish to create a custom hardware profile?" question
+	create.Stdin = strings.NewReader("no\n")
+	if output, err := create.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("failed to create the Android Virtual Device: %w: %s",
+			err, tail(strings.TrimSpace(string(output))))
+	}
+
+	serial := fmt.Sprintf("emulator-%d", emulatorPort)
+
+	// The emulator outlives the boot context, so it's bound to the background one and stopped explicitly
+	var output lockedBuffer
+
+	cmd := runner.command(context.Background(), emulator, "-avd", avdName, "-port", fmt.Sprint(emulatorPort),
+		"-no-window", "-no-audio", "-no-boot-anim", "-no-snapshot")
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	if err := cmd.Start(); err != nil {
+		_, _ = runner.run(context.Background(), avdmanager, "delete", "avd", "--name", avdName)
+
+		return nil, fmt.Errorf("failed to start the emulator: %w", err)
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(exited)
+	}()
+
+	device := &Device{
+		ID: serial,
+		shutdown: func(ctx context.Context) error {
+			_, _ = runner.run(ctx, adb, "-s", serial, "emu", "kill")
+
+			select {
+			case <-exited:
+			case <-ctx.Done():
+				_ = cmd.Process.Kill()
+				<-exited
+			}
+
+			_, err := runner.run(ctx, avdmanager, "delete", "avd", "--name", avdName)
+
+			return err
+		},
+	}
+
+	err := waitFor(ctx, pollInterval, func(ctx context.Context) bool {
+		select {
+		case <-exited:
+			return true
+		default:
+		}
+
+		bootCompleted, err := runner.run(ctx, adb, "-s", serial, "shell", "getprop", "sys.boot_completed")
+
+		return err == nil && bootCompleted == "1"
+	})
+
+	select {
+	case <-exited:
+		err = errors.New("the emulator has exited unexpectedly")
+	default:
+	}
+
+	if err != nil {
+		diagnostics := output.String()
+		if devices, devicesErr := runner.run(context.Background(), adb, "devices", "-l"); devicesErr == nil {
+			diagnostics += "\n" + devices
+		}
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = device.Shutdown(shutdownCtx)
+
+		return nil, &BootError{Err: err, Diagnostics: diagnostics}
+	}
+
+	return device, nil
+}
@@ -0,0 +1,93 @@
+package virtualdevice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidSimulatorSpec = errors.New("expected the simulator device type optionally followed " +
+	"by a comma and the runtime (e.g. \"iPhone 15\" or \"iPhone 15, iOS-17-0\")")
+
+// SimulatorSpec describes the simulator to create.
+type SimulatorSpec struct {
+	DeviceType string
+	Runtime    string
+}
+
+// ParseSimulatorSpec parses the "<device type>[,<runtime>]" specification.
+func ParseSimulatorSpec(s string) (SimulatorSpec, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) > 2 {
+		return SimulatorSpec{}, ErrInvalidSimulatorSpec
+	}
+
+	spec := SimulatorSpec{DeviceType: strings.TrimSpace(parts[0])}
+	if spec.DeviceType == "" {
+		return SimulatorSpec{}, ErrInvalidSimulatorSpec
+	}
+
+	if len(parts) == 2 {
+		spec.Runtime = strings.TrimSpace(parts[1])
+	}
+
+	return spec, nil
+}
+
+// BootSimulator creates a new iOS simulator and waits for it to finish booting.
+func BootSimulator(ctx context.Context, spec SimulatorSpec, env map[string]string) (*Device, error) {
+	runner := newRunner(env)
+
+	args := []string{"simctl", "create", "cirrus-" + strings.ReplaceAll(spec.DeviceType, " ", "-"), spec.DeviceType}
+	if spec.Runtime != "" {
+		args = append(args, spec.Runtime)
+	}
+
+	udid, err := runner.run(ctx, "xcrun", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the simulator: %w", err)
+	}
+
+	device := &Device{
+		ID: udid,
+		shutdown: func(ctx context.Context) error {
+			_, _ = runner.run(ctx, "xcrun", "simctl", "shutdown", udid)
+			_, err := runner.run(ctx, "xcrun", "simctl", "delete", udid)
+
+			return err
+		},
+	}
+
+	// "bootstatus -b" boots the simulator and blocks until all the system services are up
+	if _, err := runner.run(ctx, "xcrun", "simctl", "bootstatus", udid, "-b"); err != nil {
+		if ctx.Err() != nil {
+			err = ErrBootTimeout
+		}
+
+		bootErr := &BootError{Err: err, Diagnostics: simulatorDiagnostics(runner, udid)}
+
+		_ = device.Shutdown(context.Background())
+
+		return nil, bootErr
+	}
+
+	return device, nil
+}
+
+func simulatorDiagnostics(runner *runner, udid string) string {
+	ctx := context.Background()
+
+	var sb strings.Builder
+
+	if output, err := runner.run(ctx, "xcrun", "simctl", "list", "devices", udid); err == nil {
+		sb.WriteString(output + "\n")
+	}
+
+	if output, err := runner.run(ctx, "xcrun", "simctl", "spawn", udid, "log", "show",
+		"--last", "2m", "--style", "compact"); err == nil {
+		sb.WriteString(output + "\n")
+	}
+
+	return sb.String()
+}
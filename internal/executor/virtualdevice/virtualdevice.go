@@ -0,0 +1,113 @@
+// Package virtualdevice boots the iOS simulators and Android emulators for the mobile
+// UI tests, waiting until they're fully booted and collecting diagnostics when they don't.
+package virtualdevice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+const (
+	pollInterval    = 2 * time.Second
+	shutdownTimeout = 30 * time.Second
+	maxTail         = 4096
+)
+
+var ErrBootTimeout = errors.New("timed out waiting for the device to boot")
+
+type Device struct {
+	// ID is the simulator's UDID or the emulator's ADB serial
+	ID string
+
+	shutdown func(ctx context.Context) error
+}
+
+// Shutdown stops and deletes the device.
+func (device *Device) Shutdown(ctx context.Context) error {
+	return device.shutdown(ctx)
+}
+
+// BootError carries the diagnostics collected after the device failed to boot.
+type BootError struct {
+	Err         error
+	Diagnostics string
+}
+
+func (bootErr *BootError) Error() string {
+	if bootErr.Diagnostics == "" {
+		return bootErr.Err.Error()
+	}
+
+	return fmt.Sprintf("%v, diagnostics:\n%s", bootErr.Err, tail(bootErr.Diagnostics))
+}
+
+func (bootErr *BootError) Unwrap() error {
+	return bootErr.Err
+}
+
+// waitFor polls the condition until it's satisfied or the context is done.
+func waitFor(ctx context.Context, interval time.Duration, condition func(ctx context.Context) bool) error {
+	for {
+		if condition(ctx) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return ErrBootTimeout
+			}
+
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+type runner struct {
+	env []string
+}
+
+func newRunner(env map[string]string) *runner {
+	result := &runner{env: os.Environ()}
+
+	for key, value := range env {
+		result.env = append(result.env, key+"="+value)
+	}
+
+	return result
+}
+
+func (runner *runner) command(ctx context.Context, name string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = runner.env
+
+	return cmd
+}
+
+func (runner *runner) run(ctx context.Context, name string, args ...string) (string, error) {
+	output, err := runner.command(ctx, name, args...).CombinedOutput()
+	if err != nil {
+		message := strings.TrimSpace(string(output))
+		if message == "" {
+			return "", fmt.Errorf("%s %s: %w", name, strings.Join(args, " "), err)
+		}
+
+		return "", fmt.Errorf("%s %s: %w: %s", name, strings.Join(args, " "), err, tail(message))
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func tail(s string) string {
+	if len(s) > maxTail {
+		return "..." + s[len(s)-maxTail:]
+	}
+
+	return s
+}
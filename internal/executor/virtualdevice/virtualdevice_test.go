@@ -0,0 +1,63 @@
+package virtualdevice
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseSimulatorSpec(t *testing.T) {
+	spec, err := ParseSimulatorSpec("iPhone 15")
+	require.NoError(t, err)
+	assert.Equal(t, SimulatorSpec{DeviceType: "iPhone 15"}, spec)
+
+	spec, err = ParseSimulatorSpec("iPhone 15, iOS-17-0")
+	require.NoError(t, err)
+	assert.Equal(t, SimulatorSpec{DeviceType: "iPhone 15", Runtime: "iOS-17-0"}, spec)
+
+	_, err = ParseSimulatorSpec(" ")
+	require.ErrorIs(t, err, ErrInvalidSimulatorSpec)
+}
+
+func TestParseEmulatorSpec(t *testing.T) {
+	spec, err := ParseEmulatorSpec("system-images;android-33;google_apis;x86_64")
+	require.NoError(t, err)
+	assert.Equal(t, EmulatorSpec{SystemImage: "system-images;android-33;google_apis;x86_64", Device: "pixel"}, spec)
+
+	spec, err = ParseEmulatorSpec("system-images;android-33;google_apis;arm64-v8a, pixel_6")
+	require.NoError(t, err)
+	assert.Equal(t, "pixel_6", spec.Device)
+
+	_, err = ParseEmulatorSpec("android-33")
+	require.ErrorIs(t, err, ErrInvalidEmulatorSpec)
+}
+
+func TestWaitFor(t *testing.T) {
+	var attempts int
+
+	err := waitFor(context.Background(), time.Millisecond, func(ctx context.Context) bool {
+		attempts++
+
+		return attempts == 3
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = waitFor(ctx, time.Millisecond, func(ctx context.Context) bool {
+		return false
+	})
+	require.ErrorIs(t, err, ErrBootTimeout)
+}
+
+func TestBootError(t *testing.T) {
+	err := error(&BootError{Err: ErrBootTimeout, Diagnostics: "emulator: PANIC: Missing emulator engine"})
+
+	assert.True(t, errors.Is(err, ErrBootTimeout))
+	assert.Contains(t, err.Error(), "Missing emulator engine")
+}
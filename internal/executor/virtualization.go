@@ -0,0 +1,64 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/virtualization"
+	"log"
+)
+
+const (
+	// EnvCirrusVirtualizationProbe set to "false" disables the detection of the hardware
+	// virtualization support that's otherwise logged and reported as an annotation.
+	EnvCirrusVirtualizationProbe = "CIRRUS_VIRTUALIZATION_PROBE"
+
+	// EnvCirrusHardwareVirtualization is exported to the scripts with the name of the available
+	// hypervisor ("kvm", "hvf" or "hyperv") or "none" when it's not available.
+	EnvCirrusHardwareVirtualization = "CIRRUS_HARDWARE_VIRTUALIZATION"
+)
+
+// reportVirtualization lets the users immediately tell why their VM-based tests
+// fail on a particular pool.
+func (executor *Executor) reportVirtualization(ctx context.Context) {
+	if executor.env.Get(EnvCirrusVirtualizationProbe) == "false" {
+		return
+	}
+
+	report := virtualization.Probe()
+
+	log.Printf("Virtualization: %s", report)
+
+	if report.Hypervisor == "" {
+		return
+	}
+
+	if report.Available {
+		executor.env.Set(EnvCirrusHardwareVirtualization, report.Hypervisor)
+	} else {
+		executor.env.Set(EnvCirrusHardwareVirtualization, "none")
+	}
+
+	rawDetails, err := json.Marshal(report)
+	if err != nil {
+		log.Printf("Failed to serialize the virtualization report: %v", err)
+
+		return
+	}
+
+	_, err = client.CirrusClient.ReportAnnotations(ctx, &api.ReportAnnotationsCommandRequest{
+		TaskIdentification: executor.taskIdentification,
+		Annotations: []*api.Annotation{
+			{
+				Type:       api.Annotation_GENERIC,
+				Level:      api.Annotation_NOTICE,
+				Message:    "Runner: " + report.String(),
+				RawDetails: string(rawDetails),
+			},
+		},
+	})
+	if err != nil {
+		log.Printf("Failed to report the virtualization support: %v", err)
+	}
+}
@@ -0,0 +1,38 @@
+// Package virtualization detects whether the runner is able to run the hardware-accelerated
+// virtual machines (KVM on Linux, Hypervisor.framework on macOS and Hyper-V on Windows),
+// which is what the VM-based tests (e.g. the Android emulator or QEMU) rely on.
+package virtualization
+
+import "fmt"
+
+const (
+	HypervisorKVM    = "kvm"
+	HypervisorHVF    = "hvf"
+	HypervisorHyperV = "hyperv"
+)
+
+type Report struct {
+	// Hypervisor is the hardware virtualization API probed on this platform, if any
+	Hypervisor string `json:"hypervisor,omitempty"`
+
+	Available bool `json:"available"`
+
+	// Reason explains why the virtualization is not available
+	Reason string `json:"reason,omitempty"`
+}
+
+// Probe detects the hardware virtualization support on the current platform.
+func Probe() *Report {
+	return probe()
+}
+
+func (report *Report) String() string {
+	switch {
+	case report.Hypervisor == "":
+		return "hardware virtualization support detection is not implemented on this platform"
+	case report.Available:
+		return fmt.Sprintf("hardware virtualization is available (%s)", report.Hypervisor)
+	default:
+		return fmt.Sprintf("hardware virtualization is not available (%s): %s", report.Hypervisor, report.Reason)
+	}
+}
@@ -0,0 +1,25 @@
+package virtualization
+
+import "golang.org/x/sys/unix"
+
+func probe() *Report {
+	report := &Report{Hypervisor: HypervisorHVF}
+
+	support, err := unix.SysctlUint32("kern.hv_support")
+	if err != nil {
+		report.Reason = "failed to query kern.hv_support: " + err.Error()
+
+		return report
+	}
+
+	if support != 1 {
+		report.Reason = "kern.hv_support is 0, the Mac (or the VM the agent runs in) " +
+			"does not support the Hypervisor.framework"
+
+		return report
+	}
+
+	report.Available = true
+
+	return report
+}
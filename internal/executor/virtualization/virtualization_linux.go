@@ -0,0 +1,76 @@
+package virtualization
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+)
+
+func probe() *Report {
+	return probeKVM("/dev/kvm", "/proc/cpuinfo")
+}
+
+func probeKVM(devicePath string, cpuinfoPath string) *Report {
+	report := &Report{Hypervisor: HypervisorKVM}
+
+	device, err := os.OpenFile(devicePath, os.O_RDWR, 0)
+	if err == nil {
+		_ = device.Close()
+		report.Available = true
+
+		return report
+	}
+
+	switch {
+	case errors.Is(err, os.ErrPermission):
+		report.Reason = devicePath + " is not accessible to the agent's user, " +
+			"consider adding it to the \"kvm\" group"
+	case errors.Is(err, os.ErrNotExist):
+		if hasVirtualizationExtensions(cpuinfoPath) {
+			report.Reason = devicePath + " does not exist, the KVM module is probably not loaded " +
+				"or the device is not passed to the container"
+		} else {
+			report.Reason = devicePath + " does not exist and the CPU does not expose the virtualization " +
+				"extensions (vmx/svm), nested virtualization is probably disabled on the host"
+		}
+	default:
+		report.Reason = err.Error()
+	}
+
+	return report
+}
+
+func hasVirtualizationExtensions(cpuinfoPath string) bool {
+	file, err := os.Open(cpuinfoPath)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		key, value, found := cutLine(scanner.Text())
+		if !found || key != "flags" {
+			continue
+		}
+
+		for _, flag := range strings.Fields(value) {
+			if flag == "vmx" || flag == "svm" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func cutLine(line string) (string, string, bool) {
+	idx := strings.Index(line, ":")
+	if idx == -1 {
+		return "", "", false
+	}
+
+	return strings.TrimSpace(line[:idx]), line[idx+1:], true
+}
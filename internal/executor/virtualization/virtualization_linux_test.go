@@ -0,0 +1,36 @@
+package virtualization
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProbeKVM(t *testing.T) {
+	dir := t.TempDir()
+	devicePath := filepath.Join(dir, "kvm")
+	cpuinfoPath := filepath.Join(dir, "cpuinfo")
+
+	// No device and no virtualization extensions
+	require.NoError(t, os.WriteFile(cpuinfoPath, []byte("processor\t: 0\nflags\t\t: fpu sse2 hypervisor\n"), 0600))
+
+	report := probeKVM(devicePath, cpuinfoPath)
+	assert.False(t, report.Available)
+	assert.Contains(t, report.Reason, "nested virtualization")
+
+	// No device, but the CPU supports virtualization
+	require.NoError(t, os.WriteFile(cpuinfoPath, []byte("processor\t: 0\nflags\t\t: fpu vmx sse2\n"), 0600))
+
+	report = probeKVM(devicePath, cpuinfoPath)
+	assert.False(t, report.Available)
+	assert.Contains(t, report.Reason, "KVM module")
+
+	// Device is present
+	require.NoError(t, os.WriteFile(devicePath, []byte{}, 0600))
+
+	report = probeKVM(devicePath, cpuinfoPath)
+	assert.True(t, report.Available)
+	assert.Equal(t, "hardware virtualization is available (kvm)", report.String())
+}
@@ -0,0 +1,49 @@
+package virtualization
+
+import (
+	"fmt"
+	"golang.org/x/sys/windows"
+	"unsafe"
+)
+
+// WHvCapabilityCodeHypervisorPresent from the Windows Hypervisor Platform API
+const capabilityCodeHypervisorPresent = 0
+
+func probe() *Report {
+	report := &Report{Hypervisor: HypervisorHyperV}
+
+	dll := windows.NewLazySystemDLL("WinHvPlatform.dll")
+	proc := dll.NewProc("WHvGetCapability")
+
+	if err := proc.Find(); err != nil {
+		report.Reason = "the Windows Hypervisor Platform feature is not enabled"
+
+		return report
+	}
+
+	var present uint32
+	var written uint32
+
+	hr, _, _ := proc.Call(
+		capabilityCodeHypervisorPresent,
+		uintptr(unsafe.Pointer(&present)),
+		unsafe.Sizeof(present),
+		uintptr(unsafe.Pointer(&written)),
+	)
+	if hr != 0 {
+		report.Reason = fmt.Sprintf("WHvGetCapability failed with HRESULT 0x%08x", uint32(hr))
+
+		return report
+	}
+
+	if present == 0 {
+		report.Reason = "the hypervisor is not running, check that the virtualization is enabled " +
+			"in the firmware (or exposed to the VM the agent runs in)"
+
+		return report
+	}
+
+	report.Available = true
+
+	return report
+}
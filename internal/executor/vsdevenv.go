@@ -0,0 +1,54 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vsdevenv"
+	"log"
+	"os"
+	"strings"
+)
+
+// EnvCirrusVSDevEnv set to "true" loads the Visual Studio developer environment for the x64
+// architecture before running the scripts, otherwise it's treated as the vcvarsall.bat arguments
+// (e.g. "x86" or "x64_arm64 10.0.19041.0").
+const EnvCirrusVSDevEnv = "CIRRUS_VS_DEVENV"
+
+// loadVSDevEnv merges the Visual Studio developer environment (if requested) into the task's environment.
+func (executor *Executor) loadVSDevEnv(ctx context.Context) error {
+	spec, ok := executor.env.Lookup(EnvCirrusVSDevEnv)
+	if !ok || spec == "" || spec == "false" {
+		return nil
+	}
+
+	args, err := vsdevenv.Arguments(spec)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", EnvCirrusVSDevEnv, err)
+	}
+
+	log.Printf("Loading the Visual Studio developer environment (%s)...", strings.Join(args, " "))
+
+	base := map[string]string{}
+
+	for _, item := range os.Environ() {
+		if sep := strings.Index(item, "="); sep > 0 {
+			base[item[:sep]] = item[sep+1:]
+		}
+	}
+
+	for key, value := range executor.env.Items() {
+		base[key] = value
+	}
+
+	changes, err := vsdevenv.Load(ctx, args, base)
+	if err != nil {
+		return fmt.Errorf("failed to load the Visual Studio developer environment requested via %s: %w",
+			EnvCirrusVSDevEnv, err)
+	}
+
+	executor.env.Merge(changes, false)
+
+	log.Printf("Loaded %d variables from the Visual Studio developer environment", len(changes))
+
+	return nil
+}
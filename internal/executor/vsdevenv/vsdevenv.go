@@ -0,0 +1,207 @@
+// Package vsdevenv loads the Visual Studio developer environment (the one normally provided
+// by the "Developer Command Prompt") so that the Windows C++ builds can find cl.exe, link.exe,
+// the Windows SDK headers and libraries without wrapping each script in vcvarsall.bat.
+package vsdevenv
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// DefaultArch is the vcvarsall.bat argument used when no specific architecture is requested.
+const DefaultArch = "x64"
+
+// vcToolsComponent is required from the Visual Studio installation to have the vcvarsall.bat.
+const vcToolsComponent = "Microsoft.VisualStudio.Component.VC.Tools.x86.x64"
+
+// marker separates the vcvarsall.bat output from the environment dump that follows it.
+const marker = "==== CIRRUS VS DEVELOPER ENVIRONMENT ===="
+
+var (
+	ErrUnsupported = errors.New("the Visual Studio developer environment is only available on Windows")
+	ErrNotFound    = errors.New("no Visual Studio installation with the C++ build tools was found")
+	ErrInvalidArgs = errors.New("vcvarsall.bat arguments may only contain letters, digits, " +
+		"dots, dashes, underscores and equal signs")
+)
+
+var argumentRegex = regexp.MustCompile(`^[A-Za-z0-9_.=-]+$`)
+
+// Arguments converts the user-provided specification (e.g. "true", "x86" or "x64_arm64 10.0.19041.0")
+// to the vcvarsall.bat arguments, rejecting anything that cmd.exe might interpret.
+func Arguments(spec string) ([]string, error) {
+	fields := strings.Fields(spec)
+
+	if len(fields) == 0 || (len(fields) == 1 && strings.EqualFold(fields[0], "true")) {
+		return []string{DefaultArch}, nil
+	}
+
+	for _, field := range fields {
+		if !argumentRegex.MatchString(field) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidArgs, field)
+		}
+	}
+
+	return fields, nil
+}
+
+// Load locates the latest Visual Studio installation, runs its vcvarsall.bat with the specified
+// arguments on top of the base environment and returns the variables that it added or changed.
+func Load(ctx context.Context, args []string, base map[string]string) (map[string]string, error) {
+	if runtime.GOOS != "windows" {
+		return nil, ErrUnsupported
+	}
+
+	installationPath, err := InstallationPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vcvarsall := filepath.Join(installationPath, "VC", "Auxiliary", "Build", "vcvarsall.bat")
+	if _, err := os.Stat(vcvarsall); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotFound, err)
+	}
+
+	script := fmt.Sprintf("call \"%s\" %s && echo %s && set", vcvarsall, strings.Join(args, " "), marker)
+
+	output, err := runBatch(ctx, script, environ(base))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run vcvarsall.bat: %w%s", err, outputTail(output))
+	}
+
+	after, err := ParseOutput(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return Changes(base, after), nil
+}
+
+// InstallationPath asks vswhere for the latest Visual Studio installation that has the C++ build tools.
+func InstallationPath(ctx context.Context) (string, error) {
+	vswhere, err := vswherePath()
+	if err != nil {
+		return "", err
+	}
+
+	output, err := exec.CommandContext(ctx, vswhere, "-latest", "-products", "*",
+		"-requires", vcToolsComponent, "-property", "installationPath").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to run vswhere: %w", err)
+	}
+
+	installationPath := strings.TrimSpace(string(output))
+	if installationPath == "" {
+		return "", ErrNotFound
+	}
+
+	return installationPath, nil
+}
+
+func vswherePath() (string, error) {
+	if programFiles, ok := os.LookupEnv("ProgramFiles(x86)"); ok {
+		candidate := filepath.Join(programFiles, "Microsoft Visual Studio", "Installer", "vswhere.exe")
+
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	candidate, err := exec.LookPath("vswhere")
+	if err != nil {
+		return "", fmt.Errorf("%w: vswhere is not installed", ErrNotFound)
+	}
+
+	return candidate, nil
+}
+
+// ParseOutput extracts the environment from the "set" output that follows the marker.
+func ParseOutput(output []byte) (map[string]string, error) {
+	idx := bytes.Index(output, []byte(marker))
+	if idx == -1 {
+		return nil, fmt.Errorf("vcvarsall.bat did not complete successfully%s", outputTail(output))
+	}
+
+	result := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(output[idx+len(marker):]))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		// Skip the empty lines and the per-drive current directories (e.g. "=C:=C:\")
+		sep := strings.Index(line, "=")
+		if sep <= 0 {
+			continue
+		}
+
+		result[line[:sep]] = line[sep+1:]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Changes returns the variables from after that are absent or different in before. The names
+// are compared case-insensitively, like Windows does, keeping the spelling used in before.
+func Changes(before map[string]string, after map[string]string) map[string]string {
+	beforeKeys := map[string]string{}
+
+	for key := range before {
+		beforeKeys[strings.ToUpper(key)] = key
+	}
+
+	result := map[string]string{}
+
+	for key, value := range after {
+		beforeKey, ok := beforeKeys[strings.ToUpper(key)]
+		if !ok {
+			result[key] = value
+
+			continue
+		}
+
+		if before[beforeKey] != value {
+			result[beforeKey] = value
+		}
+	}
+
+	return result
+}
+
+func environ(env map[string]string) []string {
+	var result []string
+
+	for key, value := range env {
+		result = append(result, key+"="+value)
+	}
+
+	return result
+}
+
+func outputTail(output []byte) string {
+	const maxTail = 2048
+
+	tail := strings.TrimSpace(string(output))
+	if tail == "" {
+		return ""
+	}
+
+	if len(tail) > maxTail {
+		tail = tail[len(tail)-maxTail:]
+	}
+
+	return ":\n" + tail
+}
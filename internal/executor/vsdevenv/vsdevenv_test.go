@@ -0,0 +1,64 @@
+package vsdevenv_test
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/vsdevenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestArguments(t *testing.T) {
+	args, err := vsdevenv.Arguments("true")
+	require.NoError(t, err)
+	assert.Equal(t, []string{vsdevenv.DefaultArch}, args)
+
+	args, err = vsdevenv.Arguments("x64_arm64 10.0.19041.0 -vcvars_ver=14.29")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"x64_arm64", "10.0.19041.0", "-vcvars_ver=14.29"}, args)
+
+	_, err = vsdevenv.Arguments("x64 & del C:\\")
+	require.ErrorIs(t, err, vsdevenv.ErrInvalidArgs)
+}
+
+func TestParseOutput(t *testing.T) {
+	output := []byte("**********\r\n" +
+		"** Visual Studio 2022 Developer Command Prompt v17.4.0\r\n" +
+		"==== CIRRUS VS DEVELOPER ENVIRONMENT ====\r\n" +
+		"=C:=C:\\work\r\n" +
+		"INCLUDE=C:\\VS\\include;C:\\SDK\\include\r\n" +
+		"Path=C:\\VS\\bin;C:\\Windows\r\n" +
+		"EMPTY=\r\n")
+
+	env, err := vsdevenv.ParseOutput(output)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"INCLUDE": "C:\\VS\\include;C:\\SDK\\include",
+		"Path":    "C:\\VS\\bin;C:\\Windows",
+		"EMPTY":   "",
+	}, env)
+}
+
+func TestParseOutputFailure(t *testing.T) {
+	_, err := vsdevenv.ParseOutput([]byte("[ERROR:vcvarsall.bat] Invalid argument found : x65\r\n"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid argument found")
+}
+
+func TestChanges(t *testing.T) {
+	before := map[string]string{
+		"PATH":     "C:\\Windows",
+		"USERNAME": "cirrus",
+		"LIB":      "C:\\SDK\\lib",
+	}
+	after := map[string]string{
+		"Path":           "C:\\VS\\bin;C:\\Windows",
+		"USERNAME":       "cirrus",
+		"LIB":            "C:\\SDK\\lib",
+		"VCToolsVersion": "14.34.31933",
+	}
+
+	assert.Equal(t, map[string]string{
+		"PATH":           "C:\\VS\\bin;C:\\Windows",
+		"VCToolsVersion": "14.34.31933",
+	}, vsdevenv.Changes(before, after))
+}
@@ -0,0 +1,10 @@
+//go:build !windows
+// +build !windows
+
+package vsdevenv
+
+import "context"
+
+func runBatch(ctx context.Context, script string, env []string) ([]byte, error) {
+	return nil, ErrUnsupported
+}
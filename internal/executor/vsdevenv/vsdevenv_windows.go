@@ -0,0 +1,23 @@
+//go:build windows
+// +build windows
+
+package vsdevenv
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+)
+
+func runBatch(ctx context.Context, script string, env []string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "cmd.exe")
+	cmd.Env = env
+
+	// Go's argument escaping doesn't match the cmd.exe quoting rules,
+	// so pass the command line verbatim
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CmdLine: "cmd.exe /d /s /c \"" + script + "\"",
+	}
+
+	return cmd.CombinedOutput()
+}
@@ -0,0 +1,174 @@
+package executor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/fssnapshot"
+	"io"
+	"log"
+	"os"
+)
+
+// The working directory snapshot modes, see SetWorkingDirSnapshotMode.
+const (
+	WorkingDirSnapshotTask  = "task"
+	WorkingDirSnapshotClone = "clone"
+)
+
+// SetWorkingDirSnapshotMode makes the persistent worker reset the working directory
+// with a btrfs, ZFS or APFS snapshot rollback instead of deleting and re-creating its contents.
+//
+// WorkingDirSnapshotTask captures the working directory before the first task of each repository
+// and rolls it back to that pristine state at the start and at the end of each of the following tasks;
+// WorkingDirSnapshotClone captures the working directory after the clone instruction and rolls it back
+// before a script is re-run (see CIRRUS_RETRY_EXIT_CODES).
+//
+// The mode is a part of the worker's configuration rather than of the task's environment,
+// since the rollbacks discard the data that the worker's owner might want to keep.
+func (executor *Executor) SetWorkingDirSnapshotMode(mode string) error {
+	if mode != "" && mode != WorkingDirSnapshotTask && mode != WorkingDirSnapshotClone {
+		return fmt.Errorf("invalid working directory snapshot mode %q, expected %q or %q",
+			mode, WorkingDirSnapshotTask, WorkingDirSnapshotClone)
+	}
+
+	executor.workingDirSnapshotMode = mode
+
+	return nil
+}
+
+// workingDirSnapshotName distinguishes the snapshots of the same working directory, so that
+// a pristine snapshot is only ever rolled back for the repository it was captured for, and
+// a post-clone snapshot left by a crashed agent is never mistaken for the current task's one.
+func (executor *Executor) workingDirSnapshotName() string {
+	if executor.workingDirSnapshotMode == WorkingDirSnapshotClone {
+		return fmt.Sprintf("clone-%d", executor.taskIdentification.TaskId)
+	}
+
+	repository := executor.env.Get("CIRRUS_REPO_ID")
+	if repository == "" {
+		repository = executor.env.Get("CIRRUS_REPO_FULL_NAME")
+	}
+
+	digest := sha256.Sum256([]byte(repository))
+
+	return "task-" + hex.EncodeToString(digest[:])[:16]
+}
+
+// prepareWorkingDirSnapshot resets the working directory to the pristine snapshot or prepares
+// the snapshotting after the clone (depending on the mode), returning a function that either
+// resets the working directory once again or deletes the post-clone snapshot.
+func (executor *Executor) prepareWorkingDirSnapshot(ctx context.Context) func() {
+	if executor.workingDirSnapshotMode == "" {
+		return func() {}
+	}
+
+	snapshotter, err := fssnapshot.New(ctx, executor.env.Get("CIRRUS_WORKING_DIR"), executor.workingDirSnapshotName())
+	if err != nil {
+		executor.reportWorkingDirSnapshotProblem(ctx, err)
+
+		return func() {}
+	}
+
+	if executor.workingDirSnapshotMode == WorkingDirSnapshotClone {
+		executor.workingDirSnapshot = snapshotter
+
+		return func() {
+			// Use a fresh context since the task's one might be already cancelled
+			err := snapshotter.Delete(context.Background())
+			if err != nil && !errors.Is(err, fssnapshot.ErrNotExist) {
+				log.Printf("Failed to delete the working directory snapshot: %v", err)
+			}
+		}
+	}
+
+	exists, err := snapshotter.Exists(ctx)
+	if err != nil {
+		executor.reportWorkingDirSnapshotProblem(ctx, err)
+
+		return func() {}
+	}
+
+	if exists {
+		// The previous task has most likely reset the working directory already,
+		// unless its agent was killed before it had a chance to do so
+		if err := executor.rollbackWorkingDir(ctx, snapshotter); err != nil {
+			executor.reportWorkingDirSnapshotProblem(ctx, err)
+
+			return func() {}
+		}
+
+		log.Printf("Reset the working directory to the pristine %s snapshot", snapshotter.Filesystem())
+	} else {
+		if err := snapshotter.Create(ctx); err != nil {
+			executor.reportWorkingDirSnapshotProblem(ctx, err)
+
+			return func() {}
+		}
+
+		log.Printf("Captured the pristine working directory in a %s snapshot", snapshotter.Filesystem())
+	}
+
+	return func() {
+		// Use a fresh context since the task's one might be already cancelled
+		if err := executor.rollbackWorkingDir(context.Background(), snapshotter); err != nil {
+			log.Printf("Failed to reset the working directory after the task: %v", err)
+		}
+	}
+}
+
+// snapshotWorkingDirAfterClone captures the freshly cloned working directory (if requested).
+func (executor *Executor) snapshotWorkingDirAfterClone(ctx context.Context, logs io.Writer) {
+	if executor.workingDirSnapshot == nil {
+		return
+	}
+
+	if err := executor.workingDirSnapshot.Create(ctx); err != nil {
+		_, _ = fmt.Fprintf(logs, "\nFailed to snapshot the working directory: %v\n", err)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(logs, "\nCaptured the working directory in a %s snapshot\n",
+		executor.workingDirSnapshot.Filesystem())
+}
+
+// resetWorkingDirBeforeRetry rolls the working directory back to the post-clone
+// snapshot (if one was captured) so that the re-run starts from a clean state.
+func (executor *Executor) resetWorkingDirBeforeRetry(ctx context.Context, logs io.Writer) {
+	if executor.workingDirSnapshot == nil {
+		return
+	}
+
+	err := executor.rollbackWorkingDir(ctx, executor.workingDirSnapshot)
+	if errors.Is(err, fssnapshot.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(logs, "Failed to reset the working directory: %v\n", err)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(logs, "Reset the working directory to the post-clone %s snapshot\n",
+		executor.workingDirSnapshot.Filesystem())
+}
+
+func (executor *Executor) rollbackWorkingDir(ctx context.Context, snapshotter fssnapshot.Snapshotter) error {
+	if err := snapshotter.Rollback(ctx); err != nil {
+		return err
+	}
+
+	// The working directory might have been re-created
+	return os.Chdir(executor.env.Get("CIRRUS_WORKING_DIR"))
+}
+
+func (executor *Executor) reportWorkingDirSnapshotProblem(ctx context.Context, err error) {
+	message := fmt.Sprintf("Failed to use the %s working directory snapshot: %v",
+		executor.workingDirSnapshotMode, err)
+	log.Println(message)
+	executor.reportWarning(ctx, client.ProblemStorage, message)
+}
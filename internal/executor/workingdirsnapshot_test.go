@@ -0,0 +1,26 @@
+package executor
+
+import (
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestWorkingDirSnapshotName(t *testing.T) {
+	executor := NewExecutor(42, "", "", "", "", "")
+	require.NoError(t, executor.SetWorkingDirSnapshotMode(WorkingDirSnapshotTask))
+	require.Error(t, executor.SetWorkingDirSnapshotMode("always"))
+
+	executor.env = environment.New(map[string]string{"CIRRUS_REPO_ID": "1"})
+	first := executor.workingDirSnapshotName()
+
+	executor.env = environment.New(map[string]string{"CIRRUS_REPO_ID": "2"})
+	second := executor.workingDirSnapshotName()
+
+	// The pristine snapshots are never shared between the repositories
+	assert.NotEqual(t, first, second)
+
+	require.NoError(t, executor.SetWorkingDirSnapshotMode(WorkingDirSnapshotClone))
+	assert.Equal(t, "clone-42", executor.workingDirSnapshotName())
+}
@@ -0,0 +1,28 @@
+package grpccompression
+
+import (
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	Gzip = gzip.Name
+	None = "none"
+)
+
+// CallOptions returns the gRPC call options that enable the specified compression,
+// an empty compression name results in the default (gzip) compression.
+func CallOptions(compression string) ([]grpc.CallOption, error) {
+	switch compression {
+	case "", Gzip:
+		return []grpc.CallOption{grpc.UseCompressor(gzip.Name)}, nil
+	case Zstd:
+		return []grpc.CallOption{grpc.UseCompressor(Zstd)}, nil
+	case None:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression %q, supported compressions are %s, %s and %s",
+			compression, Gzip, Zstd, None)
+	}
+}
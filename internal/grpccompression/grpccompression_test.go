@@ -0,0 +1,48 @@
+package grpccompression_test
+
+import (
+	"bytes"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/grpccompression"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestZstdRoundTrip(t *testing.T) {
+	compressor := encoding.GetCompressor(grpccompression.Zstd)
+	require.NotNil(t, compressor)
+
+	data := []byte(strings.Repeat("Downloading dependencies...\n", 1000))
+
+	var buf bytes.Buffer
+
+	writer, err := compressor.Compress(&buf)
+	require.NoError(t, err)
+	_, err = writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	require.Less(t, buf.Len(), len(data))
+
+	reader, err := compressor.Decompress(&buf)
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, data, decompressed)
+}
+
+func TestCallOptions(t *testing.T) {
+	for _, compression := range []string{"", grpccompression.Gzip, grpccompression.Zstd} {
+		opts, err := grpccompression.CallOptions(compression)
+		require.NoError(t, err)
+		require.Len(t, opts, 1)
+	}
+
+	opts, err := grpccompression.CallOptions(grpccompression.None)
+	require.NoError(t, err)
+	require.Empty(t, opts)
+
+	_, err = grpccompression.CallOptions("brotli")
+	require.Error(t, err)
+}
@@ -0,0 +1,35 @@
+package grpccompression
+
+import (
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+	"io"
+)
+
+// Zstd is the name registered for the zstd compressor
+const Zstd = "zstd"
+
+func init() {
+	encoding.RegisterCompressor(&zstdCompressor{})
+}
+
+type zstdCompressor struct{}
+
+func (compressor *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+}
+
+func (compressor *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	// Concurrency of 1 makes the decoder synchronous, so it doesn't
+	// leak goroutines when the gRPC doesn't close the returned reader
+	decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+
+	return decoder.IOReadCloser(), nil
+}
+
+func (compressor *zstdCompressor) Name() string {
+	return Zstd
+}
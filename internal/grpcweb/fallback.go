@@ -0,0 +1,119 @@
+package grpcweb
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"sync"
+)
+
+// FallbackThreshold is the number of consecutive Unavailable errors
+// on the primary transport after which Fallback switches to gRPC-Web.
+const FallbackThreshold = 3
+
+// Fallback is a grpc.ClientConnInterface that uses the primary transport
+// until it keeps failing with Unavailable errors and then permanently
+// switches to the fallback transport.
+type Fallback struct {
+	primary  grpc.ClientConnInterface
+	fallback grpc.ClientConnInterface
+
+	mu       sync.Mutex
+	failures int
+	active   bool
+}
+
+func NewFallback(primary grpc.ClientConnInterface, fallback grpc.ClientConnInterface) *Fallback {
+	return &Fallback{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+// Activate switches to the fallback transport right away.
+func (fallback *Fallback) Activate() {
+	fallback.mu.Lock()
+	defer fallback.mu.Unlock()
+
+	fallback.activateLocked()
+}
+
+func (fallback *Fallback) Active() bool {
+	fallback.mu.Lock()
+	defer fallback.mu.Unlock()
+
+	return fallback.active
+}
+
+func (fallback *Fallback) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	if fallback.Active() {
+		return fallback.fallback.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	err := fallback.primary.Invoke(ctx, method, args, reply, opts...)
+	if fallback.observe(err) {
+		// Retry on the fallback transport right away, the idempotency key (if any)
+		// travels with the ctx, so the server can de-duplicate the call
+		return fallback.fallback.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	return err
+}
+
+func (fallback *Fallback) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if fallback.Active() {
+		return fallback.fallback.NewStream(ctx, desc, method, opts...)
+	}
+
+	stream, err := fallback.primary.NewStream(ctx, desc, method, opts...)
+	if fallback.observe(err) {
+		return fallback.fallback.NewStream(ctx, desc, method, opts...)
+	}
+
+	return stream, err
+}
+
+// observe accounts the result of the call made over the primary transport,
+// returning true if it caused the switch to the fallback transport.
+func (fallback *Fallback) observe(err error) bool {
+	fallback.mu.Lock()
+	defer fallback.mu.Unlock()
+
+	if status.Code(err) != codes.Unavailable {
+		fallback.failures = 0
+
+		return false
+	}
+
+	fallback.failures++
+
+	if fallback.failures < FallbackThreshold {
+		return false
+	}
+
+	fallback.activateLocked()
+
+	return true
+}
+
+func (fallback *Fallback) activateLocked() {
+	if fallback.active {
+		return
+	}
+	fallback.active = true
+
+	log.Printf("The API is unavailable over HTTP/2 gRPC, falling back to gRPC-Web via %v", fallback.fallback)
+}
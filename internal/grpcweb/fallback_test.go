@@ -0,0 +1,74 @@
+package grpcweb
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+type fakeConn struct {
+	err   error
+	calls int
+}
+
+func (conn *fakeConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	conn.calls++
+
+	return conn.err
+}
+
+func (conn *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	conn.calls++
+
+	return nil, conn.err
+}
+
+func (conn *fakeConn) String() string {
+	return "fake"
+}
+
+func TestFallbackSwitchesAfterRepeatedUnavailable(t *testing.T) {
+	primary := &fakeConn{err: status.Error(codes.Unavailable, "blocked")}
+	secondary := &fakeConn{}
+
+	fallback := NewFallback(primary, secondary)
+
+	for i := 1; i < FallbackThreshold; i++ {
+		require.Error(t, fallback.Invoke(context.Background(), "/test", nil, nil))
+		require.False(t, fallback.Active())
+	}
+
+	// The call that crosses the threshold is retried over the fallback transport
+	require.NoError(t, fallback.Invoke(context.Background(), "/test", nil, nil))
+	require.True(t, fallback.Active())
+	assert.Equal(t, 1, secondary.calls)
+
+	_, err := fallback.NewStream(context.Background(), &grpc.StreamDesc{}, "/test")
+	require.NoError(t, err)
+	assert.Equal(t, FallbackThreshold, primary.calls)
+	assert.Equal(t, 2, secondary.calls)
+}
+
+func TestFallbackResetsOnOtherErrors(t *testing.T) {
+	primary := &fakeConn{}
+	secondary := &fakeConn{}
+
+	fallback := NewFallback(primary, secondary)
+
+	for i := 0; i < FallbackThreshold*2; i++ {
+		if i%2 == 0 {
+			primary.err = status.Error(codes.Unavailable, "blip")
+		} else {
+			primary.err = status.Error(codes.NotFound, "not found")
+		}
+
+		_ = fallback.Invoke(context.Background(), "/test", nil, nil)
+	}
+
+	assert.False(t, fallback.Active())
+	assert.Zero(t, secondary.calls)
+}
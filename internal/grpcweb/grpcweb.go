@@ -0,0 +1,441 @@
+// Package grpcweb implements a gRPC-Web client transport that works over plain HTTP/1.1,
+// which is useful when the raw HTTP/2 gRPC is blocked by middleboxes.
+//
+// Since gRPC-Web has no notion of client-side streaming, the client-streaming RPCs are
+// buffered until CloseSend() and then sent as a single request with multiple messages.
+package grpcweb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	contentType = "application/grpc-web+proto"
+
+	frameData     = 0x00
+	frameTrailer  = 0x80
+	frameCompress = 0x01
+
+	maxFrameSize = 64 * 1024 * 1024
+)
+
+// Conn is a grpc.ClientConnInterface implementation that speaks gRPC-Web.
+type Conn struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// EndpointFor derives the gRPC-Web endpoint from the agent's API endpoint,
+// returning an empty string when there's no sensible gRPC-Web counterpart.
+func EndpointFor(apiEndpoint string) string {
+	switch {
+	case strings.HasPrefix(apiEndpoint, "unix:"):
+		return ""
+	case strings.HasPrefix(apiEndpoint, "http://"), strings.HasPrefix(apiEndpoint, "https://"):
+		return apiEndpoint
+	default:
+		return "https://" + apiEndpoint
+	}
+}
+
+func New(endpoint string, httpClient *http.Client) *Conn {
+	return &Conn{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: httpClient,
+	}
+}
+
+func (conn *Conn) String() string {
+	return conn.endpoint
+}
+
+func (conn *Conn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{}, method, opts...)
+	if err != nil {
+		return err
+	}
+
+	if err := stream.SendMsg(args); err != nil {
+		return err
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+
+	err = stream.RecvMsg(reply)
+
+	// Support grpc.Header() and grpc.Trailer() like grpc-go does
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case grpc.HeaderCallOption:
+			*opt.HeaderAddr = stream.(*clientStream).header
+		case grpc.TrailerCallOption:
+			*opt.TrailerAddr = stream.(*clientStream).trailer
+		}
+	}
+
+	return err
+}
+
+func (conn *Conn) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	return &clientStream{
+		ctx:    ctx,
+		conn:   conn,
+		desc:   desc,
+		method: method,
+	}, nil
+}
+
+type clientStream struct {
+	ctx    context.Context
+	conn   *Conn
+	desc   *grpc.StreamDesc
+	method string
+
+	request bytes.Buffer
+	sent    bool
+
+	body    io.ReadCloser
+	reader  *bufio.Reader
+	header  metadata.MD
+	trailer metadata.MD
+
+	// err is the final status of the call once it's known
+	err error
+}
+
+func (stream *clientStream) Header() (metadata.MD, error) {
+	if err := stream.send(); err != nil {
+		return nil, err
+	}
+
+	return stream.header, nil
+}
+
+func (stream *clientStream) Trailer() metadata.MD {
+	return stream.trailer
+}
+
+func (stream *clientStream) CloseSend() error {
+	// Similarly to grpc-go, the error (if any) will be returned from RecvMsg()
+	_ = stream.send()
+
+	return nil
+}
+
+func (stream *clientStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *clientStream) SendMsg(m interface{}) error {
+	if stream.sent {
+		return status.Error(codes.Internal, "gRPC-Web: SendMsg() called after CloseSend()")
+	}
+
+	message, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "gRPC-Web: %T is not a protocol buffers message", m)
+	}
+
+	payload, err := proto.Marshal(message)
+	if err != nil {
+		return status.Errorf(codes.Internal, "gRPC-Web: failed to marshal the request: %v", err)
+	}
+
+	writeFrame(&stream.request, frameData, payload)
+
+	return nil
+}
+
+func (stream *clientStream) RecvMsg(m interface{}) error {
+	if err := stream.send(); err != nil {
+		return err
+	}
+
+	if err := stream.recv(m); err != nil {
+		if err == io.EOF && !stream.desc.ServerStreams {
+			return stream.fail(status.Error(codes.Internal, "gRPC-Web: no response message received"))
+		}
+
+		return err
+	}
+
+	// Non-server-streaming RPCs return exactly one message, so check
+	// the status right away, just like grpc-go does
+	if !stream.desc.ServerStreams {
+		if err := stream.recv(nil); err != io.EOF {
+			if err == nil {
+				err = status.Error(codes.Internal, "gRPC-Web: received more than one response message")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (stream *clientStream) send() error {
+	if stream.sent {
+		return stream.err
+	}
+	stream.sent = true
+
+	req, err := http.NewRequestWithContext(stream.ctx, http.MethodPost, stream.conn.endpoint+stream.method,
+		bytes.NewReader(stream.request.Bytes()))
+	if err != nil {
+		return stream.fail(status.Errorf(codes.Internal, "gRPC-Web: %v", err))
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", contentType)
+	req.Header.Set("X-Grpc-Web", "1")
+
+	if deadline, ok := stream.ctx.Deadline(); ok {
+		req.Header.Set("Grpc-Timeout", encodeTimeout(time.Until(deadline)))
+	}
+
+	md, _ := metadata.FromOutgoingContext(stream.ctx)
+	for key, values := range md {
+		for _, value := range values {
+			if strings.HasSuffix(key, "-bin") {
+				value = base64.RawStdEncoding.EncodeToString([]byte(value))
+			}
+
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := stream.conn.httpClient.Do(req)
+	if err != nil {
+		if ctxErr := stream.ctx.Err(); ctxErr != nil {
+			return stream.fail(status.FromContextError(ctxErr).Err())
+		}
+
+		return stream.fail(status.Errorf(codes.Unavailable, "gRPC-Web: %v", err))
+	}
+
+	stream.body = resp.Body
+	stream.reader = bufio.NewReader(resp.Body)
+	stream.header = headerToMetadata(resp.Header)
+
+	// Trailers-only responses carry the status in the headers
+	if st := statusFromMetadata(stream.header); st != nil && st.Code() != codes.OK {
+		return stream.fail(st.Err())
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return stream.fail(status.Errorf(codeFromHTTPStatus(resp.StatusCode),
+			"gRPC-Web: unexpected HTTP status %s", resp.Status))
+	}
+
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/grpc-web") ||
+		strings.HasPrefix(resp.Header.Get("Content-Type"), "application/grpc-web-text") {
+		return stream.fail(status.Errorf(codes.Unknown, "gRPC-Web: unexpected content type %q",
+			resp.Header.Get("Content-Type")))
+	}
+
+	return nil
+}
+
+// recv reads the next message into m (if any), returning io.EOF
+// when the call was completed successfully.
+func (stream *clientStream) recv(m interface{}) error {
+	if stream.err != nil {
+		return stream.err
+	}
+
+	flags, payload, err := readFrame(stream.reader)
+	if err != nil {
+		if err == io.EOF {
+			// Trailers-only response with an OK status
+			if st := statusFromMetadata(stream.header); st != nil {
+				return stream.fail(orEOF(st.Err()))
+			}
+
+			err = status.Error(codes.Internal, "gRPC-Web: response ended without the trailers")
+		}
+
+		return stream.fail(err)
+	}
+
+	if flags&frameCompress != 0 {
+		return stream.fail(status.Error(codes.Internal, "gRPC-Web: compressed messages are not supported"))
+	}
+
+	if flags&frameTrailer != 0 {
+		stream.trailer = parseTrailer(payload)
+
+		st := statusFromMetadata(stream.trailer)
+		if st == nil {
+			return stream.fail(status.Error(codes.Internal, "gRPC-Web: trailers are missing the status"))
+		}
+
+		return stream.fail(orEOF(st.Err()))
+	}
+
+	if m == nil {
+		return nil
+	}
+
+	message, ok := m.(proto.Message)
+	if !ok {
+		return stream.fail(status.Errorf(codes.Internal, "gRPC-Web: %T is not a protocol buffers message", m))
+	}
+
+	if err := proto.Unmarshal(payload, message); err != nil {
+		return stream.fail(status.Errorf(codes.Internal, "gRPC-Web: failed to unmarshal the response: %v", err))
+	}
+
+	return nil
+}
+
+func (stream *clientStream) fail(err error) error {
+	stream.err = err
+
+	if stream.body != nil {
+		_ = stream.body.Close()
+	}
+
+	return err
+}
+
+func orEOF(err error) error {
+	if err == nil {
+		return io.EOF
+	}
+
+	return err
+}
+
+func writeFrame(buf *bytes.Buffer, flags byte, payload []byte) {
+	var header [5]byte
+
+	header[0] = flags
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+
+	buf.Write(header[:])
+	buf.Write(payload)
+}
+
+func readFrame(reader io.Reader) (byte, []byte, error) {
+	var header [5]byte
+
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, nil, status.Error(codes.Internal, "gRPC-Web: truncated frame header")
+		}
+
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[1:])
+	if length > maxFrameSize {
+		return 0, nil, status.Errorf(codes.ResourceExhausted, "gRPC-Web: frame of %d bytes is too large", length)
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "gRPC-Web: truncated frame: %v", err)
+	}
+
+	return header[0], payload, nil
+}
+
+func parseTrailer(payload []byte) metadata.MD {
+	md := metadata.MD{}
+
+	for _, line := range strings.Split(string(payload), "\r\n") {
+		idx := strings.IndexByte(line, ':')
+		if idx == -1 {
+			continue
+		}
+
+		key := strings.ToLower(strings.TrimSpace(line[:idx]))
+		md.Append(key, strings.TrimSpace(line[idx+1:]))
+	}
+
+	return md
+}
+
+func headerToMetadata(header http.Header) metadata.MD {
+	md := metadata.MD{}
+
+	for key, values := range header {
+		md.Append(strings.ToLower(key), values...)
+	}
+
+	return md
+}
+
+// statusFromMetadata extracts the gRPC status from the headers or trailers,
+// returning nil if there's none.
+func statusFromMetadata(md metadata.MD) *status.Status {
+	values := md.Get("grpc-status")
+	if len(values) == 0 {
+		return nil
+	}
+
+	code, err := strconv.Atoi(values[0])
+	if err != nil {
+		return status.Newf(codes.Internal, "gRPC-Web: invalid status %q", values[0])
+	}
+
+	var message string
+	if messages := md.Get("grpc-message"); len(messages) != 0 {
+		message, err = url.PathUnescape(messages[0])
+		if err != nil {
+			message = messages[0]
+		}
+	}
+
+	return status.New(codes.Code(code), message)
+}
+
+// codeFromHTTPStatus follows https://github.com/grpc/grpc/blob/master/doc/http-grpc-status-mapping.md
+func codeFromHTTPStatus(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.Internal
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.Unimplemented
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
+
+func encodeTimeout(timeout time.Duration) string {
+	if timeout <= 0 {
+		return "1n"
+	}
+
+	return fmt.Sprintf("%dm", (timeout+time.Millisecond-1)/time.Millisecond)
+}
@@ -0,0 +1,154 @@
+package grpcweb
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// echoHandler concatenates all of the request messages into a single response message
+func echoHandler(t *testing.T) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, contentType, request.Header.Get("Content-Type"))
+		assert.Equal(t, "/test.Service/Echo", request.URL.Path)
+
+		var values []string
+
+		for {
+			_, payload, err := readFrame(request.Body)
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+
+			var value wrapperspb.StringValue
+			require.NoError(t, proto.Unmarshal(payload, &value))
+			values = append(values, value.Value)
+		}
+
+		payload, err := proto.Marshal(wrapperspb.String(strings.Join(values, "+") + request.Header.Get("suffix")))
+		require.NoError(t, err)
+
+		var body bytes.Buffer
+		writeFrame(&body, frameData, payload)
+		writeFrame(&body, frameTrailer, []byte("grpc-status: 0\r\ngrpc-message: \r\n"))
+
+		writer.Header().Set("Content-Type", contentType)
+		_, _ = writer.Write(body.Bytes())
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	server := httptest.NewServer(echoHandler(t))
+	defer server.Close()
+
+	conn := New(server.URL, server.Client())
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "suffix", "!")
+
+	var reply wrapperspb.StringValue
+	require.NoError(t, conn.Invoke(ctx, "/test.Service/Echo", wrapperspb.String("hello"), &reply))
+	assert.Equal(t, "hello!", reply.Value)
+}
+
+func TestClientStreamingIsBuffered(t *testing.T) {
+	server := httptest.NewServer(echoHandler(t))
+	defer server.Close()
+
+	conn := New(server.URL, server.Client())
+
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{ClientStreams: true}, "/test.Service/Echo")
+	require.NoError(t, err)
+
+	for _, value := range []string{"a", "b", "c"} {
+		require.NoError(t, stream.SendMsg(wrapperspb.String(value)))
+	}
+	require.NoError(t, stream.CloseSend())
+
+	var reply wrapperspb.StringValue
+	require.NoError(t, stream.RecvMsg(&reply))
+	assert.Equal(t, "a+b+c", reply.Value)
+}
+
+func TestErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		handler http.HandlerFunc
+		code    codes.Code
+		message string
+	}{
+		{
+			name: "trailer",
+			handler: func(writer http.ResponseWriter, request *http.Request) {
+				var body bytes.Buffer
+				writeFrame(&body, frameTrailer, []byte("grpc-status: 5\r\ngrpc-message: task%20not%20found\r\n"))
+
+				writer.Header().Set("Content-Type", contentType)
+				_, _ = writer.Write(body.Bytes())
+			},
+			code:    codes.NotFound,
+			message: "task not found",
+		},
+		{
+			name: "trailers-only",
+			handler: func(writer http.ResponseWriter, request *http.Request) {
+				writer.Header().Set("Content-Type", contentType)
+				writer.Header().Set("Grpc-Status", "7")
+				writer.Header().Set("Grpc-Message", "denied")
+			},
+			code:    codes.PermissionDenied,
+			message: "denied",
+		},
+		{
+			name: "HTTP status",
+			handler: func(writer http.ResponseWriter, request *http.Request) {
+				writer.WriteHeader(http.StatusServiceUnavailable)
+			},
+			code: codes.Unavailable,
+		},
+		{
+			name: "missing trailers",
+			handler: func(writer http.ResponseWriter, request *http.Request) {
+				writer.Header().Set("Content-Type", contentType)
+			},
+			code: codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+
+			conn := New(server.URL, server.Client())
+
+			var reply wrapperspb.StringValue
+			err := conn.Invoke(context.Background(), "/test.Service/Echo", wrapperspb.String("hello"), &reply)
+			assert.Equal(t, testCase.code, status.Code(err), err)
+			if testCase.message != "" {
+				assert.Equal(t, testCase.message, status.Convert(err).Message())
+			}
+		})
+	}
+}
+
+func TestEndpointFor(t *testing.T) {
+	assert.Equal(t, "https://grpc.cirrus-ci.com:443", EndpointFor("https://grpc.cirrus-ci.com:443"))
+	assert.Equal(t, "https://grpc.cirrus-ci.com:443", EndpointFor("grpc.cirrus-ci.com:443"))
+	assert.Equal(t, "http://localhost:8080", EndpointFor("http://localhost:8080"))
+	assert.Empty(t, EndpointFor("unix:/var/run/cirrus.sock"))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/certifi/gocertifi"
 	"github.com/cirruslabs/cirrus-ci-agent/api"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/throttle"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -40,11 +41,11 @@ func Start(taskIdentification *api.TaskIdentification) string {
 	if err == nil {
 		maxConcurrentConnections := runtime.NumCPU() * activeRequestsPerLogicalCPU
 		httpProxyClient = &http.Client{
-			Transport: &http.Transport{
+			Transport: throttle.NewTransport(&http.Transport{
 				TLSClientConfig:     &tls.Config{RootCAs: certPool},
 				MaxIdleConns:        maxConcurrentConnections,
 				MaxIdleConnsPerHost: maxConcurrentConnections, // default is 2 which is too small
-			},
+			}, throttle.Global()),
 			Timeout: 10 * time.Minute,
 		}
 	}
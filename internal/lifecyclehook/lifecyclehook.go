@@ -0,0 +1,206 @@
+// Package lifecyclehook runs the worker-configured scripts at the specific points
+// of the task's lifecycle, letting the infrastructure providers integrate their host-level logic.
+package lifecyclehook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/api"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Hook string
+
+const (
+	// PreStart runs before the task's first instruction, its failure fails the task
+	PreStart Hook = "pre-start"
+
+	// PostClone runs after the repository is cloned, its failure fails the clone instruction
+	PostClone Hook = "post-clone"
+
+	// PostTask runs after the task's last instruction
+	PostTask Hook = "post-task"
+
+	// PreStop runs in the "--stop-hook" mode, before the worker stops the task's VM or container
+	PreStop Hook = "pre-stop"
+
+	// DefaultTimeout limits the execution of each hook
+	DefaultTimeout = 5 * time.Minute
+
+	// maxOutputSize limits the hook's output kept for the report
+	maxOutputSize = 64 * 1024
+)
+
+// Hooks maps the hooks to the local scripts that implement them.
+type Hooks map[Hook]string
+
+// Names returns the configured hooks in a stable order.
+func (hooks Hooks) Names() []string {
+	var result []string
+
+	for hook, script := range hooks {
+		if script != "" {
+			result = append(result, string(hook))
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+type Result struct {
+	Hook     Hook
+	ExitCode int
+	Duration time.Duration
+
+	// Output is the tail of the hook's combined stdout and stderr
+	Output string
+
+	Err error
+}
+
+func (result *Result) Success() bool {
+	return result.Err == nil && result.ExitCode == 0
+}
+
+func (result *Result) Error() error {
+	switch {
+	case result.Err != nil:
+		return fmt.Errorf("%s hook failed: %w", result.Hook, result.Err)
+	case result.ExitCode != 0:
+		return fmt.Errorf("%s hook failed with exit code %d", result.Hook, result.ExitCode)
+	default:
+		return nil
+	}
+}
+
+// Run runs the script with the agent's environment extended with the env, streaming its output to the output.
+func Run(ctx context.Context, hook Hook, script string, env map[string]string, output io.Writer) *Result {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	tail := &tailBuffer{max: maxOutputSize}
+
+	writer := io.Writer(tail)
+	if output != nil {
+		writer = io.MultiWriter(tail, output)
+	}
+
+	cmd := exec.CommandContext(ctx, script)
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	cmd.Env = os.Environ()
+	cmd.Env = append(cmd.Env, "CIRRUS_LIFECYCLE_HOOK="+string(hook))
+
+	var keys []string
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		cmd.Env = append(cmd.Env, key+"="+env[key])
+	}
+
+	start := time.Now()
+	err := cmd.Run()
+
+	result := &Result{
+		Hook:     hook,
+		Duration: time.Since(start),
+		Output:   tail.String(),
+	}
+
+	var exitErr *exec.ExitError
+
+	switch {
+	case errors.As(err, &exitErr) && ctx.Err() == nil:
+		result.ExitCode = exitErr.ExitCode()
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.Err = fmt.Errorf("timed out after %v", DefaultTimeout)
+	case err != nil:
+		result.Err = err
+	}
+
+	return result
+}
+
+// tailBuffer keeps the last max bytes written to it.
+type tailBuffer struct {
+	max int
+
+	mtx       sync.Mutex
+	buf       bytes.Buffer
+	truncated bool
+}
+
+func (tail *tailBuffer) Write(p []byte) (int, error) {
+	tail.mtx.Lock()
+	defer tail.mtx.Unlock()
+
+	tail.buf.Write(p)
+
+	if excess := tail.buf.Len() - tail.max; excess > 0 {
+		tail.buf.Next(excess)
+		tail.truncated = true
+	}
+
+	return len(p), nil
+}
+
+func (tail *tailBuffer) String() string {
+	tail.mtx.Lock()
+	defer tail.mtx.Unlock()
+
+	if tail.truncated {
+		return "[...]" + strings.TrimLeft(tail.buf.String(), "\n")
+	}
+
+	return tail.buf.String()
+}
+
+// RunAndReport runs the hook's script (if configured) and reports the result to the server,
+// returning an error if the hook has failed.
+func RunAndReport(
+	ctx context.Context,
+	taskIdentification *api.TaskIdentification,
+	hook Hook,
+	script string,
+	env map[string]string,
+	output io.Writer,
+) error {
+	if script == "" {
+		return nil
+	}
+
+	log.Printf("Running the %s hook %s...", hook, script)
+
+	result := Run(ctx, hook, script, env, output)
+
+	_, err := client.CirrusClient.ReportLifecycleHook(ctx, &api.ReportLifecycleHookRequest{
+		TaskIdentification: taskIdentification,
+		Hook:               string(hook),
+		Success:            result.Success(),
+		ExitCode:           int64(result.ExitCode),
+		DurationNanos:      result.Duration.Nanoseconds(),
+		Output:             result.Output,
+	})
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		log.Printf("Failed to report the %s hook result: %v", hook, err)
+	}
+
+	return result.Error()
+}
@@ -0,0 +1,34 @@
+//go:build !windows
+// +build !windows
+
+package lifecyclehook
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	script := filepath.Join(t.TempDir(), "hook.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho \"$CIRRUS_LIFECYCLE_HOOK $CIRRUS_TASK_ID\"\nexit 3\n"), 0700))
+
+	result := Run(context.Background(), PostTask, script, map[string]string{"CIRRUS_TASK_ID": "42"}, nil)
+	require.NoError(t, result.Err)
+	require.False(t, result.Success())
+	require.Equal(t, 3, result.ExitCode)
+	require.Equal(t, "post-task 42\n", result.Output)
+	require.EqualError(t, result.Error(), "post-task hook failed with exit code 3")
+}
+
+func TestTailBuffer(t *testing.T) {
+	tail := &tailBuffer{max: 4}
+
+	_, _ = tail.Write([]byte("abc"))
+	require.Equal(t, "abc", tail.String())
+
+	_, _ = tail.Write([]byte("defg"))
+	require.Equal(t, "[...]defg", tail.String())
+}
@@ -0,0 +1,133 @@
+package logsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/awssigv4"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Maximum number of events in a single PutLogEvents call
+const cloudWatchMaxEventsPerBatch = 10000
+
+// CloudWatch sends the logs to the AWS CloudWatch Logs into a per-task
+// log stream, using the credentials from the standard AWS environment variables.
+type CloudWatch struct {
+	region      string
+	group       string
+	stream      string
+	credentials *awssigv4.Credentials
+	httpClient  *http.Client
+
+	streamCreated bool
+}
+
+type cloudWatchEvent struct {
+	Timestamp int64  `json:"timestamp"`
+	Message   string `json:"message"`
+}
+
+func NewCloudWatch(region string, group string, taskID int64) (*CloudWatch, error) {
+	credentials, err := awssigv4.CredentialsFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CloudWatch{
+		region:      region,
+		group:       group,
+		stream:      fmt.Sprintf("task-%d", taskID),
+		credentials: credentials,
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}, nil
+}
+
+func (cw *CloudWatch) Write(ctx context.Context, commandName string, data []byte) error {
+	if !cw.streamCreated {
+		err := cw.call(ctx, "CreateLogStream", map[string]interface{}{
+			"logGroupName":  cw.group,
+			"logStreamName": cw.stream,
+		})
+		if err != nil && !strings.Contains(err.Error(), "ResourceAlreadyExistsException") {
+			return err
+		}
+
+		cw.streamCreated = true
+	}
+
+	timestamp := clockskew.Now().UnixMilli()
+
+	var events []cloudWatchEvent
+
+	for _, line := range splitLines(data) {
+		events = append(events, cloudWatchEvent{
+			Timestamp: timestamp,
+			Message:   fmt.Sprintf("[%s] %s", commandName, line),
+		})
+	}
+
+	for len(events) != 0 {
+		batchSize := len(events)
+		if batchSize > cloudWatchMaxEventsPerBatch {
+			batchSize = cloudWatchMaxEventsPerBatch
+		}
+
+		err := cw.call(ctx, "PutLogEvents", map[string]interface{}{
+			"logGroupName":  cw.group,
+			"logStreamName": cw.stream,
+			"logEvents":     events[:batchSize],
+		})
+		if err != nil {
+			return err
+		}
+
+		events = events[batchSize:]
+	}
+
+	return nil
+}
+
+func (cw *CloudWatch) call(ctx context.Context, action string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://logs.%s.amazonaws.com/", cw.region)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	request.Header.Set("X-Amz-Target", "Logs_20140328."+action)
+
+	awssigv4.Sign(request, body, cw.credentials, cw.region, "logs", clockskew.Now())
+
+	response, err := cw.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		responseBody, _ := io.ReadAll(io.LimitReader(response.Body, 4096))
+
+		return fmt.Errorf("CloudWatch Logs %s call failed with HTTP %d: %s", action, response.StatusCode,
+			string(responseBody))
+	}
+
+	return nil
+}
+
+func (cw *CloudWatch) Close() error {
+	return nil
+}
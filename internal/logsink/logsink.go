@@ -0,0 +1,74 @@
+package logsink
+
+import (
+	"context"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"strings"
+)
+
+const (
+	EnvCirrusLogSink       = "CIRRUS_LOG_SINK"
+	EnvCirrusLogSinkURL    = "CIRRUS_LOG_SINK_URL"
+	EnvCirrusLogSinkGroup  = "CIRRUS_LOG_SINK_GROUP"
+	EnvCirrusLogSinkRegion = "CIRRUS_LOG_SINK_REGION"
+
+	KindLoki       = "loki"
+	KindCloudWatch = "cloudwatch"
+	KindSyslog     = "syslog"
+)
+
+// Sink receives a copy of the (already masked) command logs in addition to the Cirrus CI server.
+type Sink interface {
+	Write(ctx context.Context, commandName string, data []byte) error
+	Close() error
+}
+
+// NewFromEnvironment instantiates the sink configured through the CIRRUS_LOG_SINK* environment
+// variables or returns nil if no sink is configured.
+func NewFromEnvironment(env *environment.Environment, taskID int64) (Sink, error) {
+	kind, ok := env.Lookup(EnvCirrusLogSink)
+	if !ok || kind == "" {
+		return nil, nil
+	}
+
+	switch kind {
+	case KindLoki:
+		url, ok := env.Lookup(EnvCirrusLogSinkURL)
+		if !ok {
+			return nil, fmt.Errorf("%s sink requires %s to be set", kind, EnvCirrusLogSinkURL)
+		}
+
+		return NewLoki(url, taskID), nil
+	case KindCloudWatch:
+		group, ok := env.Lookup(EnvCirrusLogSinkGroup)
+		if !ok {
+			return nil, fmt.Errorf("%s sink requires %s to be set", kind, EnvCirrusLogSinkGroup)
+		}
+
+		region, ok := env.Lookup(EnvCirrusLogSinkRegion)
+		if !ok {
+			region, ok = env.Lookup("AWS_REGION")
+		}
+		if !ok {
+			return nil, fmt.Errorf("%s sink requires %s or AWS_REGION to be set", kind, EnvCirrusLogSinkRegion)
+		}
+
+		cloudWatch, err := NewCloudWatch(region, group, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		return cloudWatch, nil
+	case KindSyslog:
+		return NewSyslog(env.Get(EnvCirrusLogSinkURL), taskID)
+	default:
+		return nil, fmt.Errorf("unsupported log sink %q, supported sinks are %s, %s and %s",
+			kind, KindLoki, KindCloudWatch, KindSyslog)
+	}
+}
+
+// splitLines splits the log chunk into lines suitable for line-oriented sinks.
+func splitLines(data []byte) []string {
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
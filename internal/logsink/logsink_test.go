@@ -0,0 +1,56 @@
+package logsink_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/environment"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/logsink"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNotConfigured(t *testing.T) {
+	sink, err := logsink.NewFromEnvironment(environment.NewEmpty(), 42)
+	require.NoError(t, err)
+	require.Nil(t, sink)
+}
+
+func TestUnsupported(t *testing.T) {
+	_, err := logsink.NewFromEnvironment(environment.New(map[string]string{
+		logsink.EnvCirrusLogSink: "papertape",
+	}), 42)
+	require.Error(t, err)
+}
+
+func TestLoki(t *testing.T) {
+	var pushRequest struct {
+		Streams []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"streams"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.NoError(t, json.NewDecoder(request.Body).Decode(&pushRequest))
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink, err := logsink.NewFromEnvironment(environment.New(map[string]string{
+		logsink.EnvCirrusLogSink:    logsink.KindLoki,
+		logsink.EnvCirrusLogSinkURL: server.URL + "/loki/api/v1/push",
+	}), 42)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Write(context.Background(), "main", []byte("first\nsecond\n")))
+
+	require.Len(t, pushRequest.Streams, 1)
+	require.Equal(t, "42", pushRequest.Streams[0].Stream["task_id"])
+	require.Equal(t, "main", pushRequest.Streams[0].Stream["command"])
+	require.Len(t, pushRequest.Streams[0].Values, 2)
+	require.Equal(t, "first", pushRequest.Streams[0].Values[0][1])
+	require.Equal(t, "second", pushRequest.Streams[0].Values[1][1])
+}
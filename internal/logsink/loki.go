@@ -0,0 +1,84 @@
+package logsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Loki pushes the logs using the Grafana Loki's HTTP push API[1],
+// credentials (if any) can be specified in the URL's userinfo.
+//
+// [1]: https://grafana.com/docs/loki/latest/reference/api/#push-log-entries-to-loki
+type Loki struct {
+	url        string
+	taskID     string
+	httpClient *http.Client
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func NewLoki(url string, taskID int64) *Loki {
+	return &Loki{
+		url:    url,
+		taskID: strconv.FormatInt(taskID, 10),
+		httpClient: &http.Client{
+			Timeout: 10 * time.Second,
+		},
+	}
+}
+
+func (loki *Loki) Write(ctx context.Context, commandName string, data []byte) error {
+	timestamp := strconv.FormatInt(clockskew.Now().UnixNano(), 10)
+
+	stream := lokiStream{
+		Stream: map[string]string{
+			"source":  "cirrus-ci",
+			"task_id": loki.taskID,
+			"command": commandName,
+		},
+	}
+
+	for _, line := range splitLines(data) {
+		stream.Values = append(stream.Values, [2]string{timestamp, line})
+	}
+
+	body, err := json.Marshal(&lokiPushRequest{Streams: []lokiStream{stream}})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, loki.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := loki.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent && response.StatusCode != http.StatusOK {
+		return fmt.Errorf("Loki push failed with HTTP %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+func (loki *Loki) Close() error {
+	return nil
+}
@@ -0,0 +1,53 @@
+//go:build !windows && !plan9
+// +build !windows,!plan9
+
+package logsink
+
+import (
+	"context"
+	"fmt"
+	"log/syslog"
+	"net/url"
+)
+
+type Syslog struct {
+	writer *syslog.Writer
+}
+
+// NewSyslog connects to the syslog daemon at the specified address (e.g. udp://logs.example.com:514)
+// or to the local syslog daemon if the address is empty.
+func NewSyslog(address string, taskID int64) (Sink, error) {
+	var network, raddr string
+
+	if address != "" {
+		parsedAddress, err := url.Parse(address)
+		if err != nil {
+			return nil, err
+		}
+
+		network, raddr = parsedAddress.Scheme, parsedAddress.Host
+	}
+
+	writer, err := syslog.Dial(network, raddr, syslog.LOG_INFO|syslog.LOG_USER, fmt.Sprintf("cirrus-task-%d", taskID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Syslog{
+		writer: writer,
+	}, nil
+}
+
+func (sink *Syslog) Write(ctx context.Context, commandName string, data []byte) error {
+	for _, line := range splitLines(data) {
+		if err := sink.writer.Info(fmt.Sprintf("[%s] %s", commandName, line)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (sink *Syslog) Close() error {
+	return sink.writer.Close()
+}
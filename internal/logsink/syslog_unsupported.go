@@ -0,0 +1,12 @@
+//go:build windows || plan9
+// +build windows plan9
+
+package logsink
+
+import (
+	"errors"
+)
+
+func NewSyslog(address string, taskID int64) (Sink, error) {
+	return nil, errors.New("syslog sink is not supported on this platform")
+}
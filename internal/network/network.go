@@ -3,33 +3,15 @@ package network
 import (
 	"context"
 	"fmt"
-	"github.com/avast/retry-go"
-	"math"
-	"net"
-	"time"
 )
 
+// WaitForLocalPort blocks until the local TCP port starts accepting
+// connections or the ctx is done.
 func WaitForLocalPort(ctx context.Context, port int) {
-	dialer := net.Dialer{
-		Timeout: 10 * time.Second,
+	probe := &Probe{
+		Kind:    ProbeTCP,
+		Address: fmt.Sprintf("localhost:%d", port),
 	}
 
-	var conn net.Conn
-	var err error
-
-	_ = retry.Do(
-		func() error {
-			conn, err = dialer.DialContext(ctx, "tcp", fmt.Sprintf("localhost:%d", port))
-			if err != nil {
-				return err
-			}
-
-			_ = conn.Close()
-
-			return nil
-		},
-		retry.Delay(1*time.Second), retry.MaxDelay(1*time.Second),
-		retry.Attempts(math.MaxUint32), retry.LastErrorOnly(true),
-		retry.Context(ctx),
-	)
+	_ = probe.Wait(ctx)
 }
@@ -2,9 +2,14 @@ package network_test
 
 import (
 	"context"
+	"fmt"
 	"github.com/cirruslabs/cirrus-ci-agent/internal/network"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -34,3 +39,65 @@ func TestEarlyExit(t *testing.T) {
 
 	assert.WithinDuration(t, stop, start, maxExpectedWaitTime)
 }
+
+func TestParseProbes(t *testing.T) {
+	probes, errs := network.ParseProbes("8080, db:5432@30s,udp:53,udp:resolver:53@5s,http://user@localhost:8080/healthz@2m")
+	require.Empty(t, errs)
+
+	require.Equal(t, []*network.Probe{
+		{Kind: network.ProbeTCP, Address: "localhost:8080", Timeout: network.DefaultProbeTimeout},
+		{Kind: network.ProbeTCP, Address: "db:5432", Timeout: 30 * time.Second},
+		{Kind: network.ProbeUDP, Address: "localhost:53", Timeout: network.DefaultProbeTimeout},
+		{Kind: network.ProbeUDP, Address: "resolver:53", Timeout: 5 * time.Second},
+		{Kind: network.ProbeHTTP, Address: "http://user@localhost:8080/healthz", Timeout: 2 * time.Minute},
+	}, probes)
+}
+
+func TestParseProbesSkipsInvalid(t *testing.T) {
+	probes, errs := network.ParseProbes("8080,port,db:5432")
+
+	require.Equal(t, []*network.Probe{
+		{Kind: network.ProbeTCP, Address: "localhost:8080", Timeout: network.DefaultProbeTimeout},
+		{Kind: network.ProbeTCP, Address: "db:5432", Timeout: network.DefaultProbeTimeout},
+	}, probes)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], network.ErrInvalidProbe)
+	assert.Contains(t, errs[0].Error(), `"port"`)
+}
+
+func TestParseProbeInvalid(t *testing.T) {
+	for _, entry := range []string{"port", "udp:", "db:", "70000", "8080@-1s"} {
+		_, err := network.ParseProbe(entry)
+		assert.ErrorIs(t, err, network.ErrInvalidProbe, entry)
+	}
+}
+
+func TestProbeHTTP(t *testing.T) {
+	var healthy int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if atomic.CompareAndSwapInt32(&healthy, 0, 1) {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	probe, err := network.ParseProbe(server.URL + "@10s")
+	require.NoError(t, err)
+	require.NoError(t, probe.Wait(context.Background()))
+	assert.EqualValues(t, 1, atomic.LoadInt32(&healthy))
+}
+
+func TestProbeTimeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	require.NoError(t, lis.Close())
+
+	probe, err := network.ParseProbe(fmt.Sprintf("%d@2s", port))
+	require.NoError(t, err)
+
+	start := time.Now()
+	require.Error(t, probe.Wait(context.Background()))
+	assert.WithinDuration(t, start.Add(2*time.Second), time.Now(), maxExpectedWaitTime)
+}
@@ -0,0 +1,240 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/avast/retry-go"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultProbeTimeout is how long Probe.Wait() waits for an entry
+// that doesn't specify its own timeout.
+const DefaultProbeTimeout = 60 * time.Second
+
+const (
+	ProbeTCP  = "tcp"
+	ProbeUDP  = "udp"
+	ProbeHTTP = "http"
+)
+
+var ErrInvalidProbe = errors.New("invalid wait-for entry")
+
+// Probe describes a single CIRRUS_PORTS_WAIT_FOR entry.
+type Probe struct {
+	Kind    string
+	Address string
+	Timeout time.Duration
+}
+
+// ParseProbes parses a comma-separated list of wait-for entries, skipping the invalid ones,
+// so that a single typo doesn't prevent waiting for the rest of the services.
+func ParseProbes(entries string) ([]*Probe, []error) {
+	var probes []*Probe
+	var errs []error
+
+	for _, entry := range strings.Split(entries, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		probe, err := ParseProbe(entry)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		probes = append(probes, probe)
+	}
+
+	return probes, errs
+}
+
+// ParseProbe parses a single wait-for entry, which can be one of:
+//
+//	8080                            local TCP port
+//	db:5432                         remote TCP port
+//	udp:53, udp:resolver:53         UDP port, local or remote
+//	http://localhost:8080/healthz   HTTP(S) endpoint that should respond with 2xx or 3xx
+//
+// Any of the above can be followed by "@<duration>" (e.g. "8080@2m") to override
+// the DefaultProbeTimeout.
+func ParseProbe(entry string) (*Probe, error) {
+	probe := &Probe{
+		Timeout: DefaultProbeTimeout,
+	}
+
+	// The timeout suffix is only recognized when it parses as a duration,
+	// so that URLs with userinfo (http://user@host) are left intact
+	if idx := strings.LastIndex(entry, "@"); idx != -1 {
+		if timeout, err := time.ParseDuration(entry[idx+1:]); err == nil {
+			if timeout <= 0 {
+				return nil, fmt.Errorf("%w %q: timeout should be positive", ErrInvalidProbe, entry)
+			}
+
+			probe.Timeout = timeout
+			entry = entry[:idx]
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(entry, "http://") || strings.HasPrefix(entry, "https://"):
+		probe.Kind = ProbeHTTP
+		probe.Address = entry
+
+		return probe, nil
+	case strings.HasPrefix(entry, "udp:"):
+		probe.Kind = ProbeUDP
+		entry = strings.TrimPrefix(entry, "udp:")
+	default:
+		probe.Kind = ProbeTCP
+	}
+
+	address, err := hostPort(entry)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %v", ErrInvalidProbe, entry, err)
+	}
+	probe.Address = address
+
+	return probe, nil
+}
+
+func hostPort(entry string) (string, error) {
+	host, port := "localhost", entry
+
+	if strings.Contains(entry, ":") {
+		var err error
+
+		host, port, err = net.SplitHostPort(entry)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	portNumber, err := strconv.Atoi(port)
+	if err != nil || portNumber <= 0 || portNumber > math.MaxUint16 {
+		return "", fmt.Errorf("invalid port %q", port)
+	}
+
+	return net.JoinHostPort(host, port), nil
+}
+
+func (probe *Probe) String() string {
+	if probe.Kind == ProbeTCP || probe.Kind == ProbeHTTP {
+		return probe.Address
+	}
+
+	return fmt.Sprintf("%s:%s", probe.Kind, probe.Address)
+}
+
+// Wait blocks until the probe succeeds, returning an error if that
+// doesn't happen within the probe's timeout. Zero timeout means
+// waiting until the ctx is done.
+func (probe *Probe) Wait(ctx context.Context) error {
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if probe.Timeout > 0 {
+		subCtx, cancel = context.WithTimeout(subCtx, probe.Timeout)
+		defer cancel()
+	}
+
+	return retry.Do(
+		func() error {
+			return probe.check(subCtx)
+		},
+		retry.Delay(1*time.Second), retry.MaxDelay(1*time.Second),
+		retry.Attempts(math.MaxUint32), retry.LastErrorOnly(true),
+		retry.Context(subCtx),
+	)
+}
+
+func (probe *Probe) check(ctx context.Context) error {
+	switch probe.Kind {
+	case ProbeTCP:
+		dialer := net.Dialer{
+			Timeout: 10 * time.Second,
+		}
+
+		conn, err := dialer.DialContext(ctx, "tcp", overrideAddress(probe.Address))
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	case ProbeUDP:
+		return checkUDP(ctx, probe.Address)
+	case ProbeHTTP:
+		return checkHTTP(ctx, probe.Address)
+	default:
+		return fmt.Errorf("%w: unknown probe kind %q", ErrInvalidProbe, probe.Kind)
+	}
+}
+
+// checkUDP sends an empty datagram and treats the port as ready unless the
+// host explicitly refuses it (ICMP port unreachable), since there's no
+// handshake in UDP that would otherwise tell us that something is listening.
+func checkUDP(ctx context.Context, address string) error {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "udp", overrideAddress(address))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte{}); err != nil {
+		return err
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(500 * time.Millisecond)); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 1)
+	if _, err := conn.Read(buf); err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func checkHTTP(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return retry.Unrecoverable(err)
+	}
+
+	client := http.Client{
+		Transport: &http.Transport{
+			DialContext: DialContext,
+		},
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s responded with %s", url, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package network
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultDNSPort = "53"
+
+var (
+	hostOverrides   map[string]string
+	hostOverridesMu sync.RWMutex
+)
+
+// ConfigureResolver makes the agent's own network clients (gRPC, Git and HTTP)
+// use the specified DNS server instead of the system resolver and/or resolve
+// the hostnames listed in the specified /etc/hosts-style file statically.
+//
+// Empty dnsServer or hostsFile leave the corresponding behavior unchanged.
+func ConfigureResolver(dnsServer string, hostsFile string) error {
+	if dnsServer != "" {
+		server, err := dnsServerAddress(dnsServer)
+		if err != nil {
+			return err
+		}
+
+		net.DefaultResolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				dialer := net.Dialer{Timeout: 10 * time.Second}
+
+				return dialer.DialContext(ctx, network, server)
+			},
+		}
+	}
+
+	if hostsFile != "" {
+		file, err := os.Open(hostsFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		overrides, err := ParseHosts(file)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", hostsFile, err)
+		}
+
+		hostOverridesMu.Lock()
+		hostOverrides = overrides
+		hostOverridesMu.Unlock()
+	}
+
+	// Clients that don't specify their own transport use this one
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport.DialContext = DialContext
+	}
+
+	return nil
+}
+
+// HasHostOverrides returns true if ConfigureResolver() was called with a hosts file.
+func HasHostOverrides() bool {
+	hostOverridesMu.RLock()
+	defer hostOverridesMu.RUnlock()
+
+	return len(hostOverrides) != 0
+}
+
+// DialContext works like net.Dialer's DialContext, but takes the
+// host overrides configured by ConfigureResolver() into account.
+func DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	dialer := net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+
+	return dialer.DialContext(ctx, network, overrideAddress(address))
+}
+
+// ParseHosts parses the /etc/hosts-style contents into a hostname to IP address mapping.
+func ParseHosts(r io.Reader) (map[string]string, error) {
+	result := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if idx := strings.IndexByte(line, '#'); idx != -1 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("no hostnames specified for %q", fields[0])
+		}
+
+		if net.ParseIP(fields[0]) == nil {
+			return nil, fmt.Errorf("invalid IP address %q", fields[0])
+		}
+
+		for _, hostname := range fields[1:] {
+			result[strings.ToLower(hostname)] = fields[0]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func overrideAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+
+	hostOverridesMu.RLock()
+	ip, ok := hostOverrides[strings.ToLower(strings.TrimSuffix(host, "."))]
+	hostOverridesMu.RUnlock()
+
+	if !ok {
+		return address
+	}
+
+	return net.JoinHostPort(ip, port)
+}
+
+func dnsServerAddress(dnsServer string) (string, error) {
+	if net.ParseIP(dnsServer) != nil {
+		return net.JoinHostPort(dnsServer, defaultDNSPort), nil
+	}
+
+	host, _, err := net.SplitHostPort(dnsServer)
+	if err != nil || net.ParseIP(host) == nil {
+		return "", fmt.Errorf("invalid DNS server %q: should be an IP address with an optional port", dnsServer)
+	}
+
+	return dnsServer, nil
+}
@@ -0,0 +1,79 @@
+package network
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseHosts(t *testing.T) {
+	hosts, err := ParseHosts(strings.NewReader(`# comment
+127.0.0.1 localhost
+
+10.0.0.1	grpc.cirrus-ci.com  Mirror.Example.com # trailing comment
+::1 ip6-localhost
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"localhost":          "127.0.0.1",
+		"grpc.cirrus-ci.com": "10.0.0.1",
+		"mirror.example.com": "10.0.0.1",
+		"ip6-localhost":      "::1",
+	}, hosts)
+
+	_, err = ParseHosts(strings.NewReader("10.0.0.1\n"))
+	assert.Error(t, err)
+
+	_, err = ParseHosts(strings.NewReader("not-an-ip example.com\n"))
+	assert.Error(t, err)
+}
+
+func TestDNSServerAddress(t *testing.T) {
+	address, err := dnsServerAddress("10.0.0.53")
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.53:53", address)
+
+	address, err = dnsServerAddress("[::1]:5353")
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:5353", address)
+
+	_, err = dnsServerAddress("dns.example.com")
+	assert.Error(t, err)
+}
+
+func TestDialContextHostOverrides(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	hostsFile := filepath.Join(t.TempDir(), "hosts")
+	require.NoError(t, os.WriteFile(hostsFile, []byte("127.0.0.1 api.cirrus-ci.invalid\n"), 0600))
+
+	require.NoError(t, ConfigureResolver("", hostsFile))
+	defer func() {
+		hostOverrides = nil
+	}()
+	assert.True(t, HasHostOverrides())
+
+	_, port, err := net.SplitHostPort(lis.Addr().String())
+	require.NoError(t, err)
+
+	conn, err := DialContext(context.Background(), "tcp", net.JoinHostPort("api.cirrus-ci.invalid", port))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
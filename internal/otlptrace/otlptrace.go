@@ -0,0 +1,316 @@
+// Package otlptrace implements a minimal OpenTelemetry trace exporter using the OTLP/HTTP
+// JSON encoding, configured with the standard OTEL_* environment variables.
+package otlptrace
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	EnvOTLPEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOTLPTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	EnvOTLPHeaders        = "OTEL_EXPORTER_OTLP_HEADERS"
+	EnvOTLPTracesHeaders  = "OTEL_EXPORTER_OTLP_TRACES_HEADERS"
+	EnvOTLPProtocol       = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	EnvServiceName        = "OTEL_SERVICE_NAME"
+	EnvResourceAttributes = "OTEL_RESOURCE_ATTRIBUTES"
+	EnvTracesExporter     = "OTEL_TRACES_EXPORTER"
+
+	defaultServiceName = "cirrus-ci-agent"
+	scopeName          = "github.com/cirruslabs/cirrus-ci-agent"
+
+	statusCodeOK    = 1
+	statusCodeError = 2
+
+	spanKindInternal = 1
+)
+
+type Tracer struct {
+	httpClient *http.Client
+	endpoint   string
+	headers    map[string]string
+	resource   map[string]interface{}
+
+	traceID [16]byte
+	root    *Span
+
+	mtx   sync.Mutex
+	spans []*Span
+}
+
+type Span struct {
+	tracer     *Tracer
+	name       string
+	spanID     [8]byte
+	parentID   *[8]byte
+	start      time.Time
+	end        time.Time
+	attributes map[string]interface{}
+	failed     bool
+}
+
+// NewFromEnvironment creates a Tracer whose root span covers the whole task, returning nil
+// when no OTLP endpoint is configured. The lookup function is used to retrieve the OTEL_*
+// environment variables, which allows to prefer the task's environment over the agent's one.
+func NewFromEnvironment(lookup func(string) (string, bool), rootName string) (*Tracer, error) {
+	if exporter, ok := lookup(EnvTracesExporter); ok && exporter == "none" {
+		return nil, nil
+	}
+
+	endpoint, ok := lookup(EnvOTLPTracesEndpoint)
+	if !ok {
+		baseEndpoint, ok := lookup(EnvOTLPEndpoint)
+		if !ok {
+			return nil, nil
+		}
+
+		endpoint = strings.TrimSuffix(baseEndpoint, "/") + "/v1/traces"
+	}
+
+	if protocol, ok := lookup(EnvOTLPProtocol); ok && protocol != "http/json" {
+		return nil, fmt.Errorf("unsupported %s %q, only \"http/json\" is supported", EnvOTLPProtocol, protocol)
+	}
+
+	headers := map[string]string{}
+	for _, key := range []string{EnvOTLPHeaders, EnvOTLPTracesHeaders} {
+		rawHeaders, _ := lookup(key)
+
+		parsed, err := parseKeyValueList(rawHeaders)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", key, err)
+		}
+
+		for key, value := range parsed {
+			headers[key] = value
+		}
+	}
+
+	rawResourceAttributes, _ := lookup(EnvResourceAttributes)
+	resourceAttributes, err := parseKeyValueList(rawResourceAttributes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", EnvResourceAttributes, err)
+	}
+
+	resource := map[string]interface{}{
+		"service.name": defaultServiceName,
+	}
+	for key, value := range resourceAttributes {
+		resource[key] = value
+	}
+	if serviceName, ok := lookup(EnvServiceName); ok && serviceName != "" {
+		resource["service.name"] = serviceName
+	}
+
+	tracer := &Tracer{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		endpoint:   endpoint,
+		headers:    headers,
+		resource:   resource,
+	}
+
+	if _, err := rand.Read(tracer.traceID[:]); err != nil {
+		return nil, err
+	}
+
+	tracer.root = tracer.newSpan(rootName, nil)
+
+	return tracer, nil
+}
+
+// Root returns the span covering the whole task.
+func (tracer *Tracer) Root() *Span {
+	return tracer.root
+}
+
+// Start starts a new span that is a child of the root span.
+func (tracer *Tracer) Start(name string) *Span {
+	return tracer.newSpan(name, &tracer.root.spanID)
+}
+
+func (tracer *Tracer) newSpan(name string, parentID *[8]byte) *Span {
+	span := &Span{
+		tracer:     tracer,
+		name:       name,
+		parentID:   parentID,
+		start:      clockskew.Now(),
+		attributes: map[string]interface{}{},
+	}
+
+	_, _ = rand.Read(span.spanID[:])
+
+	return span
+}
+
+// SetAttribute sets a string, bool, integer or floating point attribute.
+func (span *Span) SetAttribute(key string, value interface{}) {
+	span.attributes[key] = value
+}
+
+// End finishes the span and queues it for the export.
+func (span *Span) End(success bool) {
+	span.end = clockskew.Now()
+	span.failed = !success
+
+	span.tracer.mtx.Lock()
+	defer span.tracer.mtx.Unlock()
+
+	span.tracer.spans = append(span.tracer.spans, span)
+}
+
+// Shutdown ends the root span and exports all the finished spans.
+func (tracer *Tracer) Shutdown(ctx context.Context, success bool) error {
+	tracer.root.End(success)
+
+	tracer.mtx.Lock()
+	spans := tracer.spans
+	tracer.spans = nil
+	tracer.mtx.Unlock()
+
+	body, err := json.Marshal(tracer.encode(spans))
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, tracer.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range tracer.headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := tracer.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("bad response status while exporting the trace %d: %s",
+			response.StatusCode, response.Status)
+	}
+
+	return nil
+}
+
+func (tracer *Tracer) encode(spans []*Span) map[string]interface{} {
+	var encodedSpans []map[string]interface{}
+
+	for _, span := range spans {
+		encodedSpan := map[string]interface{}{
+			"traceId":           hex.EncodeToString(tracer.traceID[:]),
+			"spanId":            hex.EncodeToString(span.spanID[:]),
+			"name":              span.name,
+			"kind":              spanKindInternal,
+			"startTimeUnixNano": strconv.FormatInt(span.start.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(span.end.UnixNano(), 10),
+			"attributes":        encodeAttributes(span.attributes),
+			"status":            map[string]interface{}{"code": statusCodeOK},
+		}
+
+		if span.parentID != nil {
+			encodedSpan["parentSpanId"] = hex.EncodeToString(span.parentID[:])
+		}
+
+		if span.failed {
+			encodedSpan["status"] = map[string]interface{}{"code": statusCodeError}
+		}
+
+		encodedSpans = append(encodedSpans, encodedSpan)
+	}
+
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": encodeAttributes(tracer.resource),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": scopeName},
+						"spans": encodedSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func encodeAttributes(attributes map[string]interface{}) []map[string]interface{} {
+	var keys []string
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := []map[string]interface{}{}
+
+	for _, key := range keys {
+		var value map[string]interface{}
+
+		switch typedValue := attributes[key].(type) {
+		case bool:
+			value = map[string]interface{}{"boolValue": typedValue}
+		case int:
+			value = map[string]interface{}{"intValue": strconv.FormatInt(int64(typedValue), 10)}
+		case int64:
+			value = map[string]interface{}{"intValue": strconv.FormatInt(typedValue, 10)}
+		case uint64:
+			value = map[string]interface{}{"intValue": strconv.FormatUint(typedValue, 10)}
+		case float64:
+			value = map[string]interface{}{"doubleValue": typedValue}
+		default:
+			value = map[string]interface{}{"stringValue": fmt.Sprint(typedValue)}
+		}
+
+		result = append(result, map[string]interface{}{"key": key, "value": value})
+	}
+
+	return result
+}
+
+// parseKeyValueList parses the W3C Baggage-like "key1=value1,key2=value2"
+// format used by the OTEL_EXPORTER_OTLP_HEADERS and OTEL_RESOURCE_ATTRIBUTES.
+func parseKeyValueList(raw string) (map[string]string, error) {
+	result := map[string]string{}
+
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		splits := strings.SplitN(item, "=", 2)
+		if len(splits) != 2 {
+			return nil, fmt.Errorf("expected key=value, got %q", item)
+		}
+
+		key, err := url.QueryUnescape(strings.TrimSpace(splits[0]))
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := url.QueryUnescape(strings.TrimSpace(splits[1]))
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = value
+	}
+
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package otlptrace_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/otlptrace"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func lookupFrom(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+
+		return value, ok
+	}
+}
+
+func TestNotConfigured(t *testing.T) {
+	tracer, err := otlptrace.NewFromEnvironment(lookupFrom(map[string]string{}), "task")
+	require.NoError(t, err)
+	require.Nil(t, tracer)
+}
+
+func TestExport(t *testing.T) {
+	var payload struct {
+		ResourceSpans []struct {
+			Resource struct {
+				Attributes []struct {
+					Key   string
+					Value map[string]interface{}
+				}
+			}
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID      string `json:"traceId"`
+					SpanID       string `json:"spanId"`
+					ParentSpanID string `json:"parentSpanId"`
+					Name         string
+					Attributes   []struct {
+						Key   string
+						Value map[string]interface{}
+					}
+					Status struct {
+						Code int
+					}
+				}
+			}
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		require.Equal(t, "/v1/traces", request.URL.Path)
+		require.Equal(t, "secret", request.Header.Get("X-Api-Key"))
+		require.NoError(t, json.NewDecoder(request.Body).Decode(&payload))
+	}))
+	defer server.Close()
+
+	tracer, err := otlptrace.NewFromEnvironment(lookupFrom(map[string]string{
+		otlptrace.EnvOTLPEndpoint:       server.URL,
+		otlptrace.EnvOTLPHeaders:        "x-api-key=secret",
+		otlptrace.EnvResourceAttributes: "ci.provider=cirrus",
+	}), "task")
+	require.NoError(t, err)
+
+	span := tracer.Start("main")
+	span.SetAttribute("process.exit_code", 1)
+	span.End(false)
+
+	require.NoError(t, tracer.Shutdown(context.Background(), false))
+
+	require.Len(t, payload.ResourceSpans, 1)
+	resourceSpans := payload.ResourceSpans[0]
+	require.Len(t, resourceSpans.Resource.Attributes, 2)
+	require.Equal(t, "ci.provider", resourceSpans.Resource.Attributes[0].Key)
+	require.Equal(t, "service.name", resourceSpans.Resource.Attributes[1].Key)
+	require.Equal(t, "cirrus-ci-agent", resourceSpans.Resource.Attributes[1].Value["stringValue"])
+
+	spans := resourceSpans.ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	require.Equal(t, "main", spans[0].Name)
+	require.Equal(t, "task", spans[1].Name)
+	require.Equal(t, spans[1].SpanID, spans[0].ParentSpanID)
+	require.Equal(t, spans[1].TraceID, spans[0].TraceID)
+	require.Empty(t, spans[1].ParentSpanID)
+	require.Equal(t, 2, spans[0].Status.Code)
+	require.Equal(t, "process.exit_code", spans[0].Attributes[0].Key)
+	require.Equal(t, "1", spans[0].Attributes[0].Value["intValue"])
+}
+
+func TestUnsupportedProtocol(t *testing.T) {
+	_, err := otlptrace.NewFromEnvironment(lookupFrom(map[string]string{
+		otlptrace.EnvOTLPEndpoint: "http://localhost:4318",
+		otlptrace.EnvOTLPProtocol: "grpc",
+	}), "task")
+	require.Error(t, err)
+}
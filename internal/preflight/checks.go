@@ -0,0 +1,247 @@
+package preflight
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"github.com/certifi/gocertifi"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/clockskew"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/executor/virtualization"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/network"
+	"github.com/cirruslabs/cirrus-ci-agent/pkg/grpchelper"
+	"github.com/dustin/go-humanize"
+	"github.com/shirou/gopsutil/disk"
+	"net"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// serverTime is the API endpoint's time as seen in its response's Date header.
+type serverTime struct {
+	sent     time.Time
+	received time.Time
+	value    time.Time
+}
+
+func checkDNS(ctx context.Context, apiEndpoint string) *Check {
+	check := &Check{Name: "dns"}
+
+	host := apiHost(apiEndpoint)
+	if host == "" || net.ParseIP(host) != nil {
+		check.Status = StatusPass
+		check.Message = fmt.Sprintf("%s doesn't need to be resolved", apiEndpoint)
+
+		return check
+	}
+
+	addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		check.Status = StatusFail
+		check.Message = fmt.Sprintf("failed to resolve %s: %v", host, err)
+
+		return check
+	}
+
+	check.Status = StatusPass
+	check.Message = fmt.Sprintf("%s resolves to %s", host, strings.Join(addresses, ", "))
+
+	return check
+}
+
+func checkAPI(ctx context.Context, apiEndpoint string) (*Check, *serverTime) {
+	check := &Check{Name: "api"}
+
+	target, insecure := grpchelper.TransportSettings(apiEndpoint)
+	if strings.HasPrefix(target, "unix:") {
+		check.Status = StatusWarn
+		check.Message = fmt.Sprintf("connectivity to the Unix domain socket %s is not checked", target)
+
+		return check, nil
+	}
+
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+
+	// Use embedded root certificates for the same reasons as in grpchelper
+	certPool, _ := gocertifi.CACerts()
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext:       network.DialContext,
+			TLSClientConfig:   &tls.Config{RootCAs: certPool},
+			ForceAttemptHTTP2: true,
+		},
+		Timeout: 30 * time.Second,
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodHead, fmt.Sprintf("%s://%s/", scheme, target), nil)
+	if err != nil {
+		check.Status = StatusFail
+		check.Message = err.Error()
+
+		return check, nil
+	}
+
+	sent := time.Now()
+
+	// Any response will do since it's a gRPC endpoint
+	response, err := httpClient.Do(request)
+	if err != nil {
+		check.Status = StatusFail
+		check.Message = fmt.Sprintf("failed to connect to %s: %v", apiEndpoint, err)
+
+		return check, nil
+	}
+	_ = response.Body.Close()
+
+	received := time.Now()
+
+	check.Status = StatusPass
+	check.Message = fmt.Sprintf("%s is reachable (round-trip time %v)", apiEndpoint,
+		received.Sub(sent).Round(time.Millisecond))
+
+	value, err := clockskew.ParseDateHeader(response.Header.Values("Date"))
+	if err != nil {
+		return check, nil
+	}
+
+	return check, &serverTime{sent: sent, received: received, value: value}
+}
+
+func checkClockSkew(ctx context.Context, ntpServer string, observation *serverTime, threshold time.Duration) *Check {
+	check := &Check{Name: "clock_skew"}
+
+	var skew, uncertainty time.Duration
+	var source string
+
+	if ntpServer != "" {
+		var err error
+
+		skew, err = clockskew.QuerySNTP(ctx, ntpServer)
+		if err != nil {
+			check.Status = StatusWarn
+			check.Message = fmt.Sprintf("failed to query the NTP server %s: %v", ntpServer, err)
+
+			return check
+		}
+
+		source = "NTP server " + ntpServer
+	} else {
+		if observation == nil {
+			check.Status = StatusWarn
+			check.Message = "the API endpoint didn't provide its time, specify an NTP server to measure against"
+
+			return check
+		}
+
+		skew = clockskew.Estimate(observation.sent, observation.received, observation.value)
+		// The Date header only has a resolution of one second
+		uncertainty = observation.received.Sub(observation.sent)/2 + time.Second
+		source = "API endpoint"
+	}
+
+	check.Message = fmt.Sprintf("local clock is off by %v (±%v) relative to the %s", skew.Round(time.Millisecond),
+		uncertainty.Round(time.Millisecond), source)
+
+	if skew < 0 {
+		skew = -skew
+	}
+
+	if skew-uncertainty > threshold {
+		check.Status = StatusFail
+		check.Message += fmt.Sprintf(", which exceeds the %v threshold", threshold)
+	} else {
+		check.Status = StatusPass
+	}
+
+	return check
+}
+
+func checkDiskSpace(ctx context.Context, dir string, minFreeSpace uint64) *Check {
+	check := &Check{Name: "disk_space"}
+
+	usage, err := disk.UsageWithContext(ctx, dir)
+	if err != nil {
+		check.Status = StatusFail
+		check.Message = fmt.Sprintf("failed to query the free space of %s: %v", dir, err)
+
+		return check
+	}
+
+	check.Message = fmt.Sprintf("%s has %s free of %s", dir, humanize.IBytes(usage.Free), humanize.IBytes(usage.Total))
+
+	if usage.Free < minFreeSpace {
+		check.Status = StatusFail
+		check.Message += fmt.Sprintf(", less than the required %s", humanize.IBytes(minFreeSpace))
+	} else {
+		check.Status = StatusPass
+	}
+
+	return check
+}
+
+func checkBinaries(binaries []string) *Check {
+	check := &Check{Name: "binaries"}
+
+	var found, missing []string
+
+	for _, binary := range binaries {
+		path, err := exec.LookPath(binary)
+		if err != nil {
+			missing = append(missing, binary)
+
+			continue
+		}
+
+		found = append(found, path)
+	}
+
+	if len(missing) != 0 {
+		check.Status = StatusFail
+		check.Message = fmt.Sprintf("not found in PATH: %s", strings.Join(missing, ", "))
+
+		return check
+	}
+
+	check.Status = StatusPass
+	check.Message = fmt.Sprintf("found %s", strings.Join(found, ", "))
+
+	return check
+}
+
+func checkVirtualization() *Check {
+	report := virtualization.Probe()
+
+	check := &Check{
+		Name:    "virtualization",
+		Message: report.String(),
+	}
+
+	// Only the VM-based workloads need it
+	if report.Available {
+		check.Status = StatusPass
+	} else {
+		check.Status = StatusWarn
+	}
+
+	return check
+}
+
+func apiHost(apiEndpoint string) string {
+	target, _ := grpchelper.TransportSettings(apiEndpoint)
+	if strings.HasPrefix(target, "unix:") {
+		return ""
+	}
+
+	// The port is optional
+	host, _, err := net.SplitHostPort(target)
+	if err != nil {
+		return target
+	}
+
+	return host
+}
@@ -0,0 +1,78 @@
+// Package preflight checks whether the host is able to run the Cirrus CI tasks,
+// which is handy when bringing up a new persistent worker image.
+package preflight
+
+import (
+	"context"
+	"runtime"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+type Report struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+
+	// Passed is false if any of the checks has failed, the warnings don't count
+	Passed bool `json:"passed"`
+
+	Checks []*Check `json:"checks"`
+}
+
+type Options struct {
+	APIEndpoint string
+
+	// NTPServer is used to measure the clock skew more precisely than
+	// with the API endpoint's Date header, which has a resolution of one second
+	NTPServer          string
+	ClockSkewThreshold time.Duration
+
+	// Dir is the directory that should have at least MinFreeSpace bytes available
+	Dir          string
+	MinFreeSpace uint64
+
+	RequiredBinaries []string
+}
+
+// Run performs all the checks and reports their results, it's up to the
+// caller to decide on the timeout, since some checks involve the network.
+func Run(ctx context.Context, options Options) *Report {
+	report := &Report{
+		OS:     runtime.GOOS,
+		Arch:   runtime.GOARCH,
+		Passed: true,
+	}
+
+	dnsCheck := checkDNS(ctx, options.APIEndpoint)
+	apiCheck, serverTime := checkAPI(ctx, options.APIEndpoint)
+
+	report.Checks = []*Check{
+		dnsCheck,
+		apiCheck,
+		checkClockSkew(ctx, options.NTPServer, serverTime, options.ClockSkewThreshold),
+		checkDiskSpace(ctx, options.Dir, options.MinFreeSpace),
+		checkBinaries(options.RequiredBinaries),
+		checkVirtualization(),
+	}
+
+	for _, check := range report.Checks {
+		if check.Status == StatusFail {
+			report.Passed = false
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,80 @@
+package preflight
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func checkByName(t *testing.T, report *Report, name string) *Check {
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+
+	require.FailNow(t, "no such check", name)
+
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusUnsupportedMediaType)
+	}))
+	defer server.Close()
+
+	report := Run(context.Background(), Options{
+		APIEndpoint:        server.URL,
+		ClockSkewThreshold: 30 * time.Second,
+		Dir:                t.TempDir(),
+		RequiredBinaries:   []string{"go"},
+	})
+
+	assert.Equal(t, StatusPass, checkByName(t, report, "dns").Status)
+	assert.Equal(t, StatusPass, checkByName(t, report, "api").Status)
+	assert.Equal(t, StatusPass, checkByName(t, report, "clock_skew").Status)
+	assert.Equal(t, StatusPass, checkByName(t, report, "disk_space").Status)
+	assert.Equal(t, StatusPass, checkByName(t, report, "binaries").Status)
+	assert.True(t, report.Passed)
+}
+
+func TestRunFailures(t *testing.T) {
+	report := Run(context.Background(), Options{
+		APIEndpoint:        "http://127.0.0.1:1",
+		ClockSkewThreshold: 30 * time.Second,
+		Dir:                t.TempDir(),
+		MinFreeSpace:       math.MaxUint64,
+		RequiredBinaries:   []string{"cirrus-nonexistent-binary"},
+	})
+
+	assert.Equal(t, StatusFail, checkByName(t, report, "api").Status)
+	assert.Equal(t, StatusWarn, checkByName(t, report, "clock_skew").Status)
+	assert.Equal(t, StatusFail, checkByName(t, report, "disk_space").Status)
+	assert.True(t, strings.HasSuffix(checkByName(t, report, "binaries").Message, "cirrus-nonexistent-binary"))
+	assert.False(t, report.Passed)
+}
+
+func TestClockSkewFromServerTime(t *testing.T) {
+	now := time.Now()
+
+	check := checkClockSkew(context.Background(), "", &serverTime{
+		sent:     now,
+		received: now.Add(100 * time.Millisecond),
+		value:    now.Add(time.Hour),
+	}, 30*time.Second)
+
+	assert.Equal(t, StatusFail, check.Status)
+}
+
+func TestAPIHost(t *testing.T) {
+	assert.Equal(t, "grpc.cirrus-ci.com", apiHost("https://grpc.cirrus-ci.com:443"))
+	assert.Equal(t, "grpc.cirrus-ci.com", apiHost("grpc.cirrus-ci.com"))
+	assert.Equal(t, "", apiHost("unix:/tmp/agent.sock"))
+}
@@ -0,0 +1,221 @@
+// Package sshtunnel dials TCP connections through an SSH jump host,
+// which allows the agents in isolated networks to reach the Cirrus CI API.
+package sshtunnel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultSSHPort = "22"
+
+var ErrInvalidSpec = errors.New("invalid SSH tunnel specification, expected user@host[:port]")
+
+type Tunnel struct {
+	addr   string
+	config *ssh.ClientConfig
+
+	client   *ssh.Client
+	clientMu sync.Mutex
+}
+
+// ParseSpec parses the "user@host[:port]" specification into a username and a jump host address.
+func ParseSpec(spec string) (string, string, error) {
+	idx := strings.LastIndex(spec, "@")
+	if idx <= 0 || idx == len(spec)-1 {
+		return "", "", ErrInvalidSpec
+	}
+
+	user, host := spec[:idx], spec[idx+1:]
+
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(strings.Trim(host, "[]"), defaultSSHPort)
+	}
+
+	return user, host, nil
+}
+
+// New creates a tunnel through the jump host described by the spec ("user@host[:port]"),
+// authenticating with the private key at keyPath and verifying the jump host's key
+// against the knownHostsPath.
+//
+// Empty keyPath and knownHostsPath default to the ~/.ssh/id_* keys and ~/.ssh/known_hosts.
+func New(spec string, keyPath string, knownHostsPath string) (*Tunnel, error) {
+	user, addr, err := ParseSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := NewClientConfig(user, keyPath, knownHostsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tunnel{
+		addr:   addr,
+		config: config,
+	}, nil
+}
+
+// NewClientConfig creates the SSH client configuration that authenticates as the user with
+// the private key at keyPath and verifies the host's key against the knownHostsPath.
+//
+// Empty keyPath and knownHostsPath default to the ~/.ssh/id_* keys and ~/.ssh/known_hosts.
+func NewClientConfig(user string, keyPath string, knownHostsPath string) (*ssh.ClientConfig, error) {
+	signers, err := loadSigners(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if knownHostsPath == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		knownHostsPath = filepath.Join(homeDir, ".ssh", "known_hosts")
+	}
+
+	hostKeyCallback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known hosts to verify the host with: %w", err)
+	}
+
+	return &ssh.ClientConfig{
+		User:            user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signers...)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         30 * time.Second,
+	}, nil
+}
+
+func (tunnel *Tunnel) String() string {
+	return fmt.Sprintf("%s@%s", tunnel.config.User, tunnel.addr)
+}
+
+// DialContext opens a connection to the addr from the jump host,
+// (re-)connecting to the jump host if needed.
+func (tunnel *Tunnel) DialContext(ctx context.Context, addr string) (net.Conn, error) {
+	client, err := tunnel.sshClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := client.Dial("tcp", addr)
+	if err == nil {
+		return conn, nil
+	}
+
+	// The SSH connection might have been broken, so re-connect
+	// and try again once before giving up
+	tunnel.dropClient(client)
+
+	client, err = tunnel.sshClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Dial("tcp", addr)
+}
+
+func (tunnel *Tunnel) Close() error {
+	tunnel.clientMu.Lock()
+	defer tunnel.clientMu.Unlock()
+
+	if tunnel.client == nil {
+		return nil
+	}
+
+	err := tunnel.client.Close()
+	tunnel.client = nil
+
+	return err
+}
+
+func (tunnel *Tunnel) sshClient(ctx context.Context) (*ssh.Client, error) {
+	tunnel.clientMu.Lock()
+	defer tunnel.clientMu.Unlock()
+
+	if tunnel.client != nil {
+		return tunnel.client, nil
+	}
+
+	dialer := net.Dialer{Timeout: tunnel.config.Timeout}
+
+	netConn, err := dialer.DialContext(ctx, "tcp", tunnel.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the jump host %s: %w", tunnel.addr, err)
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(netConn, tunnel.addr, tunnel.config)
+	if err != nil {
+		_ = netConn.Close()
+
+		return nil, fmt.Errorf("failed to establish an SSH connection to the jump host %s: %w", tunnel.addr, err)
+	}
+
+	tunnel.client = ssh.NewClient(sshConn, chans, reqs)
+
+	return tunnel.client, nil
+}
+
+func (tunnel *Tunnel) dropClient(client *ssh.Client) {
+	tunnel.clientMu.Lock()
+	defer tunnel.clientMu.Unlock()
+
+	if tunnel.client == client {
+		_ = tunnel.client.Close()
+		tunnel.client = nil
+	}
+}
+
+func loadSigners(keyPath string) ([]ssh.Signer, error) {
+	var candidates []string
+
+	if keyPath != "" {
+		candidates = []string{keyPath}
+	} else {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range []string{"id_ed25519", "id_ecdsa", "id_rsa"} {
+			candidates = append(candidates, filepath.Join(homeDir, ".ssh", name))
+		}
+	}
+
+	var signers []ssh.Signer
+
+	for _, candidate := range candidates {
+		pemBytes, err := os.ReadFile(candidate)
+		if err != nil {
+			if keyPath == "" && errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		signer, err := ssh.ParsePrivateKey(pemBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the private key %s: %w", candidate, err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("no private keys found to authenticate with")
+	}
+
+	return signers, nil
+}
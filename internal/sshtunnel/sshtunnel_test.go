@@ -0,0 +1,176 @@
+package sshtunnel_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/cirruslabs/cirrus-ci-agent/internal/sshtunnel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestParseSpec(t *testing.T) {
+	user, addr, err := sshtunnel.ParseSpec("cirrus@bastion.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "cirrus", user)
+	assert.Equal(t, "bastion.example.com:22", addr)
+
+	user, addr, err = sshtunnel.ParseSpec("cirrus@10.0.0.1:2222")
+	require.NoError(t, err)
+	assert.Equal(t, "cirrus", user)
+	assert.Equal(t, "10.0.0.1:2222", addr)
+
+	for _, spec := range []string{"bastion.example.com", "@bastion.example.com", "cirrus@"} {
+		_, _, err := sshtunnel.ParseSpec(spec)
+		assert.ErrorIs(t, err, sshtunnel.ErrInvalidSpec, spec)
+	}
+}
+
+func TestTunnel(t *testing.T) {
+	dir := t.TempDir()
+
+	// Target that should only be reached through the jump host
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+
+	go func() {
+		conn, err := target.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, _ = conn.Write([]byte("hello from the target"))
+	}()
+
+	// Client key
+	clientPub, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyPath := writePrivateKey(t, dir, clientPriv)
+	clientSSHPub, err := ssh.NewPublicKey(clientPub)
+	require.NoError(t, err)
+
+	// Jump host
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+
+	jumpHost, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer jumpHost.Close()
+
+	go serveJumpHost(t, jumpHost, hostSigner, clientSSHPub)
+
+	knownHostsPath := filepath.Join(dir, "known_hosts")
+	knownHostsLine := knownhosts.Line([]string{knownhosts.Normalize(jumpHost.Addr().String())},
+		hostSigner.PublicKey())
+	require.NoError(t, os.WriteFile(knownHostsPath, []byte(knownHostsLine+"\n"), 0600))
+
+	tunnel, err := sshtunnel.New("cirrus@"+jumpHost.Addr().String(), keyPath, knownHostsPath)
+	require.NoError(t, err)
+	defer tunnel.Close()
+
+	conn, err := tunnel.DialContext(context.Background(), target.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	greeting, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "hello from the target", string(greeting))
+}
+
+func TestTunnelUnknownHost(t *testing.T) {
+	dir := t.TempDir()
+
+	_, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyPath := writePrivateKey(t, dir, clientPriv)
+
+	_, err = sshtunnel.New("cirrus@127.0.0.1", keyPath, filepath.Join(dir, "missing_known_hosts"))
+	require.Error(t, err)
+}
+
+func writePrivateKey(t *testing.T, dir string, key ed25519.PrivateKey) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(dir, "id_ed25519")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+
+	return keyPath
+}
+
+func serveJumpHost(t *testing.T, lis net.Listener, hostSigner ssh.Signer, authorizedKey ssh.PublicKey) {
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if conn.User() == "cirrus" && string(key.Marshal()) == string(authorizedKey.Marshal()) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("unauthorized")
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	netConn, err := lis.Accept()
+	if err != nil {
+		return
+	}
+
+	_, chans, reqs, err := ssh.NewServerConn(netConn, config)
+	if err != nil {
+		t.Logf("jump host handshake failed: %v", err)
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "direct-tcpip" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+
+		var payload struct {
+			Host       string
+			Port       uint32
+			OriginHost string
+			OriginPort uint32
+		}
+		if err := ssh.Unmarshal(newChannel.ExtraData(), &payload); err != nil {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		targetConn, err := net.Dial("tcp", net.JoinHostPort(payload.Host, strconv.Itoa(int(payload.Port))))
+		if err != nil {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			_ = targetConn.Close()
+			continue
+		}
+		go ssh.DiscardRequests(requests)
+
+		go func() {
+			_, _ = io.Copy(channel, targetConn)
+			_ = channel.Close()
+			_ = targetConn.Close()
+		}()
+	}
+}
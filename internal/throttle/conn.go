@@ -0,0 +1,132 @@
+package throttle
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"sync"
+)
+
+// Conn is a grpc.ClientConnInterface that throttles the messages passing through it
+// and holds a stream slot for each of the streams until it's finished.
+type Conn struct {
+	conn    grpc.ClientConnInterface
+	limiter *Limiter
+}
+
+func NewConn(conn grpc.ClientConnInterface, limiter *Limiter) *Conn {
+	return &Conn{
+		conn:    conn,
+		limiter: limiter,
+	}
+}
+
+func (conn *Conn) Invoke(
+	ctx context.Context,
+	method string,
+	args interface{},
+	reply interface{},
+	opts ...grpc.CallOption,
+) error {
+	if err := conn.limiter.WaitN(ctx, messageSize(args)); err != nil {
+		return err
+	}
+
+	if err := conn.conn.Invoke(ctx, method, args, reply, opts...); err != nil {
+		return err
+	}
+
+	return conn.limiter.WaitN(ctx, messageSize(reply))
+}
+
+func (conn *Conn) NewStream(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	method string,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if err := conn.limiter.Acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	clientStream, err := conn.conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		conn.limiter.Release()
+
+		return nil, err
+	}
+
+	stream := &stream{
+		ClientStream:  clientStream,
+		limiter:       conn.limiter,
+		serverStreams: desc.ServerStreams,
+		released:      make(chan struct{}),
+	}
+
+	// The streams abandoned by the callers are finished once their context is done
+	go func() {
+		select {
+		case <-clientStream.Context().Done():
+			stream.release()
+		case <-stream.released:
+		}
+	}()
+
+	return stream, nil
+}
+
+type stream struct {
+	grpc.ClientStream
+
+	limiter       *Limiter
+	serverStreams bool
+	released      chan struct{}
+	releaseOnce   sync.Once
+}
+
+func (stream *stream) SendMsg(m interface{}) error {
+	if err := stream.limiter.WaitN(stream.Context(), messageSize(m)); err != nil {
+		stream.release()
+
+		return err
+	}
+
+	if err := stream.ClientStream.SendMsg(m); err != nil {
+		stream.release()
+
+		return err
+	}
+
+	return nil
+}
+
+func (stream *stream) RecvMsg(m interface{}) error {
+	if err := stream.ClientStream.RecvMsg(m); err != nil {
+		stream.release()
+
+		return err
+	}
+
+	// Without the server streaming there's only a single response that finishes the stream
+	if !stream.serverStreams {
+		stream.release()
+	}
+
+	return stream.limiter.WaitN(stream.Context(), messageSize(m))
+}
+
+func (stream *stream) release() {
+	stream.releaseOnce.Do(func() {
+		stream.limiter.Release()
+		close(stream.released)
+	})
+}
+
+func messageSize(m interface{}) int {
+	message, ok := m.(proto.Message)
+	if !ok {
+		return 0
+	}
+
+	return proto.Size(message)
+}
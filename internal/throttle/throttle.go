@@ -0,0 +1,112 @@
+package throttle
+
+import (
+	"context"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+	"io"
+)
+
+// Limiter shares the bandwidth and the number of the concurrent streams between
+// all the agent's traffic to the Cirrus CI servers (logs, caches and artifacts),
+// so that a single task can't saturate the uplink of a worker it shares
+// with other tasks or trip the server-side rate limits.
+//
+// The zero limits mean no limit and a nil Limiter doesn't limit anything.
+type Limiter struct {
+	bandwidth *rate.Limiter
+	streams   *semaphore.Weighted
+}
+
+var global *Limiter
+
+// Configure sets the limits for the traffic going through Global().
+func Configure(bytesPerSecond int64, maxStreams int64) {
+	global = New(bytesPerSecond, maxStreams)
+}
+
+// Global returns the limiter configured with Configure() or nil if it wasn't called.
+func Global() *Limiter {
+	return global
+}
+
+func New(bytesPerSecond int64, maxStreams int64) *Limiter {
+	limiter := &Limiter{}
+
+	if bytesPerSecond > 0 {
+		// Let through at most a second worth of traffic at once
+		limiter.bandwidth = rate.NewLimiter(rate.Limit(bytesPerSecond), int(bytesPerSecond))
+	}
+
+	if maxStreams > 0 {
+		limiter.streams = semaphore.NewWeighted(maxStreams)
+	}
+
+	return limiter
+}
+
+// WaitN blocks until the n bytes can be sent or received.
+func (limiter *Limiter) WaitN(ctx context.Context, n int) error {
+	if limiter == nil || limiter.bandwidth == nil {
+		return nil
+	}
+
+	// rate.Limiter refuses to wait for more tokens than the burst allows at once
+	for n > 0 {
+		chunk := n
+		if burst := limiter.bandwidth.Burst(); chunk > burst {
+			chunk = burst
+		}
+
+		if err := limiter.bandwidth.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+
+		n -= chunk
+	}
+
+	return nil
+}
+
+// Acquire blocks until there's a free stream slot, which should be returned with Release().
+func (limiter *Limiter) Acquire(ctx context.Context) error {
+	if limiter == nil || limiter.streams == nil {
+		return nil
+	}
+
+	return limiter.streams.Acquire(ctx, 1)
+}
+
+func (limiter *Limiter) Release() {
+	if limiter == nil || limiter.streams == nil {
+		return
+	}
+
+	limiter.streams.Release(1)
+}
+
+// Reader throttles the reads from the r.
+func (limiter *Limiter) Reader(ctx context.Context, r io.Reader) io.Reader {
+	if limiter == nil || limiter.bandwidth == nil {
+		return r
+	}
+
+	return &reader{ctx: ctx, limiter: limiter, r: r}
+}
+
+type reader struct {
+	ctx     context.Context
+	limiter *Limiter
+	r       io.Reader
+}
+
+func (reader *reader) Read(p []byte) (int, error) {
+	n, err := reader.r.Read(p)
+	if n > 0 {
+		if waitErr := reader.limiter.WaitN(reader.ctx, n); waitErr != nil && err == nil {
+			err = waitErr
+		}
+	}
+
+	return n, err
+}
@@ -0,0 +1,106 @@
+package throttle
+
+import (
+	"bytes"
+	"context"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitNLargerThanBurst(t *testing.T) {
+	limiter := New(1000, 0)
+
+	start := time.Now()
+	require.NoError(t, limiter.WaitN(context.Background(), 2500))
+
+	// The initial burst covers the first 1000 bytes
+	require.GreaterOrEqual(t, time.Since(start), 1400*time.Millisecond)
+}
+
+func TestNilLimiter(t *testing.T) {
+	var limiter *Limiter
+
+	require.NoError(t, limiter.WaitN(context.Background(), 1<<30))
+	require.NoError(t, limiter.Acquire(context.Background()))
+	limiter.Release()
+}
+
+func TestAcquireBlocksWhenOutOfStreams(t *testing.T) {
+	limiter := New(0, 1)
+
+	require.NoError(t, limiter.Acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, limiter.Acquire(ctx), context.DeadlineExceeded)
+
+	limiter.Release()
+	require.NoError(t, limiter.Acquire(context.Background()))
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+
+	ctx context.Context
+}
+
+func (stream *fakeStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *fakeStream) RecvMsg(interface{}) error {
+	return nil
+}
+
+type fakeConn struct {
+	grpc.ClientConnInterface
+}
+
+func (conn *fakeConn) NewStream(ctx context.Context, _ *grpc.StreamDesc, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	return &fakeStream{ctx: ctx}, nil
+}
+
+func TestConnReleasesFinishedStreams(t *testing.T) {
+	limiter := New(0, 1)
+	conn := NewConn(&fakeConn{}, limiter)
+
+	// A client-streaming RPC is finished after receiving the response
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{ClientStreams: true}, "/test")
+	require.NoError(t, err)
+	require.NoError(t, stream.RecvMsg(nil))
+
+	// An abandoned stream is finished once its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err = conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/test")
+	require.NoError(t, err)
+	cancel()
+
+	acquireCtx, acquireCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer acquireCancel()
+	require.NoError(t, limiter.Acquire(acquireCtx))
+}
+
+func TestTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = io.Copy(writer, request.Body)
+	}))
+	defer server.Close()
+
+	limiter := New(0, 1)
+	httpClient := &http.Client{Transport: NewTransport(http.DefaultTransport, limiter)}
+
+	for i := 0; i < 3; i++ {
+		response, err := httpClient.Post(server.URL, "text/plain", bytes.NewBufferString("hello"))
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(response.Body)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		require.Equal(t, "hello", string(body))
+	}
+}
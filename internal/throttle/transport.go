@@ -0,0 +1,74 @@
+package throttle
+
+import (
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Transport is an http.RoundTripper that throttles the request and response bodies
+// and holds a stream slot for each of the requests until its response body is closed.
+type Transport struct {
+	base    http.RoundTripper
+	limiter *Limiter
+}
+
+func NewTransport(base http.RoundTripper, limiter *Limiter) *Transport {
+	return &Transport{
+		base:    base,
+		limiter: limiter,
+	}
+}
+
+func (transport *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	if err := transport.limiter.Acquire(ctx); err != nil {
+		if request.Body != nil {
+			_ = request.Body.Close()
+		}
+
+		return nil, err
+	}
+
+	if request.Body != nil && request.Body != http.NoBody {
+		request = request.Clone(ctx)
+		request.Body = &readCloser{
+			Reader: transport.limiter.Reader(ctx, request.Body),
+			closer: request.Body,
+		}
+	}
+
+	response, err := transport.base.RoundTrip(request)
+	if err != nil {
+		transport.limiter.Release()
+
+		return nil, err
+	}
+
+	response.Body = &readCloser{
+		Reader:  transport.limiter.Reader(ctx, response.Body),
+		closer:  response.Body,
+		onClose: transport.limiter.Release,
+	}
+
+	return response, nil
+}
+
+type readCloser struct {
+	io.Reader
+
+	closer    io.Closer
+	onClose   func()
+	closeOnce sync.Once
+}
+
+func (readCloser *readCloser) Close() error {
+	err := readCloser.closer.Close()
+
+	if readCloser.onClose != nil {
+		readCloser.closeOnce.Do(readCloser.onClose)
+	}
+
+	return err
+}
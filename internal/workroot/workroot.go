@@ -0,0 +1,44 @@
+package workroot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// Configure points the os.TempDir() to the root, so that the agent's temporary files
+// and the default working directories (see getScriptEnvironment()) end up there
+// instead of the OS temp partition. The change is inherited by the processes
+// spawned by the agent, including the user scripts.
+func Configure(root string) error {
+	if root == "" {
+		return nil
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return fmt.Errorf("failed to create the work root %s: %w", root, err)
+	}
+
+	for _, key := range tempDirVariables() {
+		if err := os.Setenv(key, root); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func tempDirVariables() []string {
+	// GetTempPath() on Windows looks for TMP first and then for TEMP
+	if runtime.GOOS == "windows" {
+		return []string{"TMP", "TEMP"}
+	}
+
+	return []string{"TMPDIR"}
+}
@@ -0,0 +1,27 @@
+package workroot
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigure(t *testing.T) {
+	for _, key := range tempDirVariables() {
+		t.Setenv(key, os.Getenv(key))
+	}
+
+	root := filepath.Join(t.TempDir(), "work")
+
+	require.NoError(t, Configure(root))
+	require.DirExists(t, root)
+	require.Equal(t, root, os.TempDir())
+}
+
+func TestConfigureEmpty(t *testing.T) {
+	before := os.TempDir()
+
+	require.NoError(t, Configure(""))
+	require.Equal(t, before, os.TempDir())
+}